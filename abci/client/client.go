@@ -30,6 +30,7 @@ type Client interface {
 	InfoAsync(types.RequestInfo) *ReqRes
 	SetOptionAsync(types.RequestSetOption) *ReqRes
 	DeliverTxAsync(types.RequestDeliverTx) *ReqRes
+	DeliverBatchAsync(types.RequestDeliverBatch) *ReqRes
 	CheckTxAsync(types.RequestCheckTx) *ReqRes
 	QueryAsync(types.RequestQuery) *ReqRes
 	CommitAsync() *ReqRes
@@ -46,6 +47,7 @@ type Client interface {
 	InfoSync(types.RequestInfo) (*types.ResponseInfo, error)
 	SetOptionSync(types.RequestSetOption) (*types.ResponseSetOption, error)
 	DeliverTxSync(types.RequestDeliverTx) (*types.ResponseDeliverTx, error)
+	DeliverBatchSync(types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error)
 	CheckTxSync(types.RequestCheckTx) (*types.ResponseCheckTx, error)
 	QuerySync(types.RequestQuery) (*types.ResponseQuery, error)
 	CommitSync() (*types.ResponseCommit, error)
@@ -58,14 +60,26 @@ type Client interface {
 	ApplySnapshotChunkSync(types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error)
 }
 
+// ConnectionMonitor is implemented by the clients, which reconnect to the
+// application after losing the connection to it.
+type ConnectionMonitor interface {
+	// IsConnected returns false while the client is reconnecting.
+	IsConnected() bool
+	// SetConnectionCallback sets the callback called with false once the
+	// connection to the application is lost and with true once it is resumed.
+	SetConnectionCallback(cb func(connected bool))
+}
+
 //----------------------------------------
 
 // NewClient returns a new ABCI client of the specified transport type.
-// It returns an error if the transport is not "socket" or "grpc"
-func NewClient(addr, transport string, mustConnect bool) (client Client, err error) {
+// It returns an error if the transport is not "socket" or "grpc". The socket
+// client options are ignored by the other transports.
+func NewClient(addr, transport string, mustConnect bool,
+	options ...SocketClientOption) (client Client, err error) {
 	switch transport {
 	case "socket":
-		client = NewSocketClient(addr, mustConnect)
+		client = NewSocketClient(addr, mustConnect, options...)
 	case "grpc":
 		client = NewGRPCClient(addr, mustConnect)
 	default:
@@ -212,6 +212,15 @@ func (cli *grpcClient) DeliverTxAsync(params types.RequestDeliverTx) *ReqRes {
 	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_DeliverTx{DeliverTx: res}})
 }
 
+func (cli *grpcClient) DeliverBatchAsync(params types.RequestDeliverBatch) *ReqRes {
+	req := types.ToRequestDeliverBatch(params)
+	res, err := cli.client.DeliverBatch(context.Background(), req.GetDeliverBatch(), grpc.WaitForReady(true))
+	if err != nil {
+		cli.StopForError(err)
+	}
+	return cli.finishAsyncCall(req, &types.Response{Value: &types.Response_DeliverBatch{DeliverBatch: res}})
+}
+
 func (cli *grpcClient) CheckTxAsync(params types.RequestCheckTx) *ReqRes {
 	req := types.ToRequestCheckTx(params)
 	res, err := cli.client.CheckTx(context.Background(), req.GetCheckTx(), grpc.WaitForReady(true))
@@ -366,6 +375,11 @@ func (cli *grpcClient) DeliverTxSync(params types.RequestDeliverTx) (*types.Resp
 	return cli.finishSyncCall(reqres).GetDeliverTx(), cli.Error()
 }
 
+func (cli *grpcClient) DeliverBatchSync(params types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error) {
+	reqres := cli.DeliverBatchAsync(params)
+	return cli.finishSyncCall(reqres).GetDeliverBatch(), cli.Error()
+}
+
 func (cli *grpcClient) CheckTxSync(params types.RequestCheckTx) (*types.ResponseCheckTx, error) {
 	reqres := cli.CheckTxAsync(params)
 	return cli.finishSyncCall(reqres).GetCheckTx(), cli.Error()
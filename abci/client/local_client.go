@@ -91,6 +91,17 @@ func (app *localClient) DeliverTxAsync(params types.RequestDeliverTx) *ReqRes {
 	)
 }
 
+func (app *localClient) DeliverBatchAsync(req types.RequestDeliverBatch) *ReqRes {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := types.DeliverBatch(app.Application, req)
+	return app.callback(
+		types.ToRequestDeliverBatch(req),
+		types.ToResponseDeliverBatch(res),
+	)
+}
+
 func (app *localClient) CheckTxAsync(req types.RequestCheckTx) *ReqRes {
 	app.mtx.Lock()
 	defer app.mtx.Unlock()
@@ -235,6 +246,14 @@ func (app *localClient) DeliverTxSync(req types.RequestDeliverTx) (*types.Respon
 	return &res, nil
 }
 
+func (app *localClient) DeliverBatchSync(req types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	res := types.DeliverBatch(app.Application, req)
+	return &res, nil
+}
+
 func (app *localClient) CheckTxSync(req types.RequestCheckTx) (*types.ResponseCheckTx, error) {
 	app.mtx.Lock()
 	defer app.mtx.Unlock()
@@ -328,5 +347,6 @@ func newLocalReqRes(req *types.Request, res *types.Response) *ReqRes {
 	reqRes := NewReqRes(req)
 	reqRes.Response = res
 	reqRes.SetDone()
+	reqRes.Done() // release waiters
 	return reqRes
 }
@@ -0,0 +1,55 @@
+package abcicli
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "abci_client"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of connections to the application, which are lost and being
+	// reestablished.
+	Disconnected metrics.Gauge
+	// Number of times a lost connection to the application was reestablished.
+	Reconnects metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		Disconnected: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "disconnected",
+			Help:      "Number of connections to the application, which are lost and being reestablished.",
+		}, labels).With(labelsAndValues...),
+		Reconnects: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reconnects",
+			Help:      "Number of times a lost connection to the application was reestablished.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Disconnected: discard.NewGauge(),
+		Reconnects:   discard.NewCounter(),
+	}
+}
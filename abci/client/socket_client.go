@@ -5,9 +5,9 @@ import (
 	"container/list"
 	"errors"
 	"fmt"
-	"io"
 	"net"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/tendermint/tendermint/abci/types"
@@ -15,13 +15,72 @@ import (
 	"github.com/tendermint/tendermint/libs/service"
 	tmsync "github.com/tendermint/tendermint/libs/sync"
 	"github.com/tendermint/tendermint/libs/timer"
+	"github.com/tendermint/tendermint/version"
 )
 
 const (
 	reqQueueSize    = 256 // TODO make configurable
 	flushThrottleMS = 20  // Don't wait longer than...
+
+	// DefaultMaxReconnectBackoff is the default maximum delay between the
+	// attempts to reconnect to the application.
+	DefaultMaxReconnectBackoff = 10 * time.Second
+
+	// minReconnectBackoff is the delay before the first attempt to reconnect
+	// to the application, doubled after every failed attempt.
+	minReconnectBackoff = 100 * time.Millisecond
+
+	// resumeTimeout is the maximum duration of the handshake and the replay
+	// of the requests on reconnection.
+	resumeTimeout = time.Minute
+
+	// handshakeTimeout is the maximum duration of the handshake negotiating
+	// multiplexing on connection.
+	handshakeTimeout = 10 * time.Second
+
+	// handshakeRequestID is the request id of the Info request negotiating
+	// multiplexing.
+	handshakeRequestID = 1
 )
 
+// SocketClientOption sets an optional parameter on the socket client.
+type SocketClientOption func(*socketClient)
+
+// SocketClientWithReconnect makes the client reconnect to the application
+// after losing the connection to it, instead of stopping. The attempts to
+// reconnect are delayed by an exponential backoff, capped to maxBackoff.
+//
+// Meanwhile, the requests are queued and the sync calls block. Once
+// reconnected, the client checks with an Info request that the application
+// is at the height of the last block committed through the client, replays
+// the requests of the block being executed and resends the requests, which
+// are not responded yet. The client stops with an error if the height of the
+// application doesn't match.
+func SocketClientWithReconnect(maxBackoff time.Duration) SocketClientOption {
+	return func(cli *socketClient) {
+		cli.reconnect = true
+		cli.maxBackoff = maxBackoff
+	}
+}
+
+// SocketClientWithMultiplexing makes the client negotiate multiplexing the
+// requests over the connection with an Info request, sent first on every
+// connection. Once the application agrees, the requests carry request ids and
+// the application may respond to them out of order, e.g. to process the
+// CheckTx requests concurrently. The responses are still delivered to the
+// callers in the order of the requests.
+//
+// The client keeps sending the requests in order to the applications, which
+// don't support multiplexing.
+func SocketClientWithMultiplexing() SocketClientOption {
+	return func(cli *socketClient) { cli.multiplex = true }
+}
+
+// SocketClientWithMetrics sets the metrics.
+func SocketClientWithMetrics(metrics *Metrics) SocketClientOption {
+	return func(cli *socketClient) { cli.metrics = metrics }
+}
+
 // This is goroutine-safe, but users should beware that the application in
 // general is not meant to be interfaced with concurrent callers.
 type socketClient struct {
@@ -29,31 +88,65 @@ type socketClient struct {
 
 	addr        string
 	mustConnect bool
-	conn        net.Conn
+	reconnect   bool
+	maxBackoff  time.Duration
+	multiplex   bool
+	metrics     *Metrics
 
 	reqQueue   chan *ReqRes
 	flushTimer *timer.ThrottleTimer
 
-	mtx     tmsync.Mutex
-	err     error
-	reqSent *list.List                            // list of requests sent, waiting for response
-	resCb   func(*types.Request, *types.Response) // called on all requests, if set.
+	// routines tracks the routines sending and receiving over the connection.
+	routines sync.WaitGroup
+
+	mtx       tmsync.Mutex
+	conn      net.Conn
+	connected bool
+	connLost  chan struct{} // closed once the connection is lost
+	err       error
+	reqSent   *list.List                            // list of requests sent, waiting for response
+	resCb     func(*types.Request, *types.Response) // called on all requests, if set.
+	connCb    func(connected bool)                  // called on losing and resuming the connection, if set.
+
+	// multiplexed is set if the application agreed to multiplex the requests
+	// over the connection, in which case reqSentByID indexes the requests
+	// sent by their ids.
+	multiplexed   bool
+	nextRequestID uint64
+	reqSentByID   map[uint64]*list.Element
+
+	// blockReqs are the responded requests of the block being executed,
+	// replayed to the application on reconnection.
+	blockReqs       []*types.Request
+	blockHeight     int64
+	committedHeight int64
+
+	// deliversBatch is set once a DeliverBatch request is sent, after which
+	// the application must keep supporting it on reconnection.
+	deliversBatch bool
 }
 
 var _ Client = (*socketClient)(nil)
+var _ ConnectionMonitor = (*socketClient)(nil)
 
 // NewSocketClient creates a new socket client, which connects to a given
 // address. If mustConnect is true, the client will return an error upon start
 // if it fails to connect.
-func NewSocketClient(addr string, mustConnect bool) Client {
+func NewSocketClient(addr string, mustConnect bool, options ...SocketClientOption) Client {
 	cli := &socketClient{
 		reqQueue:    make(chan *ReqRes, reqQueueSize),
 		flushTimer:  timer.NewThrottleTimer("socketClient", flushThrottleMS),
 		mustConnect: mustConnect,
+		maxBackoff:  DefaultMaxReconnectBackoff,
+		metrics:     NopMetrics(),
 
-		addr:    addr,
-		reqSent: list.New(),
-		resCb:   nil,
+		addr:        addr,
+		reqSent:     list.New(),
+		reqSentByID: make(map[uint64]*list.Element),
+		resCb:       nil,
+	}
+	for _, option := range options {
+		option(cli)
 	}
 	cli.BaseService = *service.NewBaseService(nil, "socketClient", cli)
 	return cli
@@ -78,10 +171,19 @@ func (cli *socketClient) OnStart() error {
 			time.Sleep(time.Second * dialRetryIntervalSeconds)
 			continue
 		}
-		cli.conn = conn
-
-		go cli.sendRequestsRoutine(conn)
-		go cli.recvResponseRoutine(conn)
+		if cli.multiplex {
+			if err = cli.negotiate(conn); err != nil {
+				conn.Close()
+				if cli.mustConnect {
+					return err
+				}
+				cli.Logger.Error(fmt.Sprintf("abci.socketClient failed to negotiate with %v.  Retrying after %vs...",
+					cli.addr, dialRetryIntervalSeconds), "err", err)
+				time.Sleep(time.Second * dialRetryIntervalSeconds)
+				continue
+			}
+		}
+		cli.startRoutines(conn)
 
 		return nil
 	}
@@ -89,14 +191,44 @@ func (cli *socketClient) OnStart() error {
 
 // OnStop implements Service by closing connection and flushing all queues.
 func (cli *socketClient) OnStop() {
+	cli.mtx.Lock()
 	if cli.conn != nil {
 		cli.conn.Close()
 	}
+	if !cli.connected && cli.err == nil {
+		cli.err = errors.New("stopped while reconnecting to the application")
+	}
+	cli.mtx.Unlock()
 
 	cli.flushQueue()
 	cli.flushTimer.Stop()
 }
 
+// IsConnected implements ConnectionMonitor.
+func (cli *socketClient) IsConnected() bool {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+	return cli.connected
+}
+
+// SetConnectionCallback implements ConnectionMonitor.
+func (cli *socketClient) SetConnectionCallback(cb func(connected bool)) {
+	cli.mtx.Lock()
+	cli.connCb = cb
+	cli.mtx.Unlock()
+}
+
+// connectionChanged calls the connection callback, if set.
+func (cli *socketClient) connectionChanged(connected bool) {
+	cli.mtx.Lock()
+	cb := cli.connCb
+	cli.mtx.Unlock()
+
+	if cb != nil {
+		cb(connected)
+	}
+}
+
 // Error returns an error if the client was stopped abruptly.
 func (cli *socketClient) Error() error {
 	cli.mtx.Lock()
@@ -116,7 +248,24 @@ func (cli *socketClient) SetResponseCallback(resCb Callback) {
 
 //----------------------------------------
 
-func (cli *socketClient) sendRequestsRoutine(conn io.Writer) {
+// startRoutines starts the routines sending and receiving over the
+// connection.
+func (cli *socketClient) startRoutines(conn net.Conn) {
+	cli.mtx.Lock()
+	cli.conn = conn
+	cli.connected = true
+	cli.connLost = make(chan struct{})
+	connLost := cli.connLost
+	cli.mtx.Unlock()
+
+	cli.routines.Add(2)
+	go cli.sendRequestsRoutine(conn, connLost)
+	go cli.recvResponseRoutine(conn)
+}
+
+func (cli *socketClient) sendRequestsRoutine(conn net.Conn, connLost <-chan struct{}) {
+	defer cli.routines.Done()
+
 	w := bufio.NewWriter(conn)
 	for {
 		select {
@@ -126,7 +275,7 @@ func (cli *socketClient) sendRequestsRoutine(conn io.Writer) {
 			cli.willSendReq(reqres)
 			err := types.WriteMessage(reqres.Request, w)
 			if err != nil {
-				cli.stopForError(fmt.Errorf("write to buffer: %w", err))
+				cli.stopForConnError(conn, fmt.Errorf("write to buffer: %w", err))
 				return
 			}
 
@@ -134,7 +283,7 @@ func (cli *socketClient) sendRequestsRoutine(conn io.Writer) {
 			if _, ok := reqres.Request.Value.(*types.Request_Flush); ok {
 				err = w.Flush()
 				if err != nil {
-					cli.stopForError(fmt.Errorf("flush buffer: %w", err))
+					cli.stopForConnError(conn, fmt.Errorf("flush buffer: %w", err))
 					return
 				}
 			}
@@ -144,19 +293,23 @@ func (cli *socketClient) sendRequestsRoutine(conn io.Writer) {
 			default:
 				// Probably will fill the buffer, or retry later.
 			}
+		case <-connLost:
+			return
 		case <-cli.Quit():
 			return
 		}
 	}
 }
 
-func (cli *socketClient) recvResponseRoutine(conn io.Reader) {
+func (cli *socketClient) recvResponseRoutine(conn net.Conn) {
+	defer cli.routines.Done()
+
 	r := bufio.NewReader(conn)
 	for {
 		var res = &types.Response{}
 		err := types.ReadMessage(r, res)
 		if err != nil {
-			cli.stopForError(fmt.Errorf("read message: %w", err))
+			cli.stopForConnError(conn, fmt.Errorf("read message: %w", err))
 			return
 		}
 
@@ -180,15 +333,37 @@ func (cli *socketClient) recvResponseRoutine(conn io.Reader) {
 func (cli *socketClient) willSendReq(reqres *ReqRes) {
 	cli.mtx.Lock()
 	defer cli.mtx.Unlock()
-	cli.reqSent.PushBack(reqres)
+	if _, ok := reqres.Request.Value.(*types.Request_DeliverBatch); ok {
+		cli.deliversBatch = true
+	}
+	cli.pushReqSent(reqres)
+}
+
+// pushReqSent adds the request to the requests sent, assigning it an id if the
+// connection is multiplexed.
+func (cli *socketClient) pushReqSent(reqres *ReqRes) {
+	elem := cli.reqSent.PushBack(reqres)
+	if cli.multiplexed {
+		cli.nextRequestID++
+		reqres.Request.RequestId = cli.nextRequestID
+		cli.reqSentByID[cli.nextRequestID] = elem
+	}
 }
 
 func (cli *socketClient) didRecvResponse(res *types.Response) error {
 	cli.mtx.Lock()
 	defer cli.mtx.Unlock()
 
-	// Get the first ReqRes.
+	// Get the ReqRes responded, the first one waiting for a response unless
+	// multiplexed.
 	next := cli.reqSent.Front()
+	for next != nil && next.Value.(*ReqRes).Response != nil {
+		next = next.Next()
+	}
+	if cli.multiplexed {
+		next = cli.reqSentByID[res.RequestId]
+		delete(cli.reqSentByID, res.RequestId)
+	}
 	if next == nil {
 		return fmt.Errorf("unexpected %v when nothing expected", reflect.TypeOf(res.Value))
 	}
@@ -198,25 +373,286 @@ func (cli *socketClient) didRecvResponse(res *types.Response) error {
 		return fmt.Errorf("unexpected %v when response to %v expected",
 			reflect.TypeOf(res.Value), reflect.TypeOf(reqres.Request.Value))
 	}
-
 	reqres.Response = res
-	reqres.Done()            // release waiters
-	cli.reqSent.Remove(next) // pop first item from linked list
 
-	// Notify client listener if set (global callback).
-	if cli.resCb != nil {
-		cli.resCb(reqres.Request, res)
+	// Release the responded requests in order, so that the callbacks get the
+	// responses in the order of the requests even if multiplexed.
+	for next = cli.reqSent.Front(); next != nil; next = cli.reqSent.Front() {
+		reqres := next.Value.(*ReqRes)
+		if reqres.Response == nil {
+			break
+		}
+		// the request ids are specific to the connection
+		reqres.Request.RequestId, reqres.Response.RequestId = 0, 0
+
+		reqres.Done()            // release waiters
+		cli.reqSent.Remove(next) // pop first item from linked list
+		cli.trackBlock(reqres.Request)
+
+		// Notify client listener if set (global callback).
+		if cli.resCb != nil {
+			cli.resCb(reqres.Request, reqres.Response)
+		}
+
+		// Notify reqRes listener if set (request specific callback).
+		//
+		// NOTE: It is possible this callback isn't set on the reqres object. At this
+		// point, in which case it will be called after, when it is set.
+		reqres.InvokeCallback()
+	}
+
+	return nil
+}
+
+// trackBlock keeps track of the responded requests of the block being
+// executed, to replay them on reconnection.
+func (cli *socketClient) trackBlock(req *types.Request) {
+	if !cli.reconnect {
+		return
+	}
+
+	switch r := req.Value.(type) {
+	case *types.Request_InitChain:
+		cli.blockReqs = []*types.Request{req}
+	case *types.Request_BeginBlock:
+		cli.blockReqs = append(cli.blockReqs, req)
+		cli.blockHeight = r.BeginBlock.Header.Height
+	case *types.Request_DeliverTx, *types.Request_DeliverBatch, *types.Request_EndBlock:
+		if len(cli.blockReqs) > 0 {
+			cli.blockReqs = append(cli.blockReqs, req)
+		}
+	case *types.Request_Commit:
+		cli.blockReqs = nil
+		cli.committedHeight = cli.blockHeight
+	}
+}
+
+//----------------------------------------
+
+// stopForConnError stops the client for the error of the connection, or
+// starts reconnecting to the application if the client reconnects.
+func (cli *socketClient) stopForConnError(conn net.Conn, err error) {
+	if !cli.reconnect {
+		cli.stopForError(err)
+		return
 	}
 
-	// Notify reqRes listener if set (request specific callback).
-	//
-	// NOTE: It is possible this callback isn't set on the reqres object. At this
-	// point, in which case it will be called after, when it is set.
-	reqres.InvokeCallback()
+	cli.mtx.Lock()
+	if conn != cli.conn || !cli.connected || !cli.IsRunning() {
+		// the connection is already lost or closed on stop
+		cli.mtx.Unlock()
+		return
+	}
+	cli.connected = false
+	close(cli.connLost)
+	cli.mtx.Unlock()
 
+	conn.Close()
+	cli.metrics.Disconnected.Add(1)
+	cli.Logger.Error("Lost connection to the application, reconnecting", "err", err)
+	cli.connectionChanged(false)
+
+	go cli.reconnectRoutine()
+}
+
+// reconnectRoutine redials the application with a backoff until the
+// connection is resumed.
+func (cli *socketClient) reconnectRoutine() {
+	// wait for the routines of the lost connection to exit, so that the
+	// requests sent and responded don't change meanwhile
+	cli.routines.Wait()
+
+	backoff := minReconnectBackoff
+	for {
+		if backoff > cli.maxBackoff {
+			backoff = cli.maxBackoff
+		}
+		select {
+		case <-time.After(backoff):
+		case <-cli.Quit():
+			return
+		}
+		backoff *= 2
+
+		conn, err := tmnet.Connect(cli.addr)
+		if err != nil {
+			cli.Logger.Error("Failed to reconnect to the application", "addr", cli.addr, "err", err)
+			continue
+		}
+
+		err = cli.resume(conn)
+		var heightErr errAppHeightMismatch
+		switch {
+		case errors.As(err, &heightErr), errors.Is(err, errDeliverBatchUnsupported):
+			conn.Close()
+			cli.metrics.Disconnected.Add(-1)
+			cli.stopForError(err)
+			return
+		case err != nil:
+			conn.Close()
+			cli.Logger.Error("Failed to resume the connection to the application", "err", err)
+			continue
+		}
+
+		cli.metrics.Disconnected.Add(-1)
+		cli.metrics.Reconnects.Add(1)
+		cli.Logger.Info("Reconnected to the application", "addr", cli.addr)
+		cli.connectionChanged(true)
+		return
+	}
+}
+
+// errAppHeightMismatch is returned on reconnection if the height of the
+// application is not the height of the last block committed through the
+// client.
+type errAppHeightMismatch struct {
+	appHeight, committedHeight int64
+}
+
+func (e errAppHeightMismatch) Error() string {
+	return fmt.Sprintf("application height %d doesn't match the last committed height %d",
+		e.appHeight, e.committedHeight)
+}
+
+// errDeliverBatchUnsupported is returned on reconnection if the application
+// doesn't support DeliverBatch anymore, while the client delivers batches.
+var errDeliverBatchUnsupported = errors.New("the application doesn't support DeliverBatch anymore, " +
+	"restart the node to deliver the txs one by one")
+
+// resume checks the height of the application over the new connection,
+// replays the requests of the block being executed, resends the requests
+// which are not responded yet and starts the routines sending and receiving
+// over the connection.
+func (cli *socketClient) resume(conn net.Conn) error {
+	if err := conn.SetDeadline(time.Now().Add(resumeTimeout)); err != nil {
+		return err
+	}
+
+	info, err := cli.handshake(conn)
+	if err != nil {
+		return fmt.Errorf("info: %w", err)
+	}
+	// the height is only known once a block is committed through the client
+	if appHeight := info.LastBlockHeight; cli.committedHeight > 0 && appHeight != cli.committedHeight {
+		return errAppHeightMismatch{appHeight: appHeight, committedHeight: cli.committedHeight}
+	}
+	// the application must keep supporting the batches the client delivers
+	cli.mtx.Lock()
+	deliversBatch := cli.deliversBatch
+	cli.mtx.Unlock()
+	if deliversBatch && !info.DeliverBatch {
+		return errDeliverBatchUnsupported
+	}
+
+	if len(cli.blockReqs) > 0 {
+		cli.Logger.Info("Replaying the requests of the block being executed",
+			"height", cli.blockHeight, "requests", len(cli.blockReqs))
+		if _, err := exchange(conn, cli.blockReqs); err != nil {
+			return fmt.Errorf("replay: %w", err)
+		}
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+
+	// resend the requests waiting for a response, followed by a flush, so
+	// that the application responds to them, with the ids of the connection
+	cli.mtx.Lock()
+	cli.reqSent.PushBack(NewReqRes(types.ToRequestFlush()))
+	cli.reqSentByID = make(map[uint64]*list.Element)
+	w := bufio.NewWriter(conn)
+	for req := cli.reqSent.Front(); req != nil; req = req.Next() {
+		reqres := req.Value.(*ReqRes)
+		if reqres.Response != nil {
+			// responded out of order, before the connection was lost
+			continue
+		}
+		reqres.Request.RequestId = 0
+		if cli.multiplexed {
+			cli.nextRequestID++
+			reqres.Request.RequestId = cli.nextRequestID
+			cli.reqSentByID[cli.nextRequestID] = req
+		}
+		if err := types.WriteMessage(reqres.Request, w); err != nil {
+			cli.mtx.Unlock()
+			return fmt.Errorf("resend: %w", err)
+		}
+	}
+	cli.mtx.Unlock()
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("resend: %w", err)
+	}
+
+	cli.startRoutines(conn)
 	return nil
 }
 
+// negotiate negotiates multiplexing the requests over a new connection.
+func (cli *socketClient) negotiate(conn net.Conn) error {
+	if err := conn.SetDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		return err
+	}
+	if _, err := cli.handshake(conn); err != nil {
+		return err
+	}
+	return conn.SetDeadline(time.Time{})
+}
+
+// handshake sends an Info request over a new connection, negotiating
+// multiplexing the requests if the client multiplexes, and returns the
+// response.
+func (cli *socketClient) handshake(conn net.Conn) (*types.ResponseInfo, error) {
+	req := types.ToRequestInfo(types.RequestInfo{})
+	if cli.multiplex {
+		req.GetInfo().AbciVersion = types.MultiplexedVersion(version.ABCIVersion)
+		req.RequestId = handshakeRequestID
+	}
+
+	ress, err := exchange(conn, []*types.Request{req})
+	if err != nil {
+		return nil, err
+	}
+
+	multiplexed := cli.multiplex && ress[0].RequestId == handshakeRequestID
+	if cli.multiplex && !multiplexed {
+		cli.Logger.Info("The application doesn't support multiplexing, the requests are sent in order")
+	}
+	cli.mtx.Lock()
+	cli.multiplexed = multiplexed
+	cli.mtx.Unlock()
+
+	return ress[0].GetInfo(), nil
+}
+
+// exchange sends the requests followed by a flush over the connection and
+// returns their responses, failing on the exceptions.
+func exchange(conn net.Conn, reqs []*types.Request) ([]*types.Response, error) {
+	w := bufio.NewWriter(conn)
+	for _, req := range append(reqs, types.ToRequestFlush()) {
+		if err := types.WriteMessage(req, w); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	ress := make([]*types.Response, 0, len(reqs)+1)
+	for len(ress) < len(reqs)+1 {
+		res := &types.Response{}
+		if err := types.ReadMessage(r, res); err != nil {
+			return nil, err
+		}
+		if e, ok := res.Value.(*types.Response_Exception); ok {
+			return nil, errors.New(e.Exception.Error)
+		}
+		ress = append(ress, res)
+	}
+	return ress[:len(reqs)], nil
+}
+
 //----------------------------------------
 
 func (cli *socketClient) EchoAsync(msg string) *ReqRes {
@@ -239,6 +675,10 @@ func (cli *socketClient) DeliverTxAsync(req types.RequestDeliverTx) *ReqRes {
 	return cli.queueRequest(types.ToRequestDeliverTx(req))
 }
 
+func (cli *socketClient) DeliverBatchAsync(req types.RequestDeliverBatch) *ReqRes {
+	return cli.queueRequest(types.ToRequestDeliverBatch(req))
+}
+
 func (cli *socketClient) CheckTxAsync(req types.RequestCheckTx) *ReqRes {
 	return cli.queueRequest(types.ToRequestCheckTx(req))
 }
@@ -326,6 +766,15 @@ func (cli *socketClient) DeliverTxSync(req types.RequestDeliverTx) (*types.Respo
 	return reqres.Response.GetDeliverTx(), cli.Error()
 }
 
+func (cli *socketClient) DeliverBatchSync(req types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error) {
+	reqres := cli.queueRequest(types.ToRequestDeliverBatch(req))
+	if err := cli.FlushSync(); err != nil {
+		return nil, err
+	}
+
+	return reqres.Response.GetDeliverBatch(), cli.Error()
+}
+
 func (cli *socketClient) CheckTxSync(req types.RequestCheckTx) (*types.ResponseCheckTx, error) {
 	reqres := cli.queueRequest(types.ToRequestCheckTx(req))
 	if err := cli.FlushSync(); err != nil {
@@ -472,6 +921,8 @@ func resMatchesReq(req *types.Request, res *types.Response) (ok bool) {
 		_, ok = res.Value.(*types.Response_SetOption)
 	case *types.Request_DeliverTx:
 		_, ok = res.Value.(*types.Response_DeliverTx)
+	case *types.Request_DeliverBatch:
+		_, ok = res.Value.(*types.Response_DeliverBatch)
 	case *types.Request_CheckTx:
 		_, ok = res.Value.(*types.Response_CheckTx)
 	case *types.Request_Commit:
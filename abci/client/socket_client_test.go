@@ -1,7 +1,10 @@
 package abcicli_test
 
 import (
+	"bufio"
 	"fmt"
+	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 	"github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	"github.com/tendermint/tendermint/libs/service"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestProperSyncCalls(t *testing.T) {
@@ -118,3 +122,390 @@ func (slowApp) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginBlock
 	time.Sleep(200 * time.Millisecond)
 	return types.ResponseBeginBlock{}
 }
+
+func TestSocketClientReconnect(t *testing.T) {
+	var (
+		port = 20000 + tmrand.Int32()%10000
+		addr = fmt.Sprintf("localhost:%d", port)
+		app1 = &recordingApp{}
+		app2 = &recordingApp{height: 1}
+	)
+
+	s := startServer(t, addr, app1)
+	c := abcicli.NewSocketClient(addr, true, abcicli.SocketClientWithReconnect(50*time.Millisecond))
+	connChanges := make(chan bool, 2)
+	c.(abcicli.ConnectionMonitor).SetConnectionCallback(func(connected bool) { connChanges <- connected })
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+
+	executeBlock(t, c, 1)
+	_, err := c.BeginBlockSync(types.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	require.NoError(t, err)
+	_, err = c.DeliverTxSync(types.RequestDeliverTx{Tx: []byte("tx2")})
+	require.NoError(t, err)
+
+	// the application restarts in the middle of the block
+	require.NoError(t, s.Stop())
+	require.Eventually(t, func() bool {
+		return !c.(abcicli.ConnectionMonitor).IsConnected()
+	}, time.Second, 10*time.Millisecond)
+	assert.NoError(t, c.Error())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.EndBlockSync(types.RequestEndBlock{Height: 2})
+		done <- err
+	}()
+
+	s = startServer(t, addr, app2)
+	t.Cleanup(func() { _ = s.Stop() })
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "EndBlock is not resumed")
+	}
+	_, err = c.CommitSync()
+	require.NoError(t, err)
+	assert.True(t, c.(abcicli.ConnectionMonitor).IsConnected())
+	assert.False(t, <-connChanges)
+	assert.True(t, <-connChanges)
+
+	// the requests of the block are replayed
+	assert.Equal(t, []string{"info", "begin_block 2", "deliver_tx tx2", "end_block", "commit"}, app2.requests())
+}
+
+func TestSocketClientReconnectHeightMismatch(t *testing.T) {
+	var (
+		port = 20000 + tmrand.Int32()%10000
+		addr = fmt.Sprintf("localhost:%d", port)
+	)
+
+	s := startServer(t, addr, &recordingApp{})
+	c := abcicli.NewSocketClient(addr, true, abcicli.SocketClientWithReconnect(50*time.Millisecond))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+
+	executeBlock(t, c, 1)
+
+	// the application restarts at another height
+	require.NoError(t, s.Stop())
+	s = startServer(t, addr, &recordingApp{height: 5})
+	t.Cleanup(func() { _ = s.Stop() })
+
+	require.Eventually(t, func() bool { return !c.IsRunning() }, 5*time.Second, 10*time.Millisecond)
+	require.Error(t, c.Error())
+	assert.Contains(t, c.Error().Error(), "doesn't match the last committed height 1")
+}
+
+func TestSocketClientReconnectDeliverBatchUnsupported(t *testing.T) {
+	var (
+		port = 20000 + tmrand.Int32()%10000
+		addr = fmt.Sprintf("localhost:%d", port)
+	)
+
+	s := startServer(t, addr, batchApp{})
+	c := abcicli.NewSocketClient(addr, true, abcicli.SocketClientWithReconnect(50*time.Millisecond))
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+
+	_, err := c.DeliverBatchSync(types.RequestDeliverBatch{Txs: []types.RequestDeliverTx{{Tx: []byte("a")}}})
+	require.NoError(t, err)
+
+	// the application restarts without reporting DeliverBatch
+	require.NoError(t, s.Stop())
+	s = startServer(t, addr, &recordingApp{})
+	t.Cleanup(func() { _ = s.Stop() })
+
+	require.Eventually(t, func() bool { return !c.IsRunning() }, 5*time.Second, 10*time.Millisecond)
+	require.Error(t, c.Error())
+	assert.Contains(t, c.Error().Error(), "doesn't support DeliverBatch anymore")
+}
+
+func TestSocketClientMultiplexing(t *testing.T) {
+	var (
+		port = 20000 + tmrand.Int32()%10000
+		addr = fmt.Sprintf("localhost:%d", port)
+		app  = &blockingCheckTxApp{unblock: make(chan struct{})}
+	)
+
+	s := server.NewSocketServer(addr, app, server.SocketServerWithConcurrentCheckTx(2))
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { _ = s.Stop() })
+	c := abcicli.NewSocketClient(addr, true, abcicli.SocketClientWithMultiplexing())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+
+	var (
+		mtx     sync.Mutex
+		checked []string
+	)
+	c.SetResponseCallback(func(req *types.Request, res *types.Response) {
+		if res.GetCheckTx() != nil {
+			mtx.Lock()
+			defer mtx.Unlock()
+			checked = append(checked, string(res.GetCheckTx().Data))
+			assert.Zero(t, req.RequestId)
+			assert.Zero(t, res.RequestId)
+		}
+	})
+
+	// the first tx is checked once the second one is, which only completes if
+	// they are checked concurrently
+	done := make(chan error, 1)
+	go func() {
+		c.CheckTxAsync(types.RequestCheckTx{Tx: []byte("blocked")})
+		c.CheckTxAsync(types.RequestCheckTx{Tx: []byte("unblocking")})
+		done <- c.FlushSync()
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "CheckTx requests are not processed concurrently")
+	}
+
+	// but the responses are delivered in order
+	mtx.Lock()
+	assert.Equal(t, []string{"blocked", "unblocking"}, checked)
+	mtx.Unlock()
+
+	_, err := c.CommitSync()
+	require.NoError(t, err)
+}
+
+func TestSocketClientMultiplexingCheckTxPanic(t *testing.T) {
+	var (
+		port = 20000 + tmrand.Int32()%10000
+		addr = fmt.Sprintf("localhost:%d", port)
+	)
+
+	s := server.NewSocketServer(addr, panickingCheckTxApp{}, server.SocketServerWithConcurrentCheckTx(2))
+	require.NoError(t, s.Start())
+	t.Cleanup(func() { _ = s.Stop() })
+
+	// the server closes the connection on the panic
+	c := abcicli.NewSocketClient(addr, true, abcicli.SocketClientWithMultiplexing())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+	c.CheckTxAsync(types.RequestCheckTx{Tx: []byte("panic")})
+	_ = c.FlushSync()
+	require.Eventually(t, func() bool { return !c.IsRunning() }, 5*time.Second, 10*time.Millisecond)
+
+	// but the application remains usable on the other connections
+	c = abcicli.NewSocketClient(addr, true, abcicli.SocketClientWithMultiplexing())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.CommitSync()
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Commit is blocked by the panicked CheckTx")
+	}
+}
+
+func TestSocketClientMultiplexingUnsupported(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	go serveInOrder(ln)
+
+	c := abcicli.NewSocketClient(ln.Addr().String(), true, abcicli.SocketClientWithMultiplexing())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+
+	reqres1 := c.CheckTxAsync(types.RequestCheckTx{Tx: []byte("tx1")})
+	reqres2 := c.CheckTxAsync(types.RequestCheckTx{Tx: []byte("tx2")})
+	require.NoError(t, c.FlushSync())
+	assert.Equal(t, "tx1", string(reqres1.Response.GetCheckTx().Data))
+	assert.Equal(t, "tx2", string(reqres2.Response.GetCheckTx().Data))
+}
+
+// serveInOrder serves the first connection accepted like the servers not
+// supporting multiplexing, which ignore the request ids.
+func serveInOrder(ln net.Listener) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r, w := bufio.NewReader(conn), bufio.NewWriter(conn)
+	for {
+		req := &types.Request{}
+		if err := types.ReadMessage(r, req); err != nil {
+			return
+		}
+		var res *types.Response
+		switch req := req.Value.(type) {
+		case *types.Request_Info:
+			res = types.ToResponseInfo(types.ResponseInfo{})
+		case *types.Request_CheckTx:
+			res = types.ToResponseCheckTx(types.ResponseCheckTx{Data: req.CheckTx.Tx})
+		case *types.Request_Flush:
+			res = types.ToResponseFlush()
+		default:
+			res = types.ToResponseException("unexpected request")
+		}
+		if err := types.WriteMessage(res, w); err != nil {
+			return
+		}
+		if res.GetFlush() != nil {
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func TestSocketClientDeliverBatch(t *testing.T) {
+	req := types.RequestDeliverBatch{Txs: []types.RequestDeliverTx{{Tx: []byte("a")}, {Tx: []byte("b")}}}
+
+	testCases := map[string]struct {
+		app  types.Application
+		data string
+	}{
+		"batch application": {&batchApp{}, "batch"},
+		// the txs are delivered one by one to applications not supporting it
+		"fallback": {echoDeliverTxApp{}, "tx"},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s, c := setupClientServer(t, tc.app)
+			t.Cleanup(func() {
+				if err := c.Stop(); err != nil {
+					t.Error(err)
+				}
+				if err := s.Stop(); err != nil {
+					t.Error(err)
+				}
+			})
+
+			res, err := c.DeliverBatchSync(req)
+			require.NoError(t, err)
+			require.Len(t, res.Txs, 2)
+			for i, txRes := range res.Txs {
+				assert.Equal(t, tc.data+":"+string(req.Txs[i].Tx), string(txRes.Data))
+			}
+		})
+	}
+}
+
+// batchApp delivers the txs of a batch at once.
+type batchApp struct {
+	types.BaseApplication
+}
+
+func (batchApp) DeliverBatch(req types.RequestDeliverBatch) types.ResponseDeliverBatch {
+	txs := make([]*types.ResponseDeliverTx, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = &types.ResponseDeliverTx{Data: []byte("batch:" + string(tx.Tx))}
+	}
+	return types.ResponseDeliverBatch{Txs: txs}
+}
+
+// echoDeliverTxApp responds to DeliverTx with the tx.
+type echoDeliverTxApp struct {
+	types.BaseApplication
+}
+
+func (echoDeliverTxApp) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
+	return types.ResponseDeliverTx{Data: []byte("tx:" + string(req.Tx))}
+}
+
+// blockingCheckTxApp blocks checking the "blocked" tx until the "unblocking"
+// tx is checked.
+type blockingCheckTxApp struct {
+	types.BaseApplication
+
+	unblock chan struct{}
+}
+
+func (app *blockingCheckTxApp) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
+	switch string(req.Tx) {
+	case "blocked":
+		<-app.unblock
+	case "unblocking":
+		close(app.unblock)
+	}
+	return types.ResponseCheckTx{Data: req.Tx}
+}
+
+// panickingCheckTxApp panics checking any tx.
+type panickingCheckTxApp struct {
+	types.BaseApplication
+}
+
+func (panickingCheckTxApp) CheckTx(req types.RequestCheckTx) types.ResponseCheckTx {
+	panic("check tx")
+}
+
+func startServer(t *testing.T, addr string, app types.Application) service.Service {
+	s, err := server.NewServer(addr, "socket", app)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	return s
+}
+
+func executeBlock(t *testing.T, c abcicli.Client, height int64) {
+	_, err := c.BeginBlockSync(types.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+	require.NoError(t, err)
+	_, err = c.DeliverTxSync(types.RequestDeliverTx{Tx: []byte(fmt.Sprintf("tx%d", height))})
+	require.NoError(t, err)
+	_, err = c.EndBlockSync(types.RequestEndBlock{Height: height})
+	require.NoError(t, err)
+	_, err = c.CommitSync()
+	require.NoError(t, err)
+}
+
+// recordingApp records the requests of the blocks it is sent.
+type recordingApp struct {
+	types.BaseApplication
+
+	mtx    sync.Mutex
+	height int64
+	reqs   []string
+}
+
+func (app *recordingApp) record(req string) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.reqs = append(app.reqs, req)
+}
+
+func (app *recordingApp) requests() []string {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	return app.reqs
+}
+
+func (app *recordingApp) Info(types.RequestInfo) types.ResponseInfo {
+	app.record("info")
+	return types.ResponseInfo{LastBlockHeight: app.height}
+}
+
+func (app *recordingApp) BeginBlock(req types.RequestBeginBlock) types.ResponseBeginBlock {
+	app.record(fmt.Sprintf("begin_block %d", req.Header.Height))
+	return types.ResponseBeginBlock{}
+}
+
+func (app *recordingApp) DeliverTx(req types.RequestDeliverTx) types.ResponseDeliverTx {
+	app.record(fmt.Sprintf("deliver_tx %s", req.Tx))
+	return types.ResponseDeliverTx{}
+}
+
+func (app *recordingApp) EndBlock(types.RequestEndBlock) types.ResponseEndBlock {
+	app.record("end_block")
+	return types.ResponseEndBlock{}
+}
+
+func (app *recordingApp) Commit() types.ResponseCommit {
+	app.record("commit")
+	return types.ResponseCommit{}
+}
@@ -9,10 +9,12 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/proxy"
 
 	abcicli "github.com/tendermint/tendermint/abci/client"
 	"github.com/tendermint/tendermint/abci/example/code"
@@ -154,6 +156,7 @@ func addCommands() {
 	RootCmd.AddCommand(commitCmd)
 	RootCmd.AddCommand(versionCmd)
 	RootCmd.AddCommand(testCmd)
+	RootCmd.AddCommand(replayCmd)
 	addQueryFlags()
 	RootCmd.AddCommand(queryCmd)
 
@@ -291,6 +294,23 @@ var testCmd = &cobra.Command{
 	RunE:  cmdTest,
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "replay a recording of the ABCI traffic against an application",
+	Long: `replay a recording of the ABCI traffic against an application
+
+This command sends the requests of a recording of a connection to the
+application, made by a node with abci_record_dir set, to the application, and
+prints the responses which differ from the recorded ones:
+
+    abci-cli replay consensus.abcirec
+
+The command fails if any response differs.
+`,
+	Args: cobra.ExactArgs(1),
+	RunE: cmdReplay,
+}
+
 // Generates new Args array based off of previous call args to maintain flag persistence
 func persistentArgs(line []byte) []string {
 
@@ -348,6 +368,95 @@ func cmdTest(cmd *cobra.Command, args []string) error {
 		})
 }
 
+func cmdReplay(cmd *cobra.Command, args []string) error {
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var (
+		reader = proxy.NewRecordingReader(file)
+		count  int
+		diffs  int
+	)
+	for {
+		req, recorded, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read request %d: %w", count+1, err)
+		}
+		count++
+
+		replayed, err := execRequest(req)
+		if err != nil {
+			return fmt.Errorf("failed to replay request %d: %w", count, err)
+		}
+		if !proto.Equal(recorded, replayed) {
+			diffs++
+			fmt.Printf("request %d (%T): the responses differ\n", count, req.Value)
+			fmt.Printf("  request:  %v\n", req)
+			fmt.Printf("  recorded: %v\n", recorded)
+			fmt.Printf("  replayed: %v\n", replayed)
+		}
+	}
+
+	fmt.Printf("replayed %d requests, %d responses differ\n", count, diffs)
+	if diffs > 0 {
+		return fmt.Errorf("%d responses differ", diffs)
+	}
+	return nil
+}
+
+// execRequest sends the request to the application and returns its response.
+func execRequest(req *types.Request) (*types.Response, error) {
+	var reqres *abcicli.ReqRes
+	switch r := req.Value.(type) {
+	case *types.Request_Echo:
+		reqres = client.EchoAsync(r.Echo.Message)
+	case *types.Request_Flush:
+		reqres = client.FlushAsync()
+	case *types.Request_Info:
+		reqres = client.InfoAsync(*r.Info)
+	case *types.Request_SetOption:
+		reqres = client.SetOptionAsync(*r.SetOption)
+	case *types.Request_DeliverTx:
+		reqres = client.DeliverTxAsync(*r.DeliverTx)
+	case *types.Request_DeliverBatch:
+		reqres = client.DeliverBatchAsync(*r.DeliverBatch)
+	case *types.Request_CheckTx:
+		reqres = client.CheckTxAsync(*r.CheckTx)
+	case *types.Request_Query:
+		reqres = client.QueryAsync(*r.Query)
+	case *types.Request_Commit:
+		reqres = client.CommitAsync()
+	case *types.Request_InitChain:
+		reqres = client.InitChainAsync(*r.InitChain)
+	case *types.Request_BeginBlock:
+		reqres = client.BeginBlockAsync(*r.BeginBlock)
+	case *types.Request_EndBlock:
+		reqres = client.EndBlockAsync(*r.EndBlock)
+	case *types.Request_ListSnapshots:
+		reqres = client.ListSnapshotsAsync(*r.ListSnapshots)
+	case *types.Request_OfferSnapshot:
+		reqres = client.OfferSnapshotAsync(*r.OfferSnapshot)
+	case *types.Request_LoadSnapshotChunk:
+		reqres = client.LoadSnapshotChunkAsync(*r.LoadSnapshotChunk)
+	case *types.Request_ApplySnapshotChunk:
+		reqres = client.ApplySnapshotChunkAsync(*r.ApplySnapshotChunk)
+	default:
+		return nil, fmt.Errorf("unknown request %T", req.Value)
+	}
+
+	if err := client.FlushSync(); err != nil {
+		return nil, err
+	}
+	reqres.Wait()
+	return reqres.Response, client.Error()
+}
+
 func cmdBatch(cmd *cobra.Command, args []string) error {
 	bufReader := bufio.NewReader(os.Stdin)
 LOOP:
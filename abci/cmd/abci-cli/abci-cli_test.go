@@ -0,0 +1,57 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/example/counter"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+func TestReplay(t *testing.T) {
+	// record the traffic with a counter, which accepts any nonce
+	dir := t.TempDir()
+	creator := proxy.NewRecordingClientCreator(proxy.NewLocalClientCreator(counter.NewApplication(false)), dir)
+	recorded, err := creator.NewABCIClient()
+	require.NoError(t, err)
+	require.NoError(t, recorded.Start())
+	for _, tx := range [][]byte{{0x00}, {0x05}} {
+		_, err = recorded.DeliverTxSync(types.RequestDeliverTx{Tx: tx})
+		require.NoError(t, err)
+	}
+	_, err = recorded.CommitSync()
+	require.NoError(t, err)
+	require.NoError(t, recorded.Stop())
+	recording := filepath.Join(dir, "client1"+proxy.RecordingExt)
+
+	testCases := []struct {
+		name   string
+		serial bool
+		err    string
+	}{
+		{"same application", false, ""},
+		// the serial counter rejects the out of order nonce and doesn't count it
+		{"different application", true, "2 responses differ"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			client = abcicli.NewLocalClient(nil, counter.NewApplication(tc.serial))
+			client.SetResponseCallback(func(*types.Request, *types.Response) {})
+			require.NoError(t, client.Start())
+			t.Cleanup(func() { _ = client.Stop() })
+
+			err := cmdReplay(replayCmd, []string{recording})
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
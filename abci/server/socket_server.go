@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"runtime"
+	"sync"
 
 	"github.com/tendermint/tendermint/abci/types"
 	tmlog "github.com/tendermint/tendermint/libs/log"
@@ -17,6 +18,20 @@ import (
 
 // var maxNumberConnections = 2
 
+// SocketServerOption sets an optional parameter on the socket server.
+type SocketServerOption func(*SocketServer)
+
+// SocketServerWithConcurrentCheckTx makes the server process up to workers
+// CheckTx requests concurrently on the connections multiplexed by the clients,
+// and respond to them as they complete. The other requests wait for the
+// CheckTx requests received before them, and are processed in order.
+//
+// The application must support concurrent CheckTx calls. They are never
+// concurrent with the other calls.
+func SocketServerWithConcurrentCheckTx(workers int) SocketServerOption {
+	return func(s *SocketServer) { s.checkTxWorkers = workers }
+}
+
 type SocketServer struct {
 	service.BaseService
 	isLoggerSet bool
@@ -29,11 +44,15 @@ type SocketServer struct {
 	conns      map[int]net.Conn
 	nextConnID int
 
-	appMtx tmsync.Mutex
+	appMtx tmsync.RWMutex
 	app    types.Application
+
+	// checkTxWorkers is the number of CheckTx requests processed concurrently
+	// per multiplexed connection, 0 to process them in order.
+	checkTxWorkers int
 }
 
-func NewSocketServer(protoAddr string, app types.Application) service.Service {
+func NewSocketServer(protoAddr string, app types.Application, options ...SocketServerOption) service.Service {
 	proto, addr := tmnet.ProtocolAndAddress(protoAddr)
 	s := &SocketServer{
 		proto:    proto,
@@ -42,6 +61,9 @@ func NewSocketServer(protoAddr string, app types.Application) service.Service {
 		app:      app,
 		conns:    make(map[int]net.Conn),
 	}
+	for _, option := range options {
+		option(s)
+	}
 	s.BaseService = *service.NewBaseService(nil, "ABCIServer", s)
 	return s
 }
@@ -121,20 +143,22 @@ func (s *SocketServer) acceptConnectionsRoutine() {
 		connID := s.addConn(conn)
 
 		closeConn := make(chan error, 2)              // Push to signal connection closed
+		closed := make(chan struct{})                 // Closed once the connection is closed
 		responses := make(chan *types.Response, 1000) // A channel to buffer responses
 
 		// Read requests from conn and deal with them
-		go s.handleRequests(closeConn, conn, responses)
+		go s.handleRequests(closeConn, closed, conn, responses)
 		// Pull responses from 'responses' and write them to conn.
-		go s.handleResponses(closeConn, conn, responses)
+		go s.handleResponses(closeConn, closed, conn, responses)
 
 		// Wait until signal to close connection
-		go s.waitForClose(closeConn, connID)
+		go s.waitForClose(closeConn, closed, connID)
 	}
 }
 
-func (s *SocketServer) waitForClose(closeConn chan error, connID int) {
+func (s *SocketServer) waitForClose(closeConn chan error, closed chan struct{}, connID int) {
 	err := <-closeConn
+	close(closed)
 	switch {
 	case err == io.EOF:
 		s.Logger.Error("Connection was closed by client")
@@ -152,22 +176,27 @@ func (s *SocketServer) waitForClose(closeConn chan error, connID int) {
 }
 
 // Read requests from conn and deal with them
-func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, responses chan<- *types.Response) {
+func (s *SocketServer) handleRequests(
+	closeConn chan error,
+	closed <-chan struct{},
+	conn io.Reader,
+	responses chan<- *types.Response,
+) {
 	var count int
 	var bufReader = bufio.NewReader(conn)
 
+	// multiplexed is set once the client negotiates multiplexing the requests
+	var (
+		multiplexed bool
+		checkTxs    sync.WaitGroup // CheckTx requests being processed concurrently
+		workers     = make(chan struct{}, s.checkTxWorkers)
+	)
+
 	defer func() {
 		// make sure to recover from any app-related panics to allow proper socket cleanup
 		r := recover()
 		if r != nil {
-			const size = 64 << 10
-			buf := make([]byte, size)
-			buf = buf[:runtime.Stack(buf, false)]
-			err := fmt.Errorf("recovered from panic: %v\n%s", r, buf)
-			if !s.isLoggerSet {
-				fmt.Fprintln(os.Stderr, err)
-			}
-			closeConn <- err
+			s.closeForPanic(closeConn, r)
 			s.appMtx.Unlock()
 		}
 	}()
@@ -184,69 +213,156 @@ func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, resp
 			}
 			return
 		}
+
+		if multiplexed && s.checkTxWorkers > 0 {
+			if _, ok := req.Value.(*types.Request_CheckTx); ok {
+				workers <- struct{}{}
+				checkTxs.Add(1)
+				go func() {
+					defer func() {
+						<-workers
+						checkTxs.Done()
+					}()
+					s.handleCheckTx(closeConn, closed, req, responses)
+				}()
+				continue
+			}
+			checkTxs.Wait()
+		}
+
 		s.appMtx.Lock()
 		count++
-		s.handleRequest(req, responses)
+		res := s.handleRequest(req)
 		s.appMtx.Unlock()
+
+		if info := req.GetInfo(); info != nil && req.RequestId != 0 && types.IsMultiplexedVersion(info.AbciVersion) {
+			multiplexed = true
+		}
+		if multiplexed {
+			res.RequestId = req.RequestId
+		}
+		select {
+		case responses <- res:
+		case <-closed:
+			return
+		}
 	}
 }
 
-func (s *SocketServer) handleRequest(req *types.Request, responses chan<- *types.Response) {
+// handleCheckTx processes a CheckTx request concurrently with the other
+// CheckTx requests of a multiplexed connection.
+func (s *SocketServer) handleCheckTx(
+	closeConn chan error,
+	closed <-chan struct{},
+	req *types.Request,
+	responses chan<- *types.Response,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.closeForPanic(closeConn, r)
+		}
+	}()
+
+	res := s.checkTx(req)
+	res.RequestId = req.RequestId
+	select {
+	case responses <- res:
+	case <-closed:
+	}
+}
+
+// checkTx calls CheckTx on the application, holding the read lock.
+func (s *SocketServer) checkTx(req *types.Request) *types.Response {
+	s.appMtx.RLock()
+	defer s.appMtx.RUnlock()
+	return types.ToResponseCheckTx(s.app.CheckTx(*req.GetCheckTx()))
+}
+
+// closeForPanic closes the connection for an app-related panic.
+func (s *SocketServer) closeForPanic(closeConn chan error, r interface{}) {
+	const size = 64 << 10
+	buf := make([]byte, size)
+	buf = buf[:runtime.Stack(buf, false)]
+	err := fmt.Errorf("recovered from panic: %v\n%s", r, buf)
+	if !s.isLoggerSet {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	select {
+	case closeConn <- err:
+	default:
+		// the connection is already being closed
+	}
+}
+
+func (s *SocketServer) handleRequest(req *types.Request) *types.Response {
 	switch r := req.Value.(type) {
 	case *types.Request_Echo:
-		responses <- types.ToResponseEcho(r.Echo.Message)
+		return types.ToResponseEcho(r.Echo.Message)
 	case *types.Request_Flush:
-		responses <- types.ToResponseFlush()
+		return types.ToResponseFlush()
 	case *types.Request_Info:
 		res := s.app.Info(*r.Info)
-		responses <- types.ToResponseInfo(res)
+		return types.ToResponseInfo(res)
 	case *types.Request_SetOption:
 		res := s.app.SetOption(*r.SetOption)
-		responses <- types.ToResponseSetOption(res)
+		return types.ToResponseSetOption(res)
 	case *types.Request_DeliverTx:
 		res := s.app.DeliverTx(*r.DeliverTx)
-		responses <- types.ToResponseDeliverTx(res)
+		return types.ToResponseDeliverTx(res)
+	case *types.Request_DeliverBatch:
+		res := types.DeliverBatch(s.app, *r.DeliverBatch)
+		return types.ToResponseDeliverBatch(res)
 	case *types.Request_CheckTx:
 		res := s.app.CheckTx(*r.CheckTx)
-		responses <- types.ToResponseCheckTx(res)
+		return types.ToResponseCheckTx(res)
 	case *types.Request_Commit:
 		res := s.app.Commit()
-		responses <- types.ToResponseCommit(res)
+		return types.ToResponseCommit(res)
 	case *types.Request_Query:
 		res := s.app.Query(*r.Query)
-		responses <- types.ToResponseQuery(res)
+		return types.ToResponseQuery(res)
 	case *types.Request_InitChain:
 		res := s.app.InitChain(*r.InitChain)
-		responses <- types.ToResponseInitChain(res)
+		return types.ToResponseInitChain(res)
 	case *types.Request_BeginBlock:
 		res := s.app.BeginBlock(*r.BeginBlock)
-		responses <- types.ToResponseBeginBlock(res)
+		return types.ToResponseBeginBlock(res)
 	case *types.Request_EndBlock:
 		res := s.app.EndBlock(*r.EndBlock)
-		responses <- types.ToResponseEndBlock(res)
+		return types.ToResponseEndBlock(res)
 	case *types.Request_ListSnapshots:
 		res := s.app.ListSnapshots(*r.ListSnapshots)
-		responses <- types.ToResponseListSnapshots(res)
+		return types.ToResponseListSnapshots(res)
 	case *types.Request_OfferSnapshot:
 		res := s.app.OfferSnapshot(*r.OfferSnapshot)
-		responses <- types.ToResponseOfferSnapshot(res)
+		return types.ToResponseOfferSnapshot(res)
 	case *types.Request_LoadSnapshotChunk:
 		res := s.app.LoadSnapshotChunk(*r.LoadSnapshotChunk)
-		responses <- types.ToResponseLoadSnapshotChunk(res)
+		return types.ToResponseLoadSnapshotChunk(res)
 	case *types.Request_ApplySnapshotChunk:
 		res := s.app.ApplySnapshotChunk(*r.ApplySnapshotChunk)
-		responses <- types.ToResponseApplySnapshotChunk(res)
+		return types.ToResponseApplySnapshotChunk(res)
 	default:
-		responses <- types.ToResponseException("Unknown request")
+		return types.ToResponseException("Unknown request")
 	}
 }
 
 // Pull responses from 'responses' and write them to conn.
-func (s *SocketServer) handleResponses(closeConn chan error, conn io.Writer, responses <-chan *types.Response) {
+func (s *SocketServer) handleResponses(
+	closeConn chan error,
+	closed <-chan struct{},
+	conn io.Writer,
+	responses <-chan *types.Response,
+) {
 	var count int
 	var bufWriter = bufio.NewWriter(conn)
 	for {
-		var res = <-responses
+		var res *types.Response
+		select {
+		case res = <-responses:
+		case <-closed:
+			return
+		}
 		err := types.WriteMessage(res, bufWriter)
 		if err != nil {
 			closeConn <- fmt.Errorf("error writing message: %w", err)
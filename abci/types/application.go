@@ -31,6 +31,30 @@ type Application interface {
 	ApplySnapshotChunk(RequestApplySnapshotChunk) ResponseApplySnapshotChunk // Apply a shapshot chunk
 }
 
+// BatchApplication is an Application, which processes the txs of a block at
+// once. It advertises it by setting DeliverBatch in ResponseInfo, so that
+// DeliverBatch is called instead of DeliverTx.
+type BatchApplication interface {
+	Application
+
+	// Consensus Connection
+	DeliverBatch(RequestDeliverBatch) ResponseDeliverBatch // Deliver the txs of a block for full processing
+}
+
+// DeliverBatch delivers the txs to the application at once if it's a
+// BatchApplication, or one by one otherwise.
+func DeliverBatch(app Application, req RequestDeliverBatch) ResponseDeliverBatch {
+	if app, ok := app.(BatchApplication); ok {
+		return app.DeliverBatch(req)
+	}
+	res := ResponseDeliverBatch{Txs: make([]*ResponseDeliverTx, len(req.Txs))}
+	for i, tx := range req.Txs {
+		txRes := app.DeliverTx(tx)
+		res.Txs[i] = &txRes
+	}
+	return res
+}
+
 //-------------------------------------------------------
 // BaseApplication is a base form of Application
 
@@ -129,6 +153,11 @@ func (app *GRPCApplication) DeliverTx(ctx context.Context, req *RequestDeliverTx
 	return &res, nil
 }
 
+func (app *GRPCApplication) DeliverBatch(ctx context.Context, req *RequestDeliverBatch) (*ResponseDeliverBatch, error) {
+	res := DeliverBatch(app.app, *req)
+	return &res, nil
+}
+
 func (app *GRPCApplication) CheckTx(ctx context.Context, req *RequestCheckTx) (*ResponseCheckTx, error) {
 	res := app.app.CheckTx(*req)
 	return &res, nil
@@ -9,7 +9,10 @@ import (
 )
 
 const (
-	maxMsgSize = 104857600 // 100MB
+	// maxMsgSize fits the DeliverBatch request of a block of the maximum size
+	// (100MB). Every tx is framed again in the request, which adds at most 2/3
+	// of the size the smallest tx takes in the block.
+	maxMsgSize = 209715200 // 200MB
 )
 
 // WriteMessage writes a varint length-delimited protobuf message.
@@ -99,6 +102,12 @@ func ToRequestDeliverTx(req RequestDeliverTx) *Request {
 	}
 }
 
+func ToRequestDeliverBatch(req RequestDeliverBatch) *Request {
+	return &Request{
+		Value: &Request_DeliverBatch{&req},
+	}
+}
+
 func ToRequestCheckTx(req RequestCheckTx) *Request {
 	return &Request{
 		Value: &Request_CheckTx{&req},
@@ -197,6 +206,12 @@ func ToResponseDeliverTx(res ResponseDeliverTx) *Response {
 	}
 }
 
+func ToResponseDeliverBatch(res ResponseDeliverBatch) *Response {
+	return &Response{
+		Value: &Response_DeliverBatch{&res},
+	}
+}
+
 func ToResponseCheckTx(res ResponseCheckTx) *Response {
 	return &Response{
 		Value: &Response_CheckTx{&res},
@@ -3,11 +3,13 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -112,3 +114,24 @@ func TestWriteReadMessage2(t *testing.T) {
 		assert.True(t, proto.Equal(c, msg))
 	}
 }
+
+func TestReadMessageMaxSize(t *testing.T) {
+	// the DeliverBatch request of a block of 1-byte txs takes 5/3 of the size
+	// of the txs in the block, 3 bytes each
+	req := ToRequestDeliverBatch(RequestDeliverBatch{Txs: make([]RequestDeliverTx, 1000)})
+	for i := range req.GetDeliverBatch().Txs {
+		req.GetDeliverBatch().Txs[i].Tx = []byte{byte(i)}
+	}
+	assert.LessOrEqual(t, req.Size(), 5*1000+6)
+
+	// so that a message larger than a block of the maximum size is read
+	for size, expected := range map[int64]error{
+		150 * 1024 * 1024: io.EOF, // the message is missing
+		maxMsgSize + 1:    io.ErrShortBuffer,
+	} {
+		buf := new(bytes.Buffer)
+		require.NoError(t, encodeVarint(buf, size))
+		err := ReadMessage(buf, new(Request))
+		assert.Equal(t, expected, err, "size %d", size)
+	}
+}
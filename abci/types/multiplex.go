@@ -0,0 +1,25 @@
+package types
+
+import "strings"
+
+// multiplexSuffix is appended to RequestInfo.AbciVersion by the socket
+// clients, which support multiplexing the requests over the connection.
+const multiplexSuffix = "+multiplex"
+
+// MultiplexedVersion returns the ABCI version, which the socket clients send
+// in RequestInfo.AbciVersion to negotiate multiplexing the requests over the
+// connection.
+//
+// The client sends it in the first request over the connection, along with a
+// non-zero request id. A server supporting multiplexing responds with the
+// request id set, and sets the request ids on all its later responses. An
+// older server ignores the request id, and the connection stays in order.
+func MultiplexedVersion(abciVersion string) string {
+	return abciVersion + multiplexSuffix
+}
+
+// IsMultiplexedVersion returns true if the ABCI version is sent by a client
+// negotiating multiplexing.
+func IsMultiplexedVersion(abciVersion string) bool {
+	return strings.HasSuffix(abciVersion, multiplexSuffix)
+}
@@ -64,18 +64,24 @@ const (
 	EvidenceType_UNKNOWN             EvidenceType = 0
 	EvidenceType_DUPLICATE_VOTE      EvidenceType = 1
 	EvidenceType_LIGHT_CLIENT_ATTACK EvidenceType = 2
+	EvidenceType_INVALID_CHAIN_LOCK  EvidenceType = 3
+	EvidenceType_DUPLICATE_PROPOSAL  EvidenceType = 4
 )
 
 var EvidenceType_name = map[int32]string{
 	0: "UNKNOWN",
 	1: "DUPLICATE_VOTE",
 	2: "LIGHT_CLIENT_ATTACK",
+	3: "INVALID_CHAIN_LOCK",
+	4: "DUPLICATE_PROPOSAL",
 }
 
 var EvidenceType_value = map[string]int32{
 	"UNKNOWN":             0,
 	"DUPLICATE_VOTE":      1,
 	"LIGHT_CLIENT_ATTACK": 2,
+	"INVALID_CHAIN_LOCK":  3,
+	"DUPLICATE_PROPOSAL":  4,
 }
 
 func (x EvidenceType) String() string {
@@ -120,7 +126,7 @@ func (x ResponseOfferSnapshot_Result) String() string {
 }
 
 func (ResponseOfferSnapshot_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{30, 0}
+	return fileDescriptor_252557cfdd89a31a, []int{32, 0}
 }
 
 type ResponseApplySnapshotChunk_Result int32
@@ -157,7 +163,7 @@ func (x ResponseApplySnapshotChunk_Result) String() string {
 }
 
 func (ResponseApplySnapshotChunk_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{32, 0}
+	return fileDescriptor_252557cfdd89a31a, []int{34, 0}
 }
 
 type Request struct {
@@ -177,7 +183,11 @@ type Request struct {
 	//	*Request_OfferSnapshot
 	//	*Request_LoadSnapshotChunk
 	//	*Request_ApplySnapshotChunk
+	//	*Request_DeliverBatch
 	Value isRequest_Value `protobuf_oneof:"value"`
+	// request_id correlates the request with its response on a multiplexed
+	// connection, 0 otherwise.
+	RequestId uint64 `protobuf:"varint,100,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
 func (m *Request) Reset()         { *m = Request{} }
@@ -264,6 +274,9 @@ type Request_LoadSnapshotChunk struct {
 type Request_ApplySnapshotChunk struct {
 	ApplySnapshotChunk *RequestApplySnapshotChunk `protobuf:"bytes,15,opt,name=apply_snapshot_chunk,json=applySnapshotChunk,proto3,oneof" json:"apply_snapshot_chunk,omitempty"`
 }
+type Request_DeliverBatch struct {
+	DeliverBatch *RequestDeliverBatch `protobuf:"bytes,16,opt,name=deliver_batch,json=deliverBatch,proto3,oneof" json:"deliver_batch,omitempty"`
+}
 
 func (*Request_Echo) isRequest_Value()               {}
 func (*Request_Flush) isRequest_Value()              {}
@@ -280,6 +293,7 @@ func (*Request_ListSnapshots) isRequest_Value()      {}
 func (*Request_OfferSnapshot) isRequest_Value()      {}
 func (*Request_LoadSnapshotChunk) isRequest_Value()  {}
 func (*Request_ApplySnapshotChunk) isRequest_Value() {}
+func (*Request_DeliverBatch) isRequest_Value()       {}
 
 func (m *Request) GetValue() isRequest_Value {
 	if m != nil {
@@ -393,6 +407,20 @@ func (m *Request) GetApplySnapshotChunk() *RequestApplySnapshotChunk {
 	return nil
 }
 
+func (m *Request) GetDeliverBatch() *RequestDeliverBatch {
+	if x, ok := m.GetValue().(*Request_DeliverBatch); ok {
+		return x.DeliverBatch
+	}
+	return nil
+}
+
+func (m *Request) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Request) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -411,6 +439,7 @@ func (*Request) XXX_OneofWrappers() []interface{} {
 		(*Request_OfferSnapshot)(nil),
 		(*Request_LoadSnapshotChunk)(nil),
 		(*Request_ApplySnapshotChunk)(nil),
+		(*Request_DeliverBatch)(nil),
 	}
 }
 
@@ -498,6 +527,7 @@ type RequestInfo struct {
 	Version      string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	BlockVersion uint64 `protobuf:"varint,2,opt,name=block_version,json=blockVersion,proto3" json:"block_version,omitempty"`
 	P2PVersion   uint64 `protobuf:"varint,3,opt,name=p2p_version,json=p2pVersion,proto3" json:"p2p_version,omitempty"`
+	AbciVersion  string `protobuf:"bytes,4,opt,name=abci_version,json=abciVersion,proto3" json:"abci_version,omitempty"`
 }
 
 func (m *RequestInfo) Reset()         { *m = RequestInfo{} }
@@ -554,6 +584,13 @@ func (m *RequestInfo) GetP2PVersion() uint64 {
 	return 0
 }
 
+func (m *RequestInfo) GetAbciVersion() string {
+	if m != nil {
+		return m.AbciVersion
+	}
+	return ""
+}
+
 // nondeterministic
 type RequestSetOption struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -931,6 +968,52 @@ func (m *RequestDeliverTx) GetTx() []byte {
 	return nil
 }
 
+// delivers the txs of a block at once, instead of a DeliverTx per tx, to the
+// applications advertising it in ResponseInfo
+type RequestDeliverBatch struct {
+	Txs []RequestDeliverTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs"`
+}
+
+func (m *RequestDeliverBatch) Reset()         { *m = RequestDeliverBatch{} }
+func (m *RequestDeliverBatch) String() string { return proto.CompactTextString(m) }
+func (*RequestDeliverBatch) ProtoMessage()    {}
+func (*RequestDeliverBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{10}
+}
+func (m *RequestDeliverBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestDeliverBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestDeliverBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestDeliverBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestDeliverBatch.Merge(m, src)
+}
+func (m *RequestDeliverBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestDeliverBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestDeliverBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestDeliverBatch proto.InternalMessageInfo
+
+func (m *RequestDeliverBatch) GetTxs() []RequestDeliverTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 type RequestEndBlock struct {
 	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -939,7 +1022,7 @@ func (m *RequestEndBlock) Reset()         { *m = RequestEndBlock{} }
 func (m *RequestEndBlock) String() string { return proto.CompactTextString(m) }
 func (*RequestEndBlock) ProtoMessage()    {}
 func (*RequestEndBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{10}
+	return fileDescriptor_252557cfdd89a31a, []int{11}
 }
 func (m *RequestEndBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1065,7 @@ func (m *RequestCommit) Reset()         { *m = RequestCommit{} }
 func (m *RequestCommit) String() string { return proto.CompactTextString(m) }
 func (*RequestCommit) ProtoMessage()    {}
 func (*RequestCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{11}
+	return fileDescriptor_252557cfdd89a31a, []int{12}
 }
 func (m *RequestCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1102,7 @@ func (m *RequestListSnapshots) Reset()         { *m = RequestListSnapshots{} }
 func (m *RequestListSnapshots) String() string { return proto.CompactTextString(m) }
 func (*RequestListSnapshots) ProtoMessage()    {}
 func (*RequestListSnapshots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{12}
+	return fileDescriptor_252557cfdd89a31a, []int{13}
 }
 func (m *RequestListSnapshots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1141,7 @@ func (m *RequestOfferSnapshot) Reset()         { *m = RequestOfferSnapshot{} }
 func (m *RequestOfferSnapshot) String() string { return proto.CompactTextString(m) }
 func (*RequestOfferSnapshot) ProtoMessage()    {}
 func (*RequestOfferSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{13}
+	return fileDescriptor_252557cfdd89a31a, []int{14}
 }
 func (m *RequestOfferSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1112,7 +1195,7 @@ func (m *RequestLoadSnapshotChunk) Reset()         { *m = RequestLoadSnapshotChu
 func (m *RequestLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*RequestLoadSnapshotChunk) ProtoMessage()    {}
 func (*RequestLoadSnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{14}
+	return fileDescriptor_252557cfdd89a31a, []int{15}
 }
 func (m *RequestLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1173,7 +1256,7 @@ func (m *RequestApplySnapshotChunk) Reset()         { *m = RequestApplySnapshotC
 func (m *RequestApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*RequestApplySnapshotChunk) ProtoMessage()    {}
 func (*RequestApplySnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{15}
+	return fileDescriptor_252557cfdd89a31a, []int{16}
 }
 func (m *RequestApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,14 +1324,18 @@ type Response struct {
 	//	*Response_OfferSnapshot
 	//	*Response_LoadSnapshotChunk
 	//	*Response_ApplySnapshotChunk
+	//	*Response_DeliverBatch
 	Value isResponse_Value `protobuf_oneof:"value"`
+	// request_id is the id of the request the response is for on a multiplexed
+	// connection, 0 otherwise.
+	RequestId uint64 `protobuf:"varint,100,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{16}
+	return fileDescriptor_252557cfdd89a31a, []int{17}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,6 +1418,9 @@ type Response_LoadSnapshotChunk struct {
 type Response_ApplySnapshotChunk struct {
 	ApplySnapshotChunk *ResponseApplySnapshotChunk `protobuf:"bytes,16,opt,name=apply_snapshot_chunk,json=applySnapshotChunk,proto3,oneof" json:"apply_snapshot_chunk,omitempty"`
 }
+type Response_DeliverBatch struct {
+	DeliverBatch *ResponseDeliverBatch `protobuf:"bytes,17,opt,name=deliver_batch,json=deliverBatch,proto3,oneof" json:"deliver_batch,omitempty"`
+}
 
 func (*Response_Exception) isResponse_Value()          {}
 func (*Response_Echo) isResponse_Value()               {}
@@ -1348,6 +1438,7 @@ func (*Response_ListSnapshots) isResponse_Value()      {}
 func (*Response_OfferSnapshot) isResponse_Value()      {}
 func (*Response_LoadSnapshotChunk) isResponse_Value()  {}
 func (*Response_ApplySnapshotChunk) isResponse_Value() {}
+func (*Response_DeliverBatch) isResponse_Value()       {}
 
 func (m *Response) GetValue() isResponse_Value {
 	if m != nil {
@@ -1468,6 +1559,20 @@ func (m *Response) GetApplySnapshotChunk() *ResponseApplySnapshotChunk {
 	return nil
 }
 
+func (m *Response) GetDeliverBatch() *ResponseDeliverBatch {
+	if x, ok := m.GetValue().(*Response_DeliverBatch); ok {
+		return x.DeliverBatch
+	}
+	return nil
+}
+
+func (m *Response) GetRequestId() uint64 {
+	if m != nil {
+		return m.RequestId
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Response) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1487,6 +1592,7 @@ func (*Response) XXX_OneofWrappers() []interface{} {
 		(*Response_OfferSnapshot)(nil),
 		(*Response_LoadSnapshotChunk)(nil),
 		(*Response_ApplySnapshotChunk)(nil),
+		(*Response_DeliverBatch)(nil),
 	}
 }
 
@@ -1499,7 +1605,7 @@ func (m *ResponseException) Reset()         { *m = ResponseException{} }
 func (m *ResponseException) String() string { return proto.CompactTextString(m) }
 func (*ResponseException) ProtoMessage()    {}
 func (*ResponseException) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{17}
+	return fileDescriptor_252557cfdd89a31a, []int{18}
 }
 func (m *ResponseException) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1543,7 +1649,7 @@ func (m *ResponseEcho) Reset()         { *m = ResponseEcho{} }
 func (m *ResponseEcho) String() string { return proto.CompactTextString(m) }
 func (*ResponseEcho) ProtoMessage()    {}
 func (*ResponseEcho) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{18}
+	return fileDescriptor_252557cfdd89a31a, []int{19}
 }
 func (m *ResponseEcho) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1586,7 +1692,7 @@ func (m *ResponseFlush) Reset()         { *m = ResponseFlush{} }
 func (m *ResponseFlush) String() string { return proto.CompactTextString(m) }
 func (*ResponseFlush) ProtoMessage()    {}
 func (*ResponseFlush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{19}
+	return fileDescriptor_252557cfdd89a31a, []int{20}
 }
 func (m *ResponseFlush) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1622,13 +1728,19 @@ type ResponseInfo struct {
 	LastBlockHeight           int64  `protobuf:"varint,4,opt,name=last_block_height,json=lastBlockHeight,proto3" json:"last_block_height,omitempty"`
 	LastBlockAppHash          []byte `protobuf:"bytes,5,opt,name=last_block_app_hash,json=lastBlockAppHash,proto3" json:"last_block_app_hash,omitempty"`
 	LastCoreChainLockedHeight uint32 `protobuf:"varint,100,opt,name=last_core_chain_locked_height,json=lastCoreChainLockedHeight,proto3" json:"last_core_chain_locked_height,omitempty"`
+	// deliver_batch is true if the application supports DeliverBatch
+	DeliverBatch bool `protobuf:"varint,101,opt,name=deliver_batch,json=deliverBatch,proto3" json:"deliver_batch,omitempty"`
+	// halt_height is the halt height last returned in ResponseEndBlock, until
+	// the chain is resumed, 0 if none. It is part of the application state, and
+	// the nodes, which state sync, learn it from the restored application.
+	HaltHeight int64 `protobuf:"varint,102,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
 }
 
 func (m *ResponseInfo) Reset()         { *m = ResponseInfo{} }
 func (m *ResponseInfo) String() string { return proto.CompactTextString(m) }
 func (*ResponseInfo) ProtoMessage()    {}
 func (*ResponseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{20}
+	return fileDescriptor_252557cfdd89a31a, []int{21}
 }
 func (m *ResponseInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1699,6 +1811,20 @@ func (m *ResponseInfo) GetLastCoreChainLockedHeight() uint32 {
 	return 0
 }
 
+func (m *ResponseInfo) GetDeliverBatch() bool {
+	if m != nil {
+		return m.DeliverBatch
+	}
+	return false
+}
+
+func (m *ResponseInfo) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
 // nondeterministic
 type ResponseSetOption struct {
 	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
@@ -1711,7 +1837,7 @@ func (m *ResponseSetOption) Reset()         { *m = ResponseSetOption{} }
 func (m *ResponseSetOption) String() string { return proto.CompactTextString(m) }
 func (*ResponseSetOption) ProtoMessage()    {}
 func (*ResponseSetOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{21}
+	return fileDescriptor_252557cfdd89a31a, []int{22}
 }
 func (m *ResponseSetOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1773,7 +1899,7 @@ func (m *ResponseInitChain) Reset()         { *m = ResponseInitChain{} }
 func (m *ResponseInitChain) String() string { return proto.CompactTextString(m) }
 func (*ResponseInitChain) ProtoMessage()    {}
 func (*ResponseInitChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{22}
+	return fileDescriptor_252557cfdd89a31a, []int{23}
 }
 func (m *ResponseInitChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1847,7 +1973,7 @@ func (m *ResponseQuery) Reset()         { *m = ResponseQuery{} }
 func (m *ResponseQuery) String() string { return proto.CompactTextString(m) }
 func (*ResponseQuery) ProtoMessage()    {}
 func (*ResponseQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{23}
+	return fileDescriptor_252557cfdd89a31a, []int{24}
 }
 func (m *ResponseQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1947,7 +2073,7 @@ func (m *ResponseBeginBlock) Reset()         { *m = ResponseBeginBlock{} }
 func (m *ResponseBeginBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseBeginBlock) ProtoMessage()    {}
 func (*ResponseBeginBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{24}
+	return fileDescriptor_252557cfdd89a31a, []int{25}
 }
 func (m *ResponseBeginBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1998,7 +2124,7 @@ func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
 func (m *ResponseCheckTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseCheckTx) ProtoMessage()    {}
 func (*ResponseCheckTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{25}
+	return fileDescriptor_252557cfdd89a31a, []int{26}
 }
 func (m *ResponseCheckTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2224,7 @@ func (m *ResponseDeliverTx) Reset()         { *m = ResponseDeliverTx{} }
 func (m *ResponseDeliverTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseDeliverTx) ProtoMessage()    {}
 func (*ResponseDeliverTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{26}
+	return fileDescriptor_252557cfdd89a31a, []int{27}
 }
 func (m *ResponseDeliverTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2183,19 +2309,69 @@ func (m *ResponseDeliverTx) GetCodespace() string {
 	return ""
 }
 
+type ResponseDeliverBatch struct {
+	// the responses to the txs, in the order of the txs
+	Txs []*ResponseDeliverTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (m *ResponseDeliverBatch) Reset()         { *m = ResponseDeliverBatch{} }
+func (m *ResponseDeliverBatch) String() string { return proto.CompactTextString(m) }
+func (*ResponseDeliverBatch) ProtoMessage()    {}
+func (*ResponseDeliverBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{28}
+}
+func (m *ResponseDeliverBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseDeliverBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseDeliverBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseDeliverBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseDeliverBatch.Merge(m, src)
+}
+func (m *ResponseDeliverBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseDeliverBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseDeliverBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseDeliverBatch proto.InternalMessageInfo
+
+func (m *ResponseDeliverBatch) GetTxs() []*ResponseDeliverTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
 type ResponseEndBlock struct {
 	//repeated ValidatorUpdate validator_updates = 1 [(gogoproto.nullable) = false];
 	ConsensusParamUpdates   *ConsensusParams      `protobuf:"bytes,2,opt,name=consensus_param_updates,json=consensusParamUpdates,proto3" json:"consensus_param_updates,omitempty"`
 	Events                  []Event               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
 	NextCoreChainLockUpdate *types1.CoreChainLock `protobuf:"bytes,100,opt,name=next_core_chain_lock_update,json=nextCoreChainLockUpdate,proto3" json:"next_core_chain_lock_update,omitempty"`
 	ValidatorSetUpdate      *ValidatorSetUpdate   `protobuf:"bytes,101,opt,name=validator_set_update,json=validatorSetUpdate,proto3" json:"validator_set_update,omitempty"`
+	// halt_height is the height after which the chain halts, e.g. for an
+	// upgrade, 0 to keep the halt height set before, if any. The height must
+	// not be below the height of the block. The application must report it in
+	// ResponseInfo until the chain is resumed.
+	HaltHeight int64 `protobuf:"varint,102,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
 }
 
 func (m *ResponseEndBlock) Reset()         { *m = ResponseEndBlock{} }
 func (m *ResponseEndBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseEndBlock) ProtoMessage()    {}
 func (*ResponseEndBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{27}
+	return fileDescriptor_252557cfdd89a31a, []int{29}
 }
 func (m *ResponseEndBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2252,6 +2428,13 @@ func (m *ResponseEndBlock) GetValidatorSetUpdate() *ValidatorSetUpdate {
 	return nil
 }
 
+func (m *ResponseEndBlock) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
 type ResponseCommit struct {
 	// reserve 1
 	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -2262,7 +2445,7 @@ func (m *ResponseCommit) Reset()         { *m = ResponseCommit{} }
 func (m *ResponseCommit) String() string { return proto.CompactTextString(m) }
 func (*ResponseCommit) ProtoMessage()    {}
 func (*ResponseCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{28}
+	return fileDescriptor_252557cfdd89a31a, []int{30}
 }
 func (m *ResponseCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2313,7 +2496,7 @@ func (m *ResponseListSnapshots) Reset()         { *m = ResponseListSnapshots{} }
 func (m *ResponseListSnapshots) String() string { return proto.CompactTextString(m) }
 func (*ResponseListSnapshots) ProtoMessage()    {}
 func (*ResponseListSnapshots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{29}
+	return fileDescriptor_252557cfdd89a31a, []int{31}
 }
 func (m *ResponseListSnapshots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2357,7 +2540,7 @@ func (m *ResponseOfferSnapshot) Reset()         { *m = ResponseOfferSnapshot{} }
 func (m *ResponseOfferSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ResponseOfferSnapshot) ProtoMessage()    {}
 func (*ResponseOfferSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{30}
+	return fileDescriptor_252557cfdd89a31a, []int{32}
 }
 func (m *ResponseOfferSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2401,7 +2584,7 @@ func (m *ResponseLoadSnapshotChunk) Reset()         { *m = ResponseLoadSnapshotC
 func (m *ResponseLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseLoadSnapshotChunk) ProtoMessage()    {}
 func (*ResponseLoadSnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{31}
+	return fileDescriptor_252557cfdd89a31a, []int{33}
 }
 func (m *ResponseLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2630,7 @@ func (m *ResponseApplySnapshotChunk) Reset()         { *m = ResponseApplySnapsho
 func (m *ResponseApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseApplySnapshotChunk) ProtoMessage()    {}
 func (*ResponseApplySnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{32}
+	return fileDescriptor_252557cfdd89a31a, []int{34}
 }
 func (m *ResponseApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2504,13 +2687,14 @@ type ConsensusParams struct {
 	Evidence  *types1.EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence,omitempty"`
 	Validator *types1.ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
 	Version   *types1.VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Synchrony *types1.SynchronyParams `protobuf:"bytes,5,opt,name=synchrony,proto3" json:"synchrony,omitempty"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
 func (m *ConsensusParams) String() string { return proto.CompactTextString(m) }
 func (*ConsensusParams) ProtoMessage()    {}
 func (*ConsensusParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{33}
+	return fileDescriptor_252557cfdd89a31a, []int{35}
 }
 func (m *ConsensusParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2567,6 +2751,13 @@ func (m *ConsensusParams) GetVersion() *types1.VersionParams {
 	return nil
 }
 
+func (m *ConsensusParams) GetSynchrony() *types1.SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return nil
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Note: must be greater than 0
@@ -2579,7 +2770,7 @@ func (m *BlockParams) Reset()         { *m = BlockParams{} }
 func (m *BlockParams) String() string { return proto.CompactTextString(m) }
 func (*BlockParams) ProtoMessage()    {}
 func (*BlockParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{34}
+	return fileDescriptor_252557cfdd89a31a, []int{36}
 }
 func (m *BlockParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2633,7 +2824,7 @@ func (m *LastCommitInfo) Reset()         { *m = LastCommitInfo{} }
 func (m *LastCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*LastCommitInfo) ProtoMessage()    {}
 func (*LastCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{35}
+	return fileDescriptor_252557cfdd89a31a, []int{37}
 }
 func (m *LastCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2702,7 +2893,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{36}
+	return fileDescriptor_252557cfdd89a31a, []int{38}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2756,7 +2947,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{37}
+	return fileDescriptor_252557cfdd89a31a, []int{39}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2820,7 +3011,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{38}
+	return fileDescriptor_252557cfdd89a31a, []int{40}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2889,7 +3080,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{39}
+	return fileDescriptor_252557cfdd89a31a, []int{41}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2943,7 +3134,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{40}
+	return fileDescriptor_252557cfdd89a31a, []int{42}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3003,7 +3194,7 @@ func (m *ValidatorSetUpdate) Reset()         { *m = ValidatorSetUpdate{} }
 func (m *ValidatorSetUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSetUpdate) ProtoMessage()    {}
 func (*ValidatorSetUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{41}
+	return fileDescriptor_252557cfdd89a31a, []int{43}
 }
 func (m *ValidatorSetUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3061,7 +3252,7 @@ func (m *ThresholdPublicKeyUpdate) Reset()         { *m = ThresholdPublicKeyUpda
 func (m *ThresholdPublicKeyUpdate) String() string { return proto.CompactTextString(m) }
 func (*ThresholdPublicKeyUpdate) ProtoMessage()    {}
 func (*ThresholdPublicKeyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{42}
+	return fileDescriptor_252557cfdd89a31a, []int{44}
 }
 func (m *ThresholdPublicKeyUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3105,7 +3296,7 @@ func (m *QuorumHashUpdate) Reset()         { *m = QuorumHashUpdate{} }
 func (m *QuorumHashUpdate) String() string { return proto.CompactTextString(m) }
 func (*QuorumHashUpdate) ProtoMessage()    {}
 func (*QuorumHashUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{43}
+	return fileDescriptor_252557cfdd89a31a, []int{45}
 }
 func (m *QuorumHashUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3151,7 +3342,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{44}
+	return fileDescriptor_252557cfdd89a31a, []int{46}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3212,7 +3403,7 @@ func (m *Evidence) Reset()         { *m = Evidence{} }
 func (m *Evidence) String() string { return proto.CompactTextString(m) }
 func (*Evidence) ProtoMessage()    {}
 func (*Evidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{45}
+	return fileDescriptor_252557cfdd89a31a, []int{47}
 }
 func (m *Evidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3480,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{46}
+	return fileDescriptor_252557cfdd89a31a, []int{48}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3375,6 +3566,7 @@ func init() {
 	proto.RegisterType((*RequestBeginBlock)(nil), "tendermint.abci.RequestBeginBlock")
 	proto.RegisterType((*RequestCheckTx)(nil), "tendermint.abci.RequestCheckTx")
 	proto.RegisterType((*RequestDeliverTx)(nil), "tendermint.abci.RequestDeliverTx")
+	proto.RegisterType((*RequestDeliverBatch)(nil), "tendermint.abci.RequestDeliverBatch")
 	proto.RegisterType((*RequestEndBlock)(nil), "tendermint.abci.RequestEndBlock")
 	proto.RegisterType((*RequestCommit)(nil), "tendermint.abci.RequestCommit")
 	proto.RegisterType((*RequestListSnapshots)(nil), "tendermint.abci.RequestListSnapshots")
@@ -3392,6 +3584,7 @@ func init() {
 	proto.RegisterType((*ResponseBeginBlock)(nil), "tendermint.abci.ResponseBeginBlock")
 	proto.RegisterType((*ResponseCheckTx)(nil), "tendermint.abci.ResponseCheckTx")
 	proto.RegisterType((*ResponseDeliverTx)(nil), "tendermint.abci.ResponseDeliverTx")
+	proto.RegisterType((*ResponseDeliverBatch)(nil), "tendermint.abci.ResponseDeliverBatch")
 	proto.RegisterType((*ResponseEndBlock)(nil), "tendermint.abci.ResponseEndBlock")
 	proto.RegisterType((*ResponseCommit)(nil), "tendermint.abci.ResponseCommit")
 	proto.RegisterType((*ResponseListSnapshots)(nil), "tendermint.abci.ResponseListSnapshots")
@@ -3417,195 +3610,207 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x77, 0x23, 0xc5,
-	0xb5, 0x57, 0x4b, 0xb2, 0x3e, 0xae, 0xac, 0x0f, 0xd7, 0x98, 0x19, 0x8d, 0x66, 0xc6, 0x1e, 0x9a,
-	0x03, 0x0c, 0x03, 0xd8, 0x0f, 0xcf, 0xe1, 0xeb, 0x3d, 0xde, 0x03, 0x4b, 0x68, 0x90, 0x19, 0x63,
-	0x9b, 0xb2, 0x66, 0x78, 0x09, 0x61, 0x9a, 0x96, 0x54, 0x96, 0x9a, 0x91, 0xba, 0x9b, 0xee, 0x92,
-	0xb1, 0xd9, 0x42, 0x36, 0xac, 0xc8, 0x2e, 0x59, 0xf0, 0x77, 0x64, 0x91, 0x73, 0xb2, 0x66, 0xc9,
-	0x32, 0x2b, 0xc2, 0x81, 0x93, 0x4d, 0x96, 0xd9, 0xe4, 0x9c, 0x9c, 0x93, 0x93, 0x9c, 0xfa, 0xe8,
-	0x2f, 0x49, 0x2d, 0xc9, 0xcc, 0x32, 0xbb, 0xae, 0x5b, 0xf7, 0xde, 0xae, 0xaa, 0xae, 0xfa, 0xdd,
-	0xdf, 0xbd, 0x5d, 0x70, 0x8d, 0x12, 0xb3, 0x47, 0x9c, 0x91, 0x61, 0xd2, 0x6d, 0xbd, 0xd3, 0x35,
-	0xb6, 0xe9, 0xb9, 0x4d, 0xdc, 0x2d, 0xdb, 0xb1, 0xa8, 0x85, 0xca, 0x41, 0xe7, 0x16, 0xeb, 0xac,
-	0xdd, 0x08, 0x69, 0x77, 0x9d, 0x73, 0x9b, 0x5a, 0xdb, 0xb6, 0x63, 0x59, 0x27, 0x42, 0xbf, 0x76,
-	0x3d, 0xd4, 0xcd, 0xfd, 0x84, 0xbd, 0x45, 0x7a, 0xa5, 0xf1, 0x23, 0x72, 0xee, 0xf5, 0xde, 0x98,
-	0xb2, 0xb5, 0x75, 0x47, 0x1f, 0x79, 0xdd, 0x9b, 0x7d, 0xcb, 0xea, 0x0f, 0xc9, 0x36, 0x6f, 0x75,
-	0xc6, 0x27, 0xdb, 0xd4, 0x18, 0x11, 0x97, 0xea, 0x23, 0x5b, 0x2a, 0xac, 0xf7, 0xad, 0xbe, 0xc5,
-	0x1f, 0xb7, 0xd9, 0x93, 0x90, 0xaa, 0xbf, 0xc9, 0x41, 0x16, 0x93, 0x4f, 0xc7, 0xc4, 0xa5, 0x68,
-	0x07, 0xd2, 0xa4, 0x3b, 0xb0, 0xaa, 0xca, 0x4d, 0xe5, 0x56, 0x61, 0xe7, 0xfa, 0xd6, 0xc4, 0xe4,
-	0xb6, 0xa4, 0x5e, 0xb3, 0x3b, 0xb0, 0x5a, 0x09, 0xcc, 0x75, 0xd1, 0xcb, 0xb0, 0x72, 0x32, 0x1c,
-	0xbb, 0x83, 0x6a, 0x92, 0x1b, 0xdd, 0x88, 0x33, 0xba, 0xcb, 0x94, 0x5a, 0x09, 0x2c, 0xb4, 0xd9,
-	0xab, 0x0c, 0xf3, 0xc4, 0xaa, 0xa6, 0xe6, 0xbf, 0x6a, 0xcf, 0x3c, 0xe1, 0xaf, 0x62, 0xba, 0xa8,
-	0x0e, 0xe0, 0x12, 0xaa, 0x59, 0x36, 0x35, 0x2c, 0xb3, 0x9a, 0xe6, 0x96, 0x4f, 0xc6, 0x59, 0x1e,
-	0x13, 0x7a, 0xc8, 0x15, 0x5b, 0x09, 0x9c, 0x77, 0xbd, 0x06, 0xf3, 0x61, 0x98, 0x06, 0xd5, 0xba,
-	0x03, 0xdd, 0x30, 0xab, 0x2b, 0xf3, 0x7d, 0xec, 0x99, 0x06, 0x6d, 0x30, 0x45, 0xe6, 0xc3, 0xf0,
-	0x1a, 0x6c, 0xca, 0x9f, 0x8e, 0x89, 0x73, 0x5e, 0xcd, 0xcc, 0x9f, 0xf2, 0xfb, 0x4c, 0x89, 0x4d,
-	0x99, 0x6b, 0xa3, 0x26, 0x14, 0x3a, 0xa4, 0x6f, 0x98, 0x5a, 0x67, 0x68, 0x75, 0x1f, 0x55, 0xb3,
-	0xdc, 0x58, 0x8d, 0x33, 0xae, 0x33, 0xd5, 0x3a, 0xd3, 0x6c, 0x25, 0x30, 0x74, 0xfc, 0x16, 0x7a,
-	0x03, 0x72, 0xdd, 0x01, 0xe9, 0x3e, 0xd2, 0xe8, 0x59, 0x35, 0xc7, 0x7d, 0x6c, 0xc6, 0xf9, 0x68,
-	0x30, 0xbd, 0xf6, 0x59, 0x2b, 0x81, 0xb3, 0x5d, 0xf1, 0xc8, 0xe6, 0xdf, 0x23, 0x43, 0xe3, 0x94,
-	0x38, 0xcc, 0x3e, 0x3f, 0x7f, 0xfe, 0x6f, 0x0b, 0x4d, 0xee, 0x21, 0xdf, 0xf3, 0x1a, 0xe8, 0x4d,
-	0xc8, 0x13, 0xb3, 0x27, 0xa7, 0x01, 0xdc, 0xc5, 0xcd, 0xd8, 0xbd, 0x62, 0xf6, 0xbc, 0x49, 0xe4,
-	0x88, 0x7c, 0x46, 0xaf, 0x41, 0xa6, 0x6b, 0x8d, 0x46, 0x06, 0xad, 0x16, 0xb8, 0xf5, 0x46, 0xec,
-	0x04, 0xb8, 0x56, 0x2b, 0x81, 0xa5, 0x3e, 0x3a, 0x80, 0xd2, 0xd0, 0x70, 0xa9, 0xe6, 0x9a, 0xba,
-	0xed, 0x0e, 0x2c, 0xea, 0x56, 0x57, 0xb9, 0x87, 0xa7, 0xe3, 0x3c, 0xec, 0x1b, 0x2e, 0x3d, 0xf6,
-	0x94, 0x5b, 0x09, 0x5c, 0x1c, 0x86, 0x05, 0xcc, 0x9f, 0x75, 0x72, 0x42, 0x1c, 0xdf, 0x61, 0xb5,
-	0x38, 0xdf, 0xdf, 0x21, 0xd3, 0xf6, 0xec, 0x99, 0x3f, 0x2b, 0x2c, 0x40, 0x1f, 0xc2, 0xa5, 0xa1,
-	0xa5, 0xf7, 0x7c, 0x77, 0x5a, 0x77, 0x30, 0x36, 0x1f, 0x55, 0x4b, 0xdc, 0xe9, 0x73, 0xb1, 0x83,
-	0xb4, 0xf4, 0x9e, 0xe7, 0xa2, 0xc1, 0x0c, 0x5a, 0x09, 0xbc, 0x36, 0x9c, 0x14, 0xa2, 0x87, 0xb0,
-	0xae, 0xdb, 0xf6, 0xf0, 0x7c, 0xd2, 0x7b, 0x99, 0x7b, 0xbf, 0x1d, 0xe7, 0x7d, 0x97, 0xd9, 0x4c,
-	0xba, 0x47, 0xfa, 0x94, 0xb4, 0x9e, 0x85, 0x95, 0x53, 0x7d, 0x38, 0x26, 0xea, 0xb3, 0x50, 0x08,
-	0x1d, 0x75, 0x54, 0x85, 0xec, 0x88, 0xb8, 0xae, 0xde, 0x27, 0x1c, 0x19, 0xf2, 0xd8, 0x6b, 0xaa,
-	0x25, 0x58, 0x0d, 0x1f, 0x6f, 0x75, 0xe4, 0x1b, 0xb2, 0x83, 0xcb, 0x0c, 0x4f, 0x89, 0xe3, 0xb2,
-	0xd3, 0x2a, 0x0d, 0x65, 0x13, 0x3d, 0x05, 0x45, 0xbe, 0x7d, 0x34, 0xaf, 0x9f, 0xa1, 0x47, 0x1a,
-	0xaf, 0x72, 0xe1, 0x03, 0xa9, 0xb4, 0x09, 0x05, 0x7b, 0xc7, 0xf6, 0x55, 0x52, 0x5c, 0x05, 0xec,
-	0x1d, 0x5b, 0x2a, 0xa8, 0xff, 0x0d, 0x95, 0xc9, 0xd3, 0x8e, 0x2a, 0x90, 0x7a, 0x44, 0xce, 0xe5,
-	0xfb, 0xd8, 0x23, 0x5a, 0x97, 0xd3, 0xe2, 0xef, 0xc8, 0x63, 0x39, 0xc7, 0x2f, 0x52, 0xbe, 0xb1,
-	0x7f, 0xcc, 0xd1, 0x6b, 0x90, 0x66, 0xa8, 0x29, 0x01, 0xb0, 0xb6, 0x25, 0x20, 0x75, 0xcb, 0x83,
-	0xd4, 0xad, 0xb6, 0x07, 0xa9, 0xf5, 0xdc, 0xb7, 0xdf, 0x6f, 0x26, 0xbe, 0xfe, 0xf3, 0xa6, 0x82,
-	0xb9, 0x05, 0xba, 0xca, 0x4e, 0xa5, 0x6e, 0x98, 0x9a, 0xd1, 0x93, 0xef, 0xc9, 0xf2, 0xf6, 0x5e,
-	0x0f, 0xdd, 0x83, 0x4a, 0xd7, 0x32, 0x5d, 0x62, 0xba, 0x63, 0x57, 0x13, 0x90, 0x2d, 0x61, 0x6f,
-	0xfa, 0xd4, 0x34, 0x3c, 0xc5, 0x23, 0xae, 0x87, 0xcb, 0xdd, 0xa8, 0x00, 0x1d, 0x40, 0xf1, 0x54,
-	0x1f, 0x1a, 0x3d, 0x9d, 0x5a, 0x8e, 0xe6, 0x12, 0x2a, 0x61, 0xf0, 0xa9, 0x29, 0x4f, 0x0f, 0x3c,
-	0xad, 0x63, 0x42, 0xef, 0xdb, 0x3d, 0x9d, 0x92, 0x7a, 0xfa, 0xdb, 0xef, 0x37, 0x15, 0xbc, 0x7a,
-	0x1a, 0xea, 0x41, 0xcf, 0x40, 0x59, 0xb7, 0x6d, 0xcd, 0xa5, 0x3a, 0x25, 0x5a, 0xe7, 0x9c, 0x12,
-	0x97, 0x83, 0xe2, 0x2a, 0x2e, 0xea, 0xb6, 0x7d, 0xcc, 0xa4, 0x75, 0x26, 0x44, 0x4f, 0x43, 0x89,
-	0x01, 0xa0, 0xa1, 0x0f, 0xb5, 0x01, 0x31, 0xfa, 0x03, 0xca, 0xc1, 0x2f, 0x85, 0x8b, 0x52, 0xda,
-	0xe2, 0x42, 0xb4, 0x05, 0x97, 0x3c, 0xb5, 0xae, 0xe5, 0x10, 0x4f, 0x97, 0x61, 0x5d, 0x11, 0xaf,
-	0xc9, 0xae, 0x86, 0xe5, 0x10, 0xa1, 0xaf, 0xf6, 0xfc, 0x0d, 0xc4, 0xc1, 0x12, 0x21, 0x48, 0xf7,
-	0x74, 0xaa, 0xf3, 0x0f, 0xb0, 0x8a, 0xf9, 0x33, 0x93, 0xd9, 0x3a, 0x1d, 0xc8, 0x65, 0xe5, 0xcf,
-	0xe8, 0x32, 0x64, 0xa4, 0xeb, 0x14, 0x1f, 0x86, 0x6c, 0xb1, 0x6f, 0x6d, 0x3b, 0xd6, 0x29, 0xe1,
-	0xcb, 0x92, 0xc3, 0xa2, 0xa1, 0x7e, 0x99, 0x84, 0xb5, 0x29, 0x58, 0x65, 0x7e, 0x07, 0xba, 0x3b,
-	0xf0, 0xde, 0xc5, 0x9e, 0xd1, 0x2b, 0xcc, 0xaf, 0xde, 0x23, 0x8e, 0x0c, 0x67, 0xd5, 0xf0, 0xba,
-	0x8a, 0x50, 0xdd, 0xe2, 0xfd, 0x7c, 0x31, 0x13, 0x58, 0x6a, 0xa3, 0x43, 0xa8, 0x0c, 0x75, 0x97,
-	0x6a, 0x02, 0xa6, 0xb4, 0x50, 0x68, 0x9b, 0x06, 0xe7, 0x7d, 0xdd, 0x03, 0x36, 0x76, 0x48, 0xa4,
-	0xa3, 0xd2, 0x30, 0x22, 0x45, 0x18, 0xd6, 0x3b, 0xe7, 0x9f, 0xeb, 0x26, 0x35, 0x4c, 0xa2, 0xf9,
-	0x5f, 0xcc, 0xad, 0xa6, 0x6f, 0xa6, 0x6e, 0x15, 0x76, 0xae, 0x4e, 0x39, 0x6d, 0x9e, 0x1a, 0x3d,
-	0x62, 0x76, 0x89, 0x74, 0x77, 0xc9, 0x37, 0xf6, 0xf7, 0x81, 0xab, 0x62, 0x28, 0x45, 0x03, 0x03,
-	0x2a, 0x41, 0x92, 0x9e, 0xc9, 0x05, 0x48, 0xd2, 0x33, 0xf4, 0x5f, 0x90, 0x66, 0x93, 0xe4, 0x93,
-	0x2f, 0xcd, 0x88, 0xca, 0xd2, 0xae, 0x7d, 0x6e, 0x13, 0xcc, 0x35, 0x55, 0xd5, 0x3f, 0x45, 0x7e,
-	0xb0, 0x98, 0xf4, 0xaa, 0x3e, 0x07, 0xe5, 0x89, 0x68, 0x10, 0xfa, 0x7e, 0x4a, 0xf8, 0xfb, 0xa9,
-	0x65, 0x28, 0x46, 0xa0, 0x5f, 0xbd, 0x0c, 0xeb, 0xb3, 0x90, 0x5c, 0x1d, 0xf8, 0xf2, 0x08, 0x22,
-	0xa3, 0x97, 0x21, 0xe7, 0x43, 0xb9, 0x38, 0xc5, 0xd3, 0x6b, 0xe5, 0x29, 0x63, 0x5f, 0x95, 0x1d,
-	0x5f, 0x76, 0x0c, 0xf8, 0x7e, 0x48, 0xf2, 0x81, 0x67, 0x75, 0xdb, 0x6e, 0xe9, 0xee, 0x40, 0xfd,
-	0x18, 0xaa, 0x71, 0x30, 0x3d, 0x31, 0x8d, 0xb4, 0xbf, 0x0d, 0x2f, 0x43, 0xe6, 0xc4, 0x72, 0x46,
-	0x3a, 0xe5, 0xce, 0x8a, 0x58, 0xb6, 0xd8, 0xf6, 0x14, 0x90, 0x9d, 0xe2, 0x62, 0xd1, 0x50, 0x35,
-	0xb8, 0x1a, 0x0b, 0xd5, 0xcc, 0xc4, 0x30, 0x7b, 0x44, 0xac, 0x67, 0x11, 0x8b, 0x46, 0xe0, 0x48,
-	0x0c, 0x56, 0x34, 0xd8, 0x6b, 0x5d, 0x3e, 0x57, 0xee, 0x3f, 0x8f, 0x65, 0x4b, 0xfd, 0x4b, 0x0e,
-	0x72, 0x98, 0xb8, 0x36, 0xc3, 0x12, 0x54, 0x87, 0x3c, 0x39, 0xeb, 0x12, 0x41, 0xa2, 0x94, 0x58,
-	0x12, 0x22, 0xb4, 0x9b, 0x9e, 0x26, 0x63, 0x00, 0xbe, 0x19, 0xba, 0x23, 0x89, 0x62, 0x3c, 0xe7,
-	0x93, 0xe6, 0x61, 0xa6, 0xf8, 0x8a, 0xc7, 0x14, 0x53, 0xb1, 0x41, 0x5f, 0x58, 0x4d, 0x50, 0xc5,
-	0x3b, 0x92, 0x2a, 0xa6, 0x17, 0xbc, 0x2c, 0xc2, 0x15, 0x1b, 0x11, 0xae, 0xb8, 0xb2, 0x60, 0x9a,
-	0x31, 0x64, 0xb1, 0x11, 0x21, 0x8b, 0x99, 0x05, 0x4e, 0x62, 0xd8, 0xe2, 0x2b, 0x1e, 0x5b, 0xcc,
-	0x2e, 0x98, 0xf6, 0x04, 0x5d, 0xbc, 0x1b, 0xa5, 0x8b, 0xb9, 0x18, 0x9c, 0xf7, 0xac, 0x63, 0xf9,
-	0xe2, 0xff, 0x86, 0xf8, 0x62, 0x3e, 0x96, 0xac, 0x09, 0x27, 0x33, 0x08, 0x63, 0x23, 0x42, 0x18,
-	0x61, 0xc1, 0x1a, 0xc4, 0x30, 0xc6, 0xb7, 0xc2, 0x8c, 0xb1, 0x10, 0x4b, 0x3a, 0xe5, 0xa6, 0x99,
-	0x45, 0x19, 0x5f, 0xf7, 0x29, 0xe3, 0x6a, 0x2c, 0xe7, 0x95, 0x73, 0x98, 0xe4, 0x8c, 0x87, 0x53,
-	0x9c, 0x51, 0x70, 0xbc, 0x67, 0x62, 0x5d, 0x2c, 0x20, 0x8d, 0x87, 0x53, 0xa4, 0xb1, 0xb4, 0xc0,
-	0xe1, 0x02, 0xd6, 0xf8, 0xab, 0xd9, 0xac, 0x31, 0x9e, 0xd7, 0xc9, 0x61, 0x2e, 0x47, 0x1b, 0xb5,
-	0x18, 0xda, 0x58, 0xe1, 0xee, 0x9f, 0x8f, 0x75, 0x7f, 0x71, 0xde, 0xf8, 0x1c, 0x0b, 0xb3, 0x13,
-	0xc0, 0xc1, 0xa0, 0x8a, 0x38, 0x8e, 0xe5, 0x48, 0x4a, 0x26, 0x1a, 0xea, 0x2d, 0x16, 0xf8, 0x03,
-	0x90, 0x98, 0xc3, 0x31, 0x79, 0x48, 0x08, 0x01, 0x83, 0xfa, 0x0f, 0x25, 0xb0, 0xe5, 0xb1, 0x32,
-	0x4c, 0x1a, 0xf2, 0x92, 0x34, 0x84, 0xa8, 0x67, 0x32, 0x4a, 0x3d, 0x37, 0xa1, 0xc0, 0xa0, 0x7e,
-	0x82, 0x55, 0xea, 0xb6, 0xc7, 0x2a, 0xd1, 0x6d, 0x58, 0xe3, 0xb1, 0x5c, 0x10, 0x54, 0x89, 0xef,
-	0x69, 0x1e, 0xa6, 0xca, 0xac, 0x43, 0x6c, 0x4e, 0x01, 0xf4, 0x2f, 0xc2, 0xa5, 0x90, 0xae, 0x1f,
-	0x42, 0x04, 0x85, 0xaa, 0xf8, 0xda, 0xbb, 0x22, 0x96, 0xa0, 0xb7, 0xe0, 0x86, 0xa4, 0x09, 0x0e,
-	0x11, 0xa8, 0xa2, 0xb1, 0x6e, 0xd2, 0xf3, 0x5e, 0xd3, 0xe3, 0x20, 0x7f, 0x55, 0x90, 0x01, 0x87,
-	0x70, 0x04, 0xd9, 0xe7, 0x1a, 0x92, 0x30, 0xbd, 0x17, 0x2c, 0x71, 0xc0, 0x79, 0x11, 0xa4, 0xbb,
-	0x56, 0x8f, 0xc8, 0x10, 0xc1, 0x9f, 0x19, 0x0f, 0x1e, 0x5a, 0x7d, 0x19, 0x08, 0xd8, 0x23, 0xd3,
-	0xf2, 0x71, 0x34, 0x2f, 0x60, 0x52, 0xfd, 0x7d, 0x32, 0xf0, 0x17, 0xd0, 0xe0, 0x59, 0x8c, 0x55,
-	0xf9, 0xb9, 0x8c, 0x35, 0x1c, 0x5a, 0x53, 0x91, 0xd0, 0x8a, 0x3e, 0x84, 0xf5, 0x08, 0x99, 0xd5,
-	0xc6, 0x9c, 0xa8, 0xf2, 0x55, 0xb8, 0x00, 0xa7, 0x4d, 0x60, 0x74, 0x3a, 0xd5, 0x83, 0x3e, 0x82,
-	0x6b, 0x26, 0x39, 0x9b, 0x5a, 0x6b, 0xef, 0x1d, 0x64, 0x1a, 0x46, 0x04, 0xbf, 0x8b, 0xac, 0x3b,
-	0xbe, 0xc2, 0x7c, 0x44, 0x44, 0xc2, 0xbd, 0xfa, 0x77, 0x25, 0xd8, 0x97, 0x3e, 0x77, 0xfd, 0x79,
-	0x5f, 0x21, 0x88, 0xf1, 0x2b, 0x7c, 0x97, 0xc9, 0x18, 0x2f, 0x33, 0x99, 0x0c, 0x5f, 0xb3, 0x68,
-	0x26, 0x93, 0x15, 0x51, 0x9f, 0x37, 0xd0, 0x6b, 0x90, 0xe7, 0x25, 0x26, 0xcd, 0xb2, 0x5d, 0x19,
-	0x26, 0xae, 0x85, 0xa7, 0x25, 0x2a, 0x49, 0x5b, 0x47, 0x4c, 0xe7, 0xd0, 0x76, 0x71, 0xce, 0x96,
-	0x4f, 0x21, 0xfa, 0x92, 0x8f, 0xb0, 0xe8, 0xeb, 0x90, 0x67, 0xa3, 0x77, 0x6d, 0xbd, 0x4b, 0x38,
-	0xe4, 0xe7, 0x71, 0x20, 0x50, 0x1f, 0x02, 0x9a, 0x0e, 0x3a, 0xa8, 0x05, 0x19, 0x72, 0x4a, 0x4c,
-	0xca, 0x76, 0x0a, 0xa3, 0xa8, 0x97, 0x67, 0x50, 0x54, 0x62, 0xd2, 0x7a, 0x95, 0x7d, 0xb0, 0xbf,
-	0x7e, 0xbf, 0x59, 0x11, 0xda, 0x2f, 0x58, 0x23, 0x83, 0x92, 0x91, 0x4d, 0xcf, 0xb1, 0xb4, 0x57,
-	0xbf, 0x48, 0x32, 0xbe, 0x18, 0x09, 0x48, 0x33, 0xd7, 0xd6, 0x3b, 0xf6, 0xc9, 0x50, 0xae, 0xb0,
-	0xdc, 0x7a, 0x6f, 0x00, 0xf4, 0x75, 0x57, 0xfb, 0x4c, 0x37, 0x29, 0xe9, 0xc9, 0x45, 0x0f, 0x49,
-	0x50, 0x0d, 0x72, 0xac, 0x35, 0x76, 0x49, 0x4f, 0xa6, 0x39, 0x7e, 0x3b, 0x34, 0xcf, 0xec, 0xe3,
-	0xcd, 0x33, 0xba, 0xca, 0xb9, 0xc9, 0x55, 0xfe, 0x75, 0xe8, 0x64, 0x06, 0xd4, 0xfa, 0x3f, 0x6f,
-	0x1d, 0xfe, 0x96, 0x64, 0x19, 0x46, 0x94, 0x19, 0xa0, 0xff, 0x87, 0x2b, 0x13, 0x00, 0x25, 0x8f,
-	0xb5, 0x2b, 0x29, 0xe9, 0x62, 0x9c, 0x7a, 0x22, 0x8a, 0x53, 0xe2, 0x54, 0xbb, 0xa1, 0x69, 0xa5,
-	0x1e, 0x73, 0x5a, 0x0b, 0xf0, 0xa7, 0xf7, 0x78, 0xf8, 0x13, 0x8b, 0x9d, 0xe4, 0xa2, 0xf5, 0x80,
-	0x19, 0xd8, 0xa9, 0xee, 0xb1, 0x4c, 0x31, 0x4c, 0xa7, 0x66, 0x6e, 0xb2, 0xa7, 0xa0, 0xe8, 0x10,
-	0xca, 0x26, 0x16, 0xc9, 0xc5, 0x57, 0x85, 0x50, 0x06, 0xac, 0x23, 0x78, 0x62, 0x26, 0xad, 0x42,
-	0xaf, 0x42, 0x3e, 0x60, 0x64, 0x4a, 0x4c, 0x5a, 0xeb, 0xa7, 0x6a, 0x81, 0xae, 0xfa, 0x47, 0x25,
-	0x70, 0x19, 0x4d, 0xfe, 0x9a, 0x90, 0x71, 0x88, 0x3b, 0x1e, 0x8a, 0x74, 0xac, 0xb4, 0xf3, 0xe2,
-	0x72, 0x84, 0x8c, 0x49, 0xc7, 0x43, 0x8a, 0xa5, 0xb1, 0xfa, 0x10, 0x32, 0x42, 0x82, 0x0a, 0x90,
-	0xbd, 0x7f, 0x70, 0xef, 0xe0, 0xf0, 0x83, 0x83, 0x4a, 0x02, 0x01, 0x64, 0x76, 0x1b, 0x8d, 0xe6,
-	0x51, 0xbb, 0xa2, 0xa0, 0x3c, 0xac, 0xec, 0xd6, 0x0f, 0x71, 0xbb, 0x92, 0x64, 0x62, 0xdc, 0x7c,
-	0xb7, 0xd9, 0x68, 0x57, 0x52, 0x68, 0x0d, 0x8a, 0xe2, 0x59, 0xbb, 0x7b, 0x88, 0xdf, 0xdb, 0x6d,
-	0x57, 0xd2, 0x21, 0xd1, 0x71, 0xf3, 0xe0, 0xed, 0x26, 0xae, 0xac, 0xa8, 0x2f, 0xb1, 0x7c, 0x2f,
-	0x86, 0xc2, 0x05, 0x99, 0x9d, 0x12, 0xca, 0xec, 0xd4, 0xdf, 0x26, 0xa1, 0x16, 0xcf, 0xcb, 0xd0,
-	0xbb, 0x13, 0x13, 0xdf, 0xb9, 0x00, 0xa9, 0x9b, 0x98, 0x3d, 0x7a, 0x1a, 0x4a, 0x0e, 0x39, 0x21,
-	0xb4, 0x3b, 0x10, 0x3c, 0x91, 0x1d, 0xa9, 0xd4, 0xad, 0x22, 0x2e, 0x4a, 0x29, 0x37, 0x72, 0x85,
-	0xda, 0x27, 0xa4, 0x4b, 0x35, 0x91, 0x64, 0x8a, 0x03, 0x93, 0x67, 0x6a, 0x4c, 0x7a, 0x2c, 0x84,
-	0xea, 0xc7, 0x17, 0x5a, 0xcb, 0x3c, 0xac, 0xe0, 0x66, 0x1b, 0xff, 0xa2, 0x92, 0x42, 0x08, 0x4a,
-	0xfc, 0x51, 0x3b, 0x3e, 0xd8, 0x3d, 0x3a, 0x6e, 0x1d, 0xb2, 0xb5, 0xbc, 0x04, 0x65, 0x6f, 0x2d,
-	0x3d, 0xe1, 0x8a, 0xfa, 0x2f, 0x05, 0xca, 0x13, 0x87, 0x1b, 0xed, 0xc0, 0x8a, 0xc8, 0x35, 0xe2,
-	0xfe, 0x64, 0x70, 0x18, 0x91, 0x48, 0x20, 0x54, 0xd1, 0x1b, 0x90, 0x23, 0xb2, 0x88, 0x32, 0x0b,
-	0x44, 0xc4, 0xe1, 0xf4, 0xca, 0x2c, 0xd2, 0xd4, 0xb7, 0x40, 0x6f, 0x42, 0xde, 0x3f, 0x47, 0x32,
-	0xc1, 0x7d, 0x72, 0xda, 0xdc, 0x3f, 0x84, 0xd2, 0x3e, 0xb0, 0x41, 0xaf, 0x07, 0x84, 0x35, 0x1d,
-	0x07, 0x0d, 0x92, 0xa1, 0x4a, 0x63, 0x4f, 0x5f, 0x6d, 0x40, 0x21, 0x34, 0x1f, 0x74, 0x0d, 0xf2,
-	0x23, 0xfd, 0x4c, 0x16, 0xf3, 0x44, 0x79, 0x25, 0x37, 0xd2, 0xcf, 0x44, 0x1d, 0xef, 0x0a, 0x64,
-	0x59, 0x67, 0x5f, 0x17, 0x48, 0x99, 0xc2, 0x99, 0x91, 0x7e, 0xf6, 0x8e, 0xee, 0xaa, 0xbf, 0x53,
-	0xa0, 0x14, 0xad, 0x4c, 0xb1, 0xad, 0xe8, 0x58, 0x63, 0xb3, 0xc7, 0x9d, 0xac, 0x60, 0xd1, 0x60,
-	0xfc, 0xf9, 0xd3, 0xb1, 0xe5, 0x8c, 0x47, 0x61, 0x4a, 0x07, 0x42, 0xc4, 0x59, 0xdd, 0xb3, 0x50,
-	0x16, 0x74, 0xd8, 0x35, 0xfa, 0xa6, 0x4e, 0xc7, 0x8e, 0xa8, 0xc6, 0xad, 0xe2, 0x12, 0x17, 0x1f,
-	0x7b, 0x52, 0xa6, 0x28, 0xea, 0x8e, 0x81, 0xa2, 0x20, 0xce, 0x25, 0x2e, 0xf6, 0x15, 0xd5, 0xcf,
-	0x61, 0x85, 0xa3, 0x2e, 0x43, 0x21, 0x5e, 0x9f, 0x92, 0x4c, 0x9f, 0x3d, 0xa3, 0x8f, 0x00, 0x74,
-	0x4a, 0x1d, 0xa3, 0x33, 0x16, 0xf0, 0x9f, 0x9a, 0x99, 0x1d, 0x72, 0xfb, 0x5d, 0x4f, 0xaf, 0x7e,
-	0x5d, 0xc2, 0xf7, 0x7a, 0x60, 0x1a, 0x82, 0xf0, 0x90, 0x43, 0xf5, 0x00, 0x4a, 0x51, 0xdb, 0x70,
-	0x85, 0x79, 0x75, 0x46, 0x85, 0xd9, 0xe7, 0x65, 0x3e, 0xab, 0x4b, 0x89, 0x5a, 0x24, 0x6f, 0xa8,
-	0x5f, 0x29, 0x90, 0x6b, 0x9f, 0xc9, 0x33, 0x11, 0x53, 0x06, 0x0b, 0x4c, 0x93, 0xe1, 0xa2, 0x8f,
-	0xa8, 0xab, 0xa5, 0xfc, 0x6a, 0xdd, 0x5b, 0xfe, 0xa9, 0x4f, 0x2f, 0x9b, 0x96, 0x7b, 0x65, 0x4b,
-	0x89, 0x74, 0xbb, 0x90, 0xf7, 0xb7, 0x24, 0xaf, 0x9d, 0x5a, 0x9f, 0xc9, 0xe2, 0x51, 0x0a, 0x8b,
-	0x06, 0xda, 0x80, 0x82, 0xed, 0x58, 0x1a, 0x3d, 0x13, 0x9f, 0x5b, 0x7c, 0x49, 0x46, 0x38, 0xdb,
-	0x67, 0xbc, 0x3c, 0xf6, 0xa5, 0x02, 0x65, 0xdf, 0x87, 0x8c, 0x4d, 0xff, 0x03, 0x59, 0x7b, 0xdc,
-	0xd1, 0xbc, 0x55, 0x9a, 0x38, 0x80, 0x1e, 0x1f, 0x1d, 0x77, 0x86, 0x46, 0xf7, 0x1e, 0x39, 0x97,
-	0x71, 0x28, 0x63, 0x8f, 0x3b, 0xf7, 0xc4, 0x62, 0x8a, 0x61, 0x24, 0xe7, 0x0c, 0x23, 0x35, 0x39,
-	0x8c, 0x1f, 0x14, 0x40, 0xd3, 0x21, 0x0e, 0x1d, 0xc3, 0x5a, 0x10, 0x25, 0x3d, 0x8a, 0x20, 0x82,
-	0xcd, 0xcd, 0xf8, 0x10, 0x19, 0xc9, 0x2d, 0x2a, 0xa7, 0x51, 0xb1, 0x8b, 0xda, 0xb0, 0x4e, 0x07,
-	0x0e, 0x71, 0x07, 0xd6, 0xb0, 0xa7, 0xd9, 0x7c, 0x1a, 0x7c, 0xae, 0xc9, 0x25, 0xe7, 0x9a, 0xc0,
-	0xc8, 0xb7, 0xf7, 0x7b, 0x16, 0x9e, 0x2b, 0xd5, 0x86, 0x6a, 0x7b, 0xca, 0x4c, 0xce, 0x33, 0x6e,
-	0x48, 0xca, 0xe3, 0x0c, 0x49, 0xbd, 0x03, 0x95, 0xf7, 0xfd, 0xf7, 0xcb, 0x37, 0x4d, 0x0c, 0x53,
-	0x99, 0x1a, 0xe6, 0x29, 0xe4, 0x1e, 0x58, 0x54, 0x64, 0xe6, 0xff, 0x17, 0x46, 0x45, 0xef, 0xa7,
-	0x4a, 0xec, 0xb2, 0xcb, 0x91, 0x84, 0x40, 0xf1, 0x36, 0xac, 0x31, 0x6c, 0x20, 0x3d, 0x2d, 0xc8,
-	0xb2, 0xf9, 0x32, 0xe7, 0x70, 0x59, 0x74, 0xec, 0x7b, 0x29, 0xb6, 0xfa, 0x4f, 0x05, 0x72, 0x1e,
-	0x3c, 0xa3, 0x97, 0x42, 0x40, 0x51, 0x9a, 0x51, 0x33, 0xf4, 0x14, 0x83, 0x4a, 0x76, 0x74, 0xac,
-	0xc9, 0x8b, 0x8f, 0x35, 0xee, 0x97, 0x84, 0xf7, 0x4f, 0x29, 0x7d, 0xe1, 0x7f, 0x4a, 0x2f, 0x00,
-	0xa2, 0x16, 0xd5, 0x87, 0xda, 0xa9, 0x45, 0x0d, 0xb3, 0xaf, 0x89, 0x63, 0x21, 0x68, 0x7a, 0x85,
-	0xf7, 0x3c, 0xe0, 0x1d, 0x47, 0x4c, 0xae, 0xfe, 0x41, 0x81, 0x9c, 0xcf, 0x84, 0x2e, 0x5a, 0x98,
-	0xbe, 0x0c, 0x19, 0x19, 0xec, 0x45, 0x65, 0x5a, 0xb6, 0xfc, 0x7f, 0x24, 0xe9, 0xd0, 0x3f, 0x92,
-	0x1a, 0xe4, 0x46, 0x84, 0xea, 0x9c, 0x0e, 0x0a, 0xbc, 0xf6, 0xdb, 0xe8, 0x55, 0xa8, 0x2e, 0xa8,
-	0x6d, 0x3c, 0xd1, 0x9d, 0x55, 0xd7, 0xb8, 0xfd, 0x3a, 0x14, 0x42, 0x3f, 0x17, 0x18, 0xc6, 0x1e,
-	0x34, 0x3f, 0xa8, 0x24, 0x6a, 0xd9, 0xaf, 0xbe, 0xb9, 0x99, 0x3a, 0x20, 0x9f, 0xa1, 0x2a, 0x64,
-	0x71, 0xb3, 0xd1, 0x6a, 0x36, 0xee, 0x55, 0x94, 0x5a, 0xe1, 0xab, 0x6f, 0x6e, 0x66, 0x31, 0xe1,
-	0x35, 0xca, 0xdb, 0x2d, 0x58, 0x0d, 0x7f, 0xce, 0x28, 0xd1, 0x40, 0x50, 0x7a, 0xfb, 0xfe, 0xd1,
-	0xfe, 0x5e, 0x63, 0xb7, 0xdd, 0xd4, 0x1e, 0x1c, 0xb6, 0x9b, 0x15, 0x05, 0x5d, 0x81, 0x4b, 0xfb,
-	0x7b, 0xef, 0xb4, 0xda, 0x5a, 0x63, 0x7f, 0xaf, 0x79, 0xd0, 0xd6, 0x76, 0xdb, 0xed, 0xdd, 0xc6,
-	0xbd, 0x4a, 0x72, 0xe7, 0x0b, 0x80, 0xf2, 0x6e, 0xbd, 0xb1, 0xc7, 0x48, 0x92, 0xd1, 0xd5, 0x65,
-	0x0d, 0x38, 0xcd, 0x0b, 0x54, 0x73, 0x6f, 0x43, 0xd4, 0xe6, 0x97, 0xc0, 0xd1, 0x5d, 0x58, 0xe1,
-	0xb5, 0x2b, 0x34, 0xff, 0x7a, 0x44, 0x6d, 0x41, 0x4d, 0x9c, 0x0d, 0x86, 0x9f, 0xab, 0xb9, 0xf7,
-	0x25, 0x6a, 0xf3, 0x4b, 0xe4, 0x08, 0x43, 0x3e, 0x28, 0x1d, 0x2d, 0xbe, 0x3f, 0x51, 0x5b, 0xa2,
-	0x6c, 0xce, 0x7c, 0x06, 0x49, 0xea, 0xe2, 0xfb, 0x04, 0xb5, 0x25, 0x42, 0x15, 0xda, 0x87, 0xac,
-	0x97, 0xfe, 0x2f, 0xba, 0xe1, 0x50, 0x5b, 0x58, 0xd2, 0x66, 0x9f, 0x40, 0x94, 0x69, 0xe6, 0x5f,
-	0xd7, 0xa8, 0x2d, 0xa8, 0xcf, 0xa3, 0x3d, 0xc8, 0xc8, 0x94, 0x68, 0xc1, 0xad, 0x85, 0xda, 0xa2,
-	0x12, 0x35, 0x5b, 0xb4, 0xa0, 0xe6, 0xb6, 0xf8, 0x12, 0x4a, 0x6d, 0x89, 0x5f, 0x0f, 0xe8, 0x3e,
-	0x40, 0xa8, 0x28, 0xb3, 0xc4, 0xed, 0x92, 0xda, 0x32, 0xbf, 0x14, 0xd0, 0x21, 0xe4, 0xfc, 0xe4,
-	0x7b, 0xe1, 0x5d, 0x8f, 0xda, 0xe2, 0xda, 0x3e, 0x7a, 0x08, 0xc5, 0x68, 0x3a, 0xb8, 0xdc, 0x0d,
-	0x8e, 0xda, 0x92, 0x45, 0x7b, 0xe6, 0x3f, 0x9a, 0x1b, 0x2e, 0x77, 0xa3, 0xa3, 0xb6, 0x64, 0x0d,
-	0x1f, 0x7d, 0x02, 0x6b, 0xd3, 0xb9, 0xdb, 0xf2, 0x17, 0x3c, 0x6a, 0x17, 0xa8, 0xea, 0xa3, 0x11,
-	0xa0, 0x19, 0x39, 0xdf, 0x05, 0xee, 0x7b, 0xd4, 0x2e, 0x52, 0xe4, 0xaf, 0x37, 0xbf, 0xfd, 0x71,
-	0x43, 0xf9, 0xee, 0xc7, 0x0d, 0xe5, 0x87, 0x1f, 0x37, 0x94, 0xaf, 0x7f, 0xda, 0x48, 0x7c, 0xf7,
-	0xd3, 0x46, 0xe2, 0x4f, 0x3f, 0x6d, 0x24, 0x7e, 0xf9, 0x7c, 0xdf, 0xa0, 0x83, 0x71, 0x67, 0xab,
-	0x6b, 0x8d, 0xb6, 0xc3, 0x97, 0xd1, 0x66, 0x5d, 0x90, 0xeb, 0x64, 0x78, 0x84, 0xbb, 0xf3, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x07, 0x1f, 0x0d, 0x40, 0x27, 0x00, 0x00,
+	// 3199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+	0xb5, 0xc6, 0x8b, 0x78, 0x1c, 0x3c, 0x08, 0xb6, 0x28, 0x09, 0x82, 0x24, 0x92, 0x1e, 0x5d, 0xdb,
+	0xb2, 0x6c, 0x93, 0xd7, 0xd4, 0xf5, 0x43, 0x37, 0x2f, 0x83, 0x10, 0x64, 0xd0, 0xa4, 0x09, 0x7a,
+	0x08, 0xc9, 0x49, 0x1c, 0x6b, 0x3c, 0xc0, 0x34, 0x89, 0xb1, 0x80, 0x99, 0xf1, 0x4c, 0x83, 0x06,
+	0xbd, 0xb5, 0xb3, 0xf1, 0xca, 0x55, 0xd9, 0x24, 0x0b, 0xff, 0x8e, 0x2c, 0x52, 0x95, 0xca, 0xd2,
+	0x4b, 0x57, 0x56, 0x59, 0x39, 0x2e, 0x7b, 0x97, 0x3f, 0x90, 0x95, 0xab, 0x52, 0xfd, 0x1a, 0xcc,
+	0x00, 0x18, 0x02, 0xb4, 0x96, 0xd9, 0x4d, 0x9f, 0x3e, 0xe7, 0xf4, 0x6b, 0xfa, 0x3b, 0x5f, 0x9f,
+	0x6e, 0xb8, 0x4e, 0xb0, 0x65, 0x60, 0x77, 0x60, 0x5a, 0x64, 0x4b, 0xef, 0x74, 0xcd, 0x2d, 0x72,
+	0xe6, 0x60, 0x6f, 0xd3, 0x71, 0x6d, 0x62, 0xa3, 0xe5, 0x71, 0xe5, 0x26, 0xad, 0xac, 0xde, 0x0c,
+	0x68, 0x77, 0xdd, 0x33, 0x87, 0xd8, 0x5b, 0x8e, 0x6b, 0xdb, 0xc7, 0x5c, 0xbf, 0x7a, 0x23, 0x50,
+	0xcd, 0xfc, 0x04, 0xbd, 0x55, 0x6f, 0x4c, 0x1b, 0x3f, 0xc1, 0x67, 0xb2, 0xf6, 0xe6, 0x94, 0xad,
+	0xa3, 0xbb, 0xfa, 0x40, 0x56, 0xaf, 0x9f, 0xd8, 0xf6, 0x49, 0x1f, 0x6f, 0xb1, 0x52, 0x67, 0x78,
+	0xbc, 0x45, 0xcc, 0x01, 0xf6, 0x88, 0x3e, 0x70, 0x84, 0xc2, 0xea, 0x89, 0x7d, 0x62, 0xb3, 0xcf,
+	0x2d, 0xfa, 0xc5, 0xa5, 0xca, 0x8f, 0x59, 0xc8, 0xa8, 0xf8, 0xe3, 0x21, 0xf6, 0x08, 0xda, 0x86,
+	0x14, 0xee, 0xf6, 0xec, 0x4a, 0x7c, 0x23, 0x7e, 0x3b, 0xbf, 0x7d, 0x63, 0x73, 0x62, 0x70, 0x9b,
+	0x42, 0xaf, 0xd1, 0xed, 0xd9, 0xcd, 0x98, 0xca, 0x74, 0xd1, 0xab, 0xb0, 0x74, 0xdc, 0x1f, 0x7a,
+	0xbd, 0x4a, 0x82, 0x19, 0xdd, 0x8c, 0x32, 0x7a, 0x40, 0x95, 0x9a, 0x31, 0x95, 0x6b, 0xd3, 0xa6,
+	0x4c, 0xeb, 0xd8, 0xae, 0x24, 0xcf, 0x6f, 0x6a, 0xd7, 0x3a, 0x66, 0x4d, 0x51, 0x5d, 0xb4, 0x03,
+	0xe0, 0x61, 0xa2, 0xd9, 0x0e, 0x31, 0x6d, 0xab, 0x92, 0x62, 0x96, 0xcf, 0x44, 0x59, 0x1e, 0x61,
+	0xd2, 0x62, 0x8a, 0xcd, 0x98, 0x9a, 0xf3, 0x64, 0x81, 0xfa, 0x30, 0x2d, 0x93, 0x68, 0xdd, 0x9e,
+	0x6e, 0x5a, 0x95, 0xa5, 0xf3, 0x7d, 0xec, 0x5a, 0x26, 0xa9, 0x53, 0x45, 0xea, 0xc3, 0x94, 0x05,
+	0x3a, 0xe4, 0x8f, 0x87, 0xd8, 0x3d, 0xab, 0xa4, 0xcf, 0x1f, 0xf2, 0xbb, 0x54, 0x89, 0x0e, 0x99,
+	0x69, 0xa3, 0x06, 0xe4, 0x3b, 0xf8, 0xc4, 0xb4, 0xb4, 0x4e, 0xdf, 0xee, 0x3e, 0xa9, 0x64, 0x98,
+	0xb1, 0x12, 0x65, 0xbc, 0x43, 0x55, 0x77, 0xa8, 0x66, 0x33, 0xa6, 0x42, 0xc7, 0x2f, 0xa1, 0x9f,
+	0x43, 0xb6, 0xdb, 0xc3, 0xdd, 0x27, 0x1a, 0x19, 0x55, 0xb2, 0xcc, 0xc7, 0x7a, 0x94, 0x8f, 0x3a,
+	0xd5, 0x6b, 0x8f, 0x9a, 0x31, 0x35, 0xd3, 0xe5, 0x9f, 0x74, 0xfc, 0x06, 0xee, 0x9b, 0xa7, 0xd8,
+	0xa5, 0xf6, 0xb9, 0xf3, 0xc7, 0x7f, 0x9f, 0x6b, 0x32, 0x0f, 0x39, 0x43, 0x16, 0xd0, 0xaf, 0x20,
+	0x87, 0x2d, 0x43, 0x0c, 0x03, 0x98, 0x8b, 0x8d, 0xc8, 0x7f, 0xc5, 0x32, 0xe4, 0x20, 0xb2, 0x58,
+	0x7c, 0xa3, 0x37, 0x20, 0xdd, 0xb5, 0x07, 0x03, 0x93, 0x54, 0xf2, 0xcc, 0x7a, 0x2d, 0x72, 0x00,
+	0x4c, 0xab, 0x19, 0x53, 0x85, 0x3e, 0x3a, 0x80, 0x52, 0xdf, 0xf4, 0x88, 0xe6, 0x59, 0xba, 0xe3,
+	0xf5, 0x6c, 0xe2, 0x55, 0x0a, 0xcc, 0xc3, 0xb3, 0x51, 0x1e, 0xf6, 0x4d, 0x8f, 0x1c, 0x49, 0xe5,
+	0x66, 0x4c, 0x2d, 0xf6, 0x83, 0x02, 0xea, 0xcf, 0x3e, 0x3e, 0xc6, 0xae, 0xef, 0xb0, 0x52, 0x3c,
+	0xdf, 0x5f, 0x8b, 0x6a, 0x4b, 0x7b, 0xea, 0xcf, 0x0e, 0x0a, 0xd0, 0xfb, 0x70, 0xa9, 0x6f, 0xeb,
+	0x86, 0xef, 0x4e, 0xeb, 0xf6, 0x86, 0xd6, 0x93, 0x4a, 0x89, 0x39, 0x7d, 0x21, 0xb2, 0x93, 0xb6,
+	0x6e, 0x48, 0x17, 0x75, 0x6a, 0xd0, 0x8c, 0xa9, 0x2b, 0xfd, 0x49, 0x21, 0x7a, 0x0c, 0xab, 0xba,
+	0xe3, 0xf4, 0xcf, 0x26, 0xbd, 0x2f, 0x33, 0xef, 0x77, 0xa2, 0xbc, 0xd7, 0xa8, 0xcd, 0xa4, 0x7b,
+	0xa4, 0x4f, 0x49, 0xd1, 0x1e, 0x14, 0xe5, 0xbf, 0xd1, 0xd1, 0x49, 0xb7, 0x57, 0x29, 0x33, 0xc7,
+	0xff, 0x33, 0xe7, 0xf7, 0xd8, 0xa1, 0xba, 0xcd, 0x98, 0x5a, 0x30, 0x02, 0x65, 0x74, 0x13, 0xc0,
+	0xe5, 0x6a, 0x9a, 0x69, 0x54, 0x8c, 0x8d, 0xf8, 0xed, 0x94, 0x9a, 0x13, 0x92, 0x5d, 0x63, 0x27,
+	0x03, 0x4b, 0xa7, 0x7a, 0x7f, 0x88, 0x95, 0xe7, 0x21, 0x1f, 0x80, 0x15, 0x54, 0x81, 0xcc, 0x00,
+	0x7b, 0x9e, 0x7e, 0x82, 0x19, 0x0a, 0xe5, 0x54, 0x59, 0x54, 0x4a, 0x50, 0x08, 0x42, 0x89, 0xf2,
+	0x65, 0x1c, 0xf2, 0x01, 0x94, 0xa0, 0x96, 0xa7, 0xd8, 0xf5, 0x28, 0x34, 0x08, 0x4b, 0x51, 0x44,
+	0xb7, 0xa0, 0xc8, 0xfe, 0x55, 0x4d, 0xd6, 0x27, 0x58, 0x6f, 0x0a, 0x4c, 0xf8, 0x48, 0x28, 0xad,
+	0x43, 0xde, 0xd9, 0x76, 0x7c, 0x95, 0x24, 0x53, 0x01, 0x67, 0xdb, 0x91, 0x0a, 0xcf, 0x40, 0x81,
+	0x0e, 0xde, 0xd7, 0x48, 0xb1, 0x46, 0xf2, 0x54, 0x26, 0x54, 0x94, 0xff, 0x87, 0xf2, 0x24, 0xfa,
+	0xa0, 0x32, 0x24, 0x9f, 0xe0, 0x33, 0xd1, 0x25, 0xfa, 0x89, 0x56, 0xc5, 0xd0, 0x59, 0x37, 0x72,
+	0xaa, 0x98, 0x87, 0xcf, 0x92, 0x50, 0x9e, 0x84, 0x1d, 0xf4, 0x06, 0xa4, 0x28, 0x8a, 0x0b, 0x40,
+	0xae, 0x6e, 0x72, 0x88, 0xdf, 0x94, 0x10, 0xbf, 0xd9, 0x96, 0x10, 0xbf, 0x93, 0xfd, 0xfa, 0xdb,
+	0xf5, 0xd8, 0x97, 0xff, 0x5c, 0x8f, 0xab, 0xcc, 0x02, 0x5d, 0xa3, 0x28, 0xa1, 0x9b, 0x16, 0x9d,
+	0x7c, 0xde, 0x4e, 0x86, 0x95, 0x77, 0x0d, 0xb4, 0x07, 0xe5, 0xae, 0x6d, 0x79, 0xd8, 0xf2, 0x86,
+	0x9e, 0xc6, 0x43, 0x48, 0x25, 0x19, 0xb1, 0x8b, 0xeb, 0x52, 0xf1, 0x90, 0xe9, 0xa9, 0xcb, 0xdd,
+	0xb0, 0x00, 0x1d, 0x40, 0xf1, 0x54, 0xef, 0x9b, 0x86, 0x4e, 0x6c, 0x57, 0xf3, 0x30, 0x11, 0xb0,
+	0x7c, 0x6b, 0xca, 0xd3, 0x23, 0xa9, 0x75, 0x84, 0xc9, 0x43, 0xc7, 0xd0, 0x09, 0xde, 0x49, 0x7d,
+	0xfd, 0xed, 0x7a, 0x5c, 0x2d, 0x9c, 0x06, 0x6a, 0xd0, 0x73, 0xb0, 0xac, 0x3b, 0x8e, 0xe6, 0x11,
+	0x9d, 0x60, 0xad, 0x73, 0x46, 0xb0, 0xc7, 0x40, 0xba, 0xa0, 0x16, 0x75, 0xc7, 0x39, 0xa2, 0xd2,
+	0x1d, 0x2a, 0x44, 0xcf, 0x42, 0x89, 0x02, 0xb2, 0xa9, 0xf7, 0xb5, 0x1e, 0x36, 0x4f, 0x7a, 0x84,
+	0x81, 0x71, 0x52, 0x2d, 0x0a, 0x69, 0x93, 0x09, 0xd1, 0x26, 0x5c, 0x92, 0x6a, 0x5d, 0xdb, 0xc5,
+	0x52, 0x97, 0x62, 0x6f, 0x51, 0x5d, 0x11, 0x55, 0x75, 0xdb, 0xc5, 0x5c, 0x5f, 0x31, 0xa0, 0x10,
+	0x04, 0x6f, 0x84, 0x20, 0x65, 0xe8, 0x44, 0x67, 0x0b, 0x50, 0x50, 0xd9, 0x37, 0x95, 0x39, 0x3a,
+	0xe9, 0x89, 0x69, 0x65, 0xdf, 0xe8, 0x0a, 0xa4, 0x85, 0xeb, 0x24, 0xeb, 0x86, 0x28, 0xd1, 0xb5,
+	0x76, 0x5c, 0xfb, 0x14, 0xb3, 0x69, 0xc9, 0xaa, 0xbc, 0xa0, 0x7c, 0x9e, 0x80, 0x95, 0x29, 0x98,
+	0xa7, 0x7e, 0x7b, 0xba, 0xd7, 0x93, 0x6d, 0xd1, 0x6f, 0xf4, 0x1a, 0xf5, 0xab, 0x1b, 0xd8, 0x15,
+	0xe1, 0xb5, 0x12, 0x9c, 0x57, 0x4e, 0x1d, 0x9a, 0xac, 0x9e, 0x4d, 0x66, 0x4c, 0x15, 0xda, 0xa8,
+	0x05, 0xe5, 0xbe, 0xee, 0x11, 0x8d, 0xc3, 0xa6, 0x16, 0x08, 0xb5, 0xd3, 0xc1, 0x62, 0x5f, 0x97,
+	0x40, 0x4b, 0xf7, 0x91, 0x70, 0x54, 0xea, 0x87, 0xa4, 0x48, 0x85, 0xd5, 0xce, 0xd9, 0xa7, 0xba,
+	0x45, 0x4c, 0x0b, 0x6b, 0xfe, 0x8a, 0x79, 0x95, 0xd4, 0x46, 0xf2, 0x76, 0x7e, 0xfb, 0xda, 0x94,
+	0xd3, 0xc6, 0xa9, 0x69, 0x60, 0xab, 0x8b, 0x85, 0xbb, 0x4b, 0xbe, 0xb1, 0xff, 0x1f, 0x78, 0x8a,
+	0x0a, 0xa5, 0x70, 0xa0, 0x42, 0x25, 0x48, 0x90, 0x91, 0x98, 0x80, 0x04, 0x19, 0xa1, 0xff, 0x85,
+	0x14, 0x1d, 0x24, 0x1b, 0x7c, 0x69, 0x06, 0x4b, 0x10, 0x76, 0xed, 0x33, 0x07, 0xab, 0x4c, 0x53,
+	0x51, 0xa0, 0x1c, 0x46, 0xa7, 0x69, 0xaf, 0xca, 0x21, 0x5c, 0x9a, 0x81, 0x60, 0xe8, 0x1e, 0x24,
+	0xc9, 0xc8, 0xab, 0xc4, 0x37, 0x92, 0x0b, 0xc5, 0x44, 0x31, 0x32, 0x6a, 0xa3, 0xbc, 0x00, 0xcb,
+	0x13, 0xf1, 0x2e, 0xf0, 0x47, 0xc4, 0x83, 0x7f, 0x84, 0xb2, 0x0c, 0xc5, 0x50, 0x70, 0x53, 0xae,
+	0xc0, 0xea, 0xac, 0x58, 0xa5, 0xf4, 0x60, 0x75, 0x56, 0xcc, 0x41, 0xaf, 0x42, 0xd6, 0x0f, 0x56,
+	0x1c, 0x17, 0xa6, 0x67, 0x5f, 0x2a, 0xab, 0xbe, 0x2a, 0x05, 0x04, 0xba, 0xb1, 0xd8, 0x1f, 0x96,
+	0x60, 0x53, 0x91, 0xd1, 0x1d, 0xa7, 0xa9, 0x7b, 0x3d, 0xe5, 0x43, 0xa8, 0x44, 0x05, 0xa2, 0x89,
+	0x61, 0xa4, 0xfc, 0x1f, 0xfb, 0x0a, 0xa4, 0x8f, 0x6d, 0x77, 0xa0, 0x13, 0xe6, 0xac, 0xa8, 0x8a,
+	0x12, 0xfd, 0xe1, 0x79, 0x50, 0x4a, 0x32, 0x31, 0x2f, 0x28, 0x1a, 0x5c, 0x8b, 0x0c, 0x46, 0xd4,
+	0xc4, 0xb4, 0x0c, 0xcc, 0x57, 0xa8, 0xa8, 0xf2, 0xc2, 0xd8, 0x11, 0xef, 0x2c, 0x2f, 0xd0, 0x66,
+	0x3d, 0x36, 0x56, 0xe6, 0x3f, 0xa7, 0x8a, 0x92, 0xf2, 0xf7, 0x1c, 0x64, 0x55, 0xec, 0x39, 0x14,
+	0x9d, 0xd0, 0x0e, 0xe4, 0xf0, 0xa8, 0x8b, 0x39, 0x4d, 0x8c, 0x47, 0xd2, 0x2c, 0xae, 0xdd, 0x90,
+	0x9a, 0x94, 0xe3, 0xf8, 0x66, 0xe8, 0xae, 0xa0, 0xc2, 0xd1, 0xac, 0x56, 0x98, 0x07, 0xb9, 0xf0,
+	0x6b, 0x92, 0x0b, 0x27, 0x23, 0x69, 0x0d, 0xb7, 0x9a, 0x20, 0xc3, 0x77, 0x05, 0x19, 0x4e, 0xcd,
+	0x69, 0x2c, 0xc4, 0x86, 0xeb, 0x21, 0x36, 0xbc, 0x34, 0x67, 0x98, 0x11, 0x74, 0xb8, 0x1e, 0xa2,
+	0xc3, 0xe9, 0x39, 0x4e, 0x22, 0xf8, 0xf0, 0x6b, 0x92, 0x0f, 0x67, 0xe6, 0x0c, 0x7b, 0x82, 0x10,
+	0x3f, 0x08, 0x13, 0xe2, 0x6c, 0x44, 0xe4, 0x90, 0xd6, 0x91, 0x8c, 0xf8, 0x17, 0x01, 0x46, 0x9c,
+	0x8b, 0xa4, 0xa3, 0xdc, 0xc9, 0x0c, 0x4a, 0x5c, 0x0f, 0x51, 0x62, 0x98, 0x33, 0x07, 0x11, 0x9c,
+	0xf8, 0xcd, 0x20, 0x27, 0xce, 0x47, 0xd2, 0x6a, 0xf1, 0xd3, 0xcc, 0x22, 0xc5, 0xf7, 0x7c, 0x52,
+	0x5c, 0x88, 0x64, 0xf5, 0x62, 0x0c, 0x93, 0xac, 0xb8, 0x35, 0xc5, 0x8a, 0x39, 0x8b, 0x7d, 0x2e,
+	0xd2, 0xc5, 0x1c, 0x5a, 0xdc, 0x9a, 0xa2, 0xc5, 0xa5, 0x39, 0x0e, 0xe7, 0xf0, 0xe2, 0xdf, 0xcd,
+	0xe6, 0xc5, 0xd1, 0xcc, 0x55, 0x74, 0x73, 0x31, 0x62, 0xac, 0x45, 0x10, 0x63, 0xce, 0x5f, 0x5f,
+	0x8c, 0x74, 0xbf, 0x30, 0x33, 0xde, 0x9f, 0x64, 0xc6, 0x2b, 0x91, 0xa7, 0x84, 0xd0, 0x5f, 0xf2,
+	0x74, 0xd4, 0xf8, 0x05, 0x58, 0x91, 0xfe, 0x7c, 0x94, 0xa2, 0xb8, 0x88, 0x5d, 0xd7, 0x76, 0x05,
+	0xa3, 0xe4, 0x05, 0xe5, 0x36, 0x14, 0x7c, 0xd5, 0xf3, 0x69, 0x34, 0x8b, 0x3f, 0x01, 0x14, 0x52,
+	0xfe, 0x96, 0x80, 0x42, 0x10, 0x60, 0x42, 0x9c, 0x27, 0x27, 0x38, 0x4f, 0x80, 0x5c, 0x27, 0xc2,
+	0xe4, 0x7a, 0x1d, 0xf2, 0x34, 0xae, 0x4c, 0xf0, 0x66, 0xdd, 0xf1, 0x79, 0xf3, 0x1d, 0x58, 0x61,
+	0x54, 0x84, 0x53, 0x70, 0x11, 0x4c, 0x52, 0x2c, 0x26, 0x2e, 0xd3, 0x0a, 0xbe, 0x13, 0x98, 0x18,
+	0xbd, 0x0c, 0x97, 0x02, 0xba, 0x7e, 0xbc, 0xe2, 0x0c, 0xb0, 0xec, 0x6b, 0xd7, 0x78, 0xe0, 0x42,
+	0x6f, 0xc2, 0x4d, 0xc1, 0x72, 0x5c, 0xcc, 0x21, 0x4c, 0xa3, 0xd5, 0xd8, 0x90, 0xcd, 0x18, 0x2c,
+	0xa2, 0x5c, 0xe3, 0x5c, 0xc6, 0xc5, 0x0c, 0xae, 0xf6, 0x99, 0x86, 0x68, 0xf0, 0xd6, 0xe4, 0xc2,
+	0x62, 0xc6, 0xd3, 0xc2, 0xeb, 0xb5, 0x0e, 0xf9, 0x9e, 0xde, 0x27, 0xd2, 0xe9, 0x31, 0xeb, 0x3b,
+	0x50, 0x91, 0x60, 0x8d, 0xef, 0xc0, 0xca, 0x14, 0xce, 0xd2, 0x69, 0xec, 0xda, 0x06, 0x16, 0x51,
+	0x8d, 0x7d, 0xd3, 0xc3, 0x40, 0xdf, 0x3e, 0x11, 0xb1, 0x8b, 0x7e, 0x52, 0x2d, 0x1f, 0xfa, 0x73,
+	0x1c, 0xd9, 0x95, 0x3f, 0x27, 0x60, 0x65, 0x0a, 0x72, 0x67, 0xd2, 0xf6, 0xf8, 0x4f, 0xa5, 0xed,
+	0x41, 0x36, 0x90, 0x0c, 0xb1, 0x01, 0xf4, 0x3e, 0xac, 0x86, 0x18, 0xbd, 0x36, 0x64, 0x6c, 0xbd,
+	0x62, 0x44, 0xc0, 0x73, 0x04, 0xb1, 0x8f, 0xa9, 0xe8, 0x74, 0xaa, 0x06, 0x7d, 0x00, 0xd7, 0x2d,
+	0x3c, 0x9a, 0x5a, 0x31, 0xd9, 0x06, 0x9e, 0x46, 0x3e, 0x4e, 0x72, 0x43, 0xab, 0xa7, 0x5e, 0xa5,
+	0x3e, 0x42, 0x22, 0xee, 0x5e, 0xf9, 0x77, 0x1c, 0x8a, 0xa1, 0x60, 0xf3, 0xd3, 0x57, 0x61, 0x4c,
+	0x4b, 0x96, 0xd8, 0x7a, 0xf3, 0x82, 0x3c, 0xce, 0xa5, 0xd9, 0x9c, 0x85, 0x8f, 0x73, 0x19, 0x26,
+	0xe3, 0x05, 0xf4, 0x06, 0xe4, 0x58, 0xde, 0x4f, 0xb3, 0x1d, 0x4f, 0x44, 0xb6, 0xeb, 0xc1, 0x61,
+	0xf1, 0xf4, 0xde, 0xe6, 0x21, 0xd5, 0x69, 0x39, 0x9e, 0x9a, 0x75, 0xc4, 0x57, 0x80, 0x71, 0xe5,
+	0x42, 0x47, 0x89, 0x1b, 0x90, 0xa3, 0xbd, 0xf7, 0x1c, 0xbd, 0x8b, 0x59, 0x94, 0xca, 0xa9, 0x63,
+	0x81, 0xf2, 0x18, 0xd0, 0x74, 0x9c, 0x44, 0x4d, 0x48, 0xe3, 0x53, 0x6c, 0x11, 0xc9, 0x6a, 0xaf,
+	0xcc, 0xe0, 0xe9, 0xd8, 0x22, 0x3b, 0x15, 0xba, 0x60, 0xff, 0xfa, 0x76, 0xbd, 0xcc, 0xb5, 0x5f,
+	0xb2, 0x07, 0x26, 0xc1, 0x03, 0x87, 0x9c, 0xa9, 0xc2, 0x5e, 0xf9, 0x2c, 0x01, 0xcb, 0xb2, 0x01,
+	0xc9, 0xd6, 0x67, 0xcd, 0xad, 0x04, 0x8f, 0x44, 0xe0, 0xc0, 0xb4, 0xd8, 0x7c, 0xaf, 0x01, 0x9c,
+	0xe8, 0x9e, 0xf6, 0x89, 0x6e, 0x11, 0x6c, 0x88, 0x49, 0x0f, 0x48, 0x50, 0x15, 0xb2, 0xb4, 0x34,
+	0xf4, 0xb0, 0x21, 0xce, 0x7a, 0x7e, 0x39, 0x30, 0xce, 0xcc, 0xd3, 0x8d, 0x33, 0x3c, 0xcb, 0xd9,
+	0xc9, 0x59, 0xfe, 0x7d, 0x60, 0x67, 0x8e, 0xcf, 0x17, 0xff, 0x7d, 0xf3, 0xb0, 0x0f, 0xab, 0x13,
+	0xd3, 0xc0, 0x91, 0xf2, 0xff, 0x82, 0x47, 0xa8, 0x05, 0x38, 0x14, 0x3f, 0x3d, 0xfd, 0x81, 0xa5,
+	0x3e, 0xc2, 0xd4, 0x08, 0xfd, 0x1a, 0xae, 0x4e, 0xc0, 0x9d, 0x00, 0x09, 0xaf, 0x92, 0x58, 0x10,
+	0xf5, 0x2e, 0x87, 0x51, 0x8f, 0x63, 0x84, 0x17, 0x98, 0xa4, 0xe4, 0x53, 0x4e, 0xd2, 0x1c, 0x34,
+	0x33, 0x9e, 0x0e, 0xcd, 0x22, 0x91, 0x18, 0x5f, 0x34, 0xc5, 0x32, 0x0b, 0x89, 0xe7, 0x06, 0xb5,
+	0x5d, 0x28, 0xc9, 0x45, 0xe1, 0x84, 0x73, 0xe6, 0x3f, 0x7d, 0x0b, 0x8a, 0x2e, 0x26, 0x74, 0xe4,
+	0xa1, 0xfc, 0x47, 0x81, 0x0b, 0x85, 0xab, 0x43, 0xb8, 0x3c, 0x93, 0x78, 0xa2, 0xd7, 0x21, 0x37,
+	0xe6, 0xac, 0xf1, 0x88, 0x54, 0x82, 0x54, 0x57, 0xc7, 0xba, 0xca, 0x5f, 0xe3, 0x70, 0x79, 0x26,
+	0xf5, 0x44, 0x0d, 0x48, 0xbb, 0xd8, 0x1b, 0xf6, 0xf9, 0x81, 0xb5, 0xb4, 0xfd, 0xf2, 0x62, 0x94,
+	0x95, 0x4a, 0x87, 0x7d, 0xa2, 0x0a, 0x63, 0xe5, 0x31, 0xa4, 0xb9, 0x04, 0xe5, 0x21, 0xf3, 0xf0,
+	0x60, 0xef, 0xa0, 0xf5, 0xde, 0x41, 0x39, 0x86, 0x00, 0xd2, 0xb5, 0x7a, 0xbd, 0x71, 0xd8, 0x2e,
+	0xc7, 0x51, 0x0e, 0x96, 0x6a, 0x3b, 0x2d, 0xb5, 0x5d, 0x4e, 0x50, 0xb1, 0xda, 0x78, 0xbb, 0x51,
+	0x6f, 0x97, 0x93, 0x68, 0x05, 0x8a, 0xfc, 0x5b, 0x7b, 0xd0, 0x52, 0xdf, 0xa9, 0xb5, 0xcb, 0xa9,
+	0x80, 0xe8, 0xa8, 0x71, 0x70, 0xbf, 0xa1, 0x96, 0x97, 0x94, 0x57, 0xe0, 0x9a, 0xec, 0xc7, 0xf4,
+	0xa1, 0xdb, 0x3f, 0xfb, 0xc6, 0x03, 0x67, 0x5f, 0xe5, 0x8f, 0x09, 0xa8, 0x46, 0x33, 0x57, 0xf4,
+	0xf6, 0xc4, 0xc0, 0xb7, 0x2f, 0x40, 0x7b, 0x27, 0x46, 0x4f, 0xb3, 0x6b, 0x2e, 0x3e, 0xc6, 0xa4,
+	0xdb, 0xe3, 0x4c, 0x9a, 0xee, 0xb9, 0xe4, 0xed, 0xa2, 0x5a, 0x14, 0x52, 0x66, 0xe4, 0x71, 0xb5,
+	0x8f, 0x70, 0x97, 0x68, 0xfc, 0x18, 0xce, 0x77, 0x54, 0x4e, 0x2d, 0x72, 0xe9, 0x11, 0x17, 0x2a,
+	0x1f, 0x5e, 0x68, 0x2e, 0x73, 0xb0, 0xa4, 0x36, 0xda, 0xea, 0x6f, 0xca, 0x49, 0x84, 0xa0, 0xc4,
+	0x3e, 0xb5, 0xa3, 0x83, 0xda, 0xe1, 0x51, 0xb3, 0x45, 0xe7, 0xf2, 0x12, 0x2c, 0xcb, 0xb9, 0x94,
+	0xc2, 0x25, 0xca, 0x61, 0x97, 0x27, 0x76, 0x3f, 0xda, 0x86, 0x25, 0x7e, 0x1a, 0x8b, 0xba, 0xcd,
+	0x62, 0x38, 0xc3, 0x95, 0xd5, 0xa5, 0x8e, 0xbc, 0x5b, 0xc1, 0x22, 0x71, 0x35, 0x0b, 0x65, 0xf8,
+	0xee, 0x95, 0xa9, 0x2d, 0x61, 0xea, 0x5b, 0xd0, 0x7b, 0x11, 0x7f, 0xa3, 0x55, 0x92, 0xd3, 0x67,
+	0x40, 0x6e, 0xee, 0xef, 0x52, 0x61, 0x3f, 0xb6, 0x41, 0xf7, 0xc6, 0x2c, 0x3b, 0x15, 0x85, 0x1d,
+	0x82, 0x56, 0x0b, 0x63, 0xa9, 0x4f, 0xdb, 0xf6, 0xce, 0xac, 0x6e, 0xcf, 0xb5, 0xad, 0xb3, 0x59,
+	0xd7, 0x5a, 0xdc, 0xf8, 0x48, 0xaa, 0xc8, 0xb6, 0x7d, 0x1b, 0xa5, 0x0e, 0xf9, 0xc0, 0x84, 0xa0,
+	0xeb, 0x90, 0x1b, 0xe8, 0x23, 0x91, 0x81, 0xe5, 0x19, 0xac, 0xec, 0x40, 0x1f, 0xf1, 0xe4, 0xeb,
+	0x55, 0xc8, 0xd0, 0xca, 0x13, 0x9d, 0x63, 0x71, 0x52, 0x4d, 0x0f, 0xf4, 0xd1, 0x5b, 0xba, 0xa7,
+	0xfc, 0x29, 0x0e, 0xa5, 0x70, 0x3a, 0x91, 0xfe, 0xcb, 0xae, 0x3d, 0xb4, 0x0c, 0xe6, 0x64, 0x49,
+	0xe5, 0x05, 0x8a, 0x3e, 0x1f, 0x0f, 0x6d, 0x77, 0x38, 0x08, 0x52, 0x50, 0xe0, 0x22, 0xc6, 0x42,
+	0x9f, 0x87, 0x65, 0x7e, 0x08, 0xf0, 0xcc, 0x13, 0x4b, 0x27, 0x43, 0x97, 0xa7, 0x50, 0x0b, 0x6a,
+	0x89, 0x89, 0x8f, 0xa4, 0x94, 0x2a, 0xf2, 0x64, 0xf1, 0x58, 0x91, 0x1f, 0x17, 0x4a, 0x4c, 0xec,
+	0x2b, 0x2a, 0x9f, 0xc2, 0x12, 0xc3, 0x75, 0x0a, 0x63, 0x2c, 0xa9, 0x28, 0xce, 0x37, 0xf4, 0x1b,
+	0x7d, 0x00, 0xa0, 0x13, 0xe2, 0x9a, 0x9d, 0x21, 0x0f, 0x30, 0xc9, 0x99, 0x07, 0x70, 0x66, 0x5f,
+	0x93, 0x7a, 0x3b, 0x37, 0x44, 0x80, 0x58, 0x1d, 0x9b, 0x06, 0x82, 0x44, 0xc0, 0xa1, 0x72, 0x00,
+	0xa5, 0xb0, 0x6d, 0xf0, 0x5a, 0xa0, 0x30, 0xe3, 0x5a, 0xc0, 0xe7, 0x91, 0x3e, 0x0b, 0x4d, 0xf2,
+	0x04, 0x32, 0x2b, 0x28, 0x5f, 0xc4, 0x21, 0xdb, 0x1e, 0x89, 0x4d, 0x15, 0x91, 0x69, 0x1c, 0x9b,
+	0x26, 0x82, 0x79, 0x35, 0x9e, 0x0c, 0x4d, 0xfa, 0x29, 0xd6, 0x37, 0x7d, 0xd8, 0x48, 0x2d, 0x9a,
+	0xf9, 0x90, 0xb9, 0x66, 0x01, 0x95, 0x35, 0xc8, 0xf9, 0xff, 0x34, 0x6d, 0xd4, 0xb1, 0x3f, 0x11,
+	0xf9, 0xb9, 0xa4, 0xca, 0x0b, 0x68, 0x0d, 0xf2, 0x8e, 0x6b, 0x6b, 0x64, 0xc4, 0x97, 0x9b, 0xaf,
+	0x24, 0x25, 0xc8, 0xed, 0x11, 0xcb, 0x40, 0x7e, 0x1e, 0x87, 0x65, 0xdf, 0x87, 0x08, 0x50, 0x3f,
+	0x83, 0x8c, 0x33, 0xec, 0x68, 0x72, 0x96, 0x26, 0x76, 0xb0, 0xe4, 0xcf, 0xc3, 0x4e, 0xdf, 0xec,
+	0xee, 0xe1, 0x33, 0x11, 0xe9, 0xd2, 0xce, 0xb0, 0xb3, 0xc7, 0x27, 0x93, 0x77, 0x23, 0x71, 0x4e,
+	0x37, 0x92, 0x93, 0xdd, 0xf8, 0x2e, 0x0e, 0x68, 0x3a, 0x88, 0xa2, 0x23, 0x58, 0x19, 0xc7, 0x61,
+	0x49, 0x42, 0x78, 0xb4, 0xda, 0x88, 0x0e, 0xc2, 0xa1, 0xb3, 0x50, 0xf9, 0x34, 0x2c, 0xf6, 0x50,
+	0x1b, 0x56, 0x49, 0xcf, 0xc5, 0x5e, 0xcf, 0xee, 0x1b, 0x9a, 0xc3, 0x86, 0xc1, 0xc6, 0x9a, 0x58,
+	0x70, 0xac, 0x31, 0x15, 0xf9, 0xf6, 0x7e, 0xcd, 0xdc, 0x7d, 0xa5, 0x38, 0x50, 0x69, 0x4f, 0x99,
+	0x89, 0x71, 0x46, 0x75, 0x29, 0xfe, 0x34, 0x5d, 0x52, 0xee, 0x42, 0xf9, 0x5d, 0xbf, 0xfd, 0x31,
+	0xf9, 0x08, 0x76, 0x33, 0x3e, 0xd5, 0xcd, 0x53, 0xc8, 0x3e, 0xb2, 0x09, 0xcf, 0x47, 0xfc, 0x32,
+	0x08, 0xab, 0xf2, 0x26, 0x2c, 0x72, 0xda, 0x45, 0x4f, 0xc6, 0x26, 0x34, 0x01, 0x41, 0xb1, 0x01,
+	0x1b, 0xda, 0x38, 0xb7, 0xc0, 0xa6, 0x39, 0xab, 0x2e, 0xf3, 0x8a, 0x7d, 0x99, 0x58, 0x50, 0x7e,
+	0x8c, 0x43, 0x56, 0xe2, 0x3b, 0x7a, 0x25, 0x00, 0x14, 0xa5, 0x19, 0x69, 0x59, 0xa9, 0x38, 0xbe,
+	0x7e, 0x08, 0xf7, 0x35, 0x71, 0xf1, 0xbe, 0x46, 0xdd, 0x23, 0xc9, 0x8b, 0xc0, 0xd4, 0x85, 0x2f,
+	0x02, 0x5f, 0x02, 0x44, 0x6c, 0xa2, 0xf7, 0xb5, 0x53, 0x9b, 0x98, 0xd6, 0x89, 0xc6, 0xb7, 0x05,
+	0x3f, 0x56, 0x94, 0x59, 0xcd, 0x23, 0x56, 0x71, 0x48, 0xe5, 0xca, 0x5f, 0xe2, 0x90, 0xf5, 0xa9,
+	0xd4, 0x45, 0x73, 0xff, 0x57, 0x20, 0x2d, 0xd8, 0x02, 0x4f, 0xfe, 0x8b, 0x92, 0x7f, 0xb1, 0x95,
+	0x0a, 0x5c, 0x6c, 0x55, 0x21, 0x3b, 0xc0, 0x44, 0x67, 0x7c, 0x92, 0xe3, 0xb5, 0x5f, 0x46, 0xaf,
+	0x43, 0x65, 0x4e, 0x46, 0xe7, 0x72, 0x77, 0x56, 0x36, 0xe7, 0xce, 0x3d, 0xc8, 0x07, 0x6e, 0x84,
+	0x28, 0xc6, 0x1e, 0x34, 0xde, 0x2b, 0xc7, 0xaa, 0x99, 0x2f, 0xbe, 0xda, 0x48, 0x1e, 0xe0, 0x4f,
+	0x68, 0x1a, 0x4b, 0x6d, 0xd4, 0x9b, 0x8d, 0xfa, 0x5e, 0x39, 0x5e, 0xcd, 0x7f, 0xf1, 0xd5, 0x46,
+	0x46, 0xc5, 0x2c, 0x0d, 0x7c, 0x67, 0x04, 0x85, 0xe0, 0x72, 0x86, 0x99, 0x0a, 0x82, 0xd2, 0xfd,
+	0x87, 0x87, 0xfb, 0xbb, 0xf5, 0x5a, 0xbb, 0xa1, 0x3d, 0x6a, 0xb5, 0x1b, 0xe5, 0x38, 0xba, 0x0a,
+	0x97, 0xf6, 0x77, 0xdf, 0x6a, 0xb6, 0xb5, 0xfa, 0xfe, 0x6e, 0xe3, 0xa0, 0xad, 0xd5, 0xda, 0xed,
+	0x5a, 0x7d, 0xaf, 0x9c, 0x40, 0x57, 0x00, 0xed, 0x1e, 0x3c, 0xaa, 0xed, 0xef, 0xde, 0xd7, 0xea,
+	0xcd, 0xda, 0xee, 0x81, 0xb6, 0xdf, 0xaa, 0xef, 0x95, 0x93, 0x54, 0x3e, 0x76, 0x72, 0xa8, 0xb6,
+	0x0e, 0x5b, 0x47, 0xb5, 0xfd, 0x72, 0x6a, 0xfb, 0x3b, 0x80, 0xe5, 0xda, 0x4e, 0x7d, 0x97, 0xb2,
+	0x32, 0xb3, 0xab, 0x8b, 0xb4, 0x7c, 0x8a, 0xa5, 0xf1, 0xce, 0x7d, 0x82, 0x53, 0x3d, 0xff, 0x56,
+	0x02, 0x3d, 0x80, 0x25, 0x96, 0xe1, 0x43, 0xe7, 0xbf, 0xc9, 0xa9, 0xce, 0xb9, 0xa6, 0xa0, 0x9d,
+	0x61, 0xfb, 0xf0, 0xdc, 0x47, 0x3a, 0xd5, 0xf3, 0x6f, 0x2d, 0x90, 0x0a, 0xb9, 0x71, 0x6a, 0x6c,
+	0xfe, 0xa3, 0x9d, 0xea, 0x02, 0x37, 0x19, 0xd4, 0xe7, 0xf8, 0x10, 0x3e, 0xff, 0xc2, 0xae, 0xba,
+	0x40, 0x68, 0x43, 0xef, 0x43, 0x21, 0x74, 0xa2, 0x5d, 0xe8, 0xf1, 0x43, 0x75, 0xb1, 0x44, 0x30,
+	0xda, 0x87, 0x8c, 0xcc, 0x9d, 0xcc, 0x7b, 0xb3, 0x53, 0x9d, 0x7b, 0x85, 0x41, 0xd7, 0x97, 0xe7,
+	0xb8, 0xce, 0x7f, 0x80, 0x54, 0x9d, 0x73, 0x1f, 0x83, 0x76, 0x21, 0x2d, 0x0e, 0x78, 0x73, 0xde,
+	0xe1, 0x54, 0xe7, 0x5d, 0x49, 0xd0, 0x15, 0x19, 0x27, 0x2c, 0xe7, 0x3f, 0xab, 0xaa, 0x2e, 0x70,
+	0xd5, 0x84, 0x1e, 0x02, 0x04, 0x32, 0x5a, 0x0b, 0xbc, 0x97, 0xaa, 0x2e, 0x72, 0x85, 0x84, 0x5a,
+	0x90, 0xf5, 0x73, 0x0d, 0x73, 0x5f, 0x2f, 0x55, 0xe7, 0xdf, 0xe5, 0xa0, 0xc7, 0x50, 0x0c, 0x1f,
+	0x6e, 0x17, 0x7b, 0x93, 0x54, 0x5d, 0xf0, 0x92, 0x86, 0xfa, 0x0f, 0x9f, 0x74, 0x17, 0x7b, 0xa3,
+	0x54, 0x5d, 0xf0, 0xce, 0x06, 0x7d, 0x04, 0x2b, 0xd3, 0x27, 0xd1, 0xc5, 0x9f, 0x2c, 0x55, 0x2f,
+	0x70, 0x8b, 0x83, 0x06, 0x80, 0x66, 0x9c, 0x60, 0x2f, 0xf0, 0x82, 0xa9, 0x7a, 0x91, 0x4b, 0x9d,
+	0x9d, 0xc6, 0xd7, 0xdf, 0xaf, 0xc5, 0xbf, 0xf9, 0x7e, 0x2d, 0xfe, 0xdd, 0xf7, 0x6b, 0xf1, 0x2f,
+	0x7f, 0x58, 0x8b, 0x7d, 0xf3, 0xc3, 0x5a, 0xec, 0x1f, 0x3f, 0xac, 0xc5, 0x7e, 0xfb, 0xe2, 0x89,
+	0x49, 0x7a, 0xc3, 0xce, 0x66, 0xd7, 0x1e, 0x6c, 0x05, 0x9f, 0x57, 0xce, 0x7a, 0xf2, 0xd9, 0x49,
+	0xb3, 0x70, 0x7b, 0xf7, 0x3f, 0x03, 0x00, 0x65, 0x73, 0x26, 0x80, 0x12, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3625,6 +3830,7 @@ type ABCIApplicationClient interface {
 	Info(ctx context.Context, in *RequestInfo, opts ...grpc.CallOption) (*ResponseInfo, error)
 	SetOption(ctx context.Context, in *RequestSetOption, opts ...grpc.CallOption) (*ResponseSetOption, error)
 	DeliverTx(ctx context.Context, in *RequestDeliverTx, opts ...grpc.CallOption) (*ResponseDeliverTx, error)
+	DeliverBatch(ctx context.Context, in *RequestDeliverBatch, opts ...grpc.CallOption) (*ResponseDeliverBatch, error)
 	CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error)
 	Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error)
 	Commit(ctx context.Context, in *RequestCommit, opts ...grpc.CallOption) (*ResponseCommit, error)
@@ -3690,6 +3896,15 @@ func (c *aBCIApplicationClient) DeliverTx(ctx context.Context, in *RequestDelive
 	return out, nil
 }
 
+func (c *aBCIApplicationClient) DeliverBatch(ctx context.Context, in *RequestDeliverBatch, opts ...grpc.CallOption) (*ResponseDeliverBatch, error) {
+	out := new(ResponseDeliverBatch)
+	err := c.cc.Invoke(ctx, "/tendermint.abci.ABCIApplication/DeliverBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aBCIApplicationClient) CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error) {
 	out := new(ResponseCheckTx)
 	err := c.cc.Invoke(ctx, "/tendermint.abci.ABCIApplication/CheckTx", in, out, opts...)
@@ -3787,6 +4002,7 @@ type ABCIApplicationServer interface {
 	Info(context.Context, *RequestInfo) (*ResponseInfo, error)
 	SetOption(context.Context, *RequestSetOption) (*ResponseSetOption, error)
 	DeliverTx(context.Context, *RequestDeliverTx) (*ResponseDeliverTx, error)
+	DeliverBatch(context.Context, *RequestDeliverBatch) (*ResponseDeliverBatch, error)
 	CheckTx(context.Context, *RequestCheckTx) (*ResponseCheckTx, error)
 	Query(context.Context, *RequestQuery) (*ResponseQuery, error)
 	Commit(context.Context, *RequestCommit) (*ResponseCommit, error)
@@ -3818,6 +4034,9 @@ func (*UnimplementedABCIApplicationServer) SetOption(ctx context.Context, req *R
 func (*UnimplementedABCIApplicationServer) DeliverTx(ctx context.Context, req *RequestDeliverTx) (*ResponseDeliverTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeliverTx not implemented")
 }
+func (*UnimplementedABCIApplicationServer) DeliverBatch(ctx context.Context, req *RequestDeliverBatch) (*ResponseDeliverBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeliverBatch not implemented")
+}
 func (*UnimplementedABCIApplicationServer) CheckTx(ctx context.Context, req *RequestCheckTx) (*ResponseCheckTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckTx not implemented")
 }
@@ -3943,6 +4162,24 @@ func _ABCIApplication_DeliverTx_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ABCIApplication_DeliverBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDeliverBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIApplicationServer).DeliverBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.abci.ABCIApplication/DeliverBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIApplicationServer).DeliverBatch(ctx, req.(*RequestDeliverBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ABCIApplication_CheckTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestCheckTx)
 	if err := dec(in); err != nil {
@@ -4147,6 +4384,10 @@ var _ABCIApplication_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeliverTx",
 			Handler:    _ABCIApplication_DeliverTx_Handler,
 		},
+		{
+			MethodName: "DeliverBatch",
+			Handler:    _ABCIApplication_DeliverBatch_Handler,
+		},
 		{
 			MethodName: "CheckTx",
 			Handler:    _ABCIApplication_CheckTx_Handler,
@@ -4212,6 +4453,13 @@ func (m *Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequestId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa0
+	}
 	if m.Value != nil {
 		{
 			size := m.Value.Size()
@@ -4539,6 +4787,29 @@ func (m *Request_ApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Request_DeliverBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_DeliverBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeliverBatch != nil {
+		{
+			size, err := m.DeliverBatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
 func (m *RequestEcho) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4612,6 +4883,13 @@ func (m *RequestInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AbciVersion) > 0 {
+		i -= len(m.AbciVersion)
+		copy(dAtA[i:], m.AbciVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AbciVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.P2PVersion != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.P2PVersion))
 		i--
@@ -4737,12 +5015,12 @@ func (m *RequestInitChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err18 != nil {
-		return 0, err18
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n18
-	i = encodeVarintTypes(dAtA, i, uint64(n18))
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -4929,6 +5207,43 @@ func (m *RequestDeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestDeliverBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestDeliverBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestDeliverBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RequestEndBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5145,9 +5460,16 @@ func (m *Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		{
-			size := m.Value.Size()
+	if m.RequestId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RequestId))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
 			i -= size
 			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
@@ -5495,6 +5817,29 @@ func (m *Response_ApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, er
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Response_DeliverBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_DeliverBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DeliverBatch != nil {
+		{
+			size, err := m.DeliverBatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ResponseException) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5598,6 +5943,25 @@ func (m *ResponseInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HaltHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.DeliverBatch {
+		i--
+		if m.DeliverBatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.LastCoreChainLockedHeight != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.LastCoreChainLockedHeight))
 		i--
@@ -6031,6 +6395,43 @@ func (m *ResponseDeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseDeliverBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseDeliverBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseDeliverBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResponseEndBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6051,6 +6452,13 @@ func (m *ResponseEndBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HaltHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.ValidatorSetUpdate != nil {
 		{
 			size, err := m.ValidatorSetUpdate.MarshalToSizedBuffer(dAtA[:i])
@@ -6268,20 +6676,20 @@ func (m *ResponseApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 		}
 	}
 	if len(m.RefetchChunks) > 0 {
-		dAtA46 := make([]byte, len(m.RefetchChunks)*10)
-		var j45 int
+		dAtA48 := make([]byte, len(m.RefetchChunks)*10)
+		var j47 int
 		for _, num := range m.RefetchChunks {
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j47++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintTypes(dAtA, i, uint64(j45))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintTypes(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6313,6 +6721,18 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Synchrony != nil {
+		{
+			size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Version != nil {
 		{
 			size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
@@ -6854,12 +7274,12 @@ func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n56, err56 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err56 != nil {
-		return 0, err56
+	n59, err59 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err59 != nil {
+		return 0, err59
 	}
-	i -= n56
-	i = encodeVarintTypes(dAtA, i, uint64(n56))
+	i -= n59
+	i = encodeVarintTypes(dAtA, i, uint64(n59))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -6964,6 +7384,9 @@ func (m *Request) Size() (n int) {
 	if m.Value != nil {
 		n += m.Value.Size()
 	}
+	if m.RequestId != 0 {
+		n += 2 + sovTypes(uint64(m.RequestId))
+	}
 	return n
 }
 
@@ -7147,6 +7570,18 @@ func (m *Request_ApplySnapshotChunk) Size() (n int) {
 	}
 	return n
 }
+func (m *Request_DeliverBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeliverBatch != nil {
+		l = m.DeliverBatch.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *RequestEcho) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7185,6 +7620,10 @@ func (m *RequestInfo) Size() (n int) {
 	if m.P2PVersion != 0 {
 		n += 1 + sovTypes(uint64(m.P2PVersion))
 	}
+	l = len(m.AbciVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -7313,6 +7752,21 @@ func (m *RequestDeliverTx) Size() (n int) {
 	return n
 }
 
+func (m *RequestDeliverBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RequestEndBlock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7407,6 +7861,9 @@ func (m *Response) Size() (n int) {
 	if m.Value != nil {
 		n += m.Value.Size()
 	}
+	if m.RequestId != 0 {
+		n += 2 + sovTypes(uint64(m.RequestId))
+	}
 	return n
 }
 
@@ -7602,6 +8059,18 @@ func (m *Response_ApplySnapshotChunk) Size() (n int) {
 	}
 	return n
 }
+func (m *Response_DeliverBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeliverBatch != nil {
+		l = m.DeliverBatch.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *ResponseException) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7664,6 +8133,12 @@ func (m *ResponseInfo) Size() (n int) {
 	if m.LastCoreChainLockedHeight != 0 {
 		n += 2 + sovTypes(uint64(m.LastCoreChainLockedHeight))
 	}
+	if m.DeliverBatch {
+		n += 3
+	}
+	if m.HaltHeight != 0 {
+		n += 2 + sovTypes(uint64(m.HaltHeight))
+	}
 	return n
 }
 
@@ -7847,6 +8322,21 @@ func (m *ResponseDeliverTx) Size() (n int) {
 	return n
 }
 
+func (m *ResponseDeliverBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResponseEndBlock) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7871,6 +8361,9 @@ func (m *ResponseEndBlock) Size() (n int) {
 		l = m.ValidatorSetUpdate.Size()
 		n += 2 + l + sovTypes(uint64(l))
 	}
+	if m.HaltHeight != 0 {
+		n += 2 + sovTypes(uint64(m.HaltHeight))
+	}
 	return n
 }
 
@@ -7977,6 +8470,10 @@ func (m *ConsensusParams) Size() (n int) {
 		l = m.Version.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Synchrony != nil {
+		l = m.Synchrony.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -8785,6 +9282,60 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Request_ApplySnapshotChunk{v}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestDeliverBatch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_DeliverBatch{v}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -9037,6 +9588,38 @@ func (m *RequestInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbciVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbciVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -9957,6 +10540,90 @@ func (m *RequestDeliverTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RequestDeliverBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestDeliverBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestDeliverBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, RequestDeliverTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RequestEndBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11077,6 +11744,60 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Response_ApplySnapshotChunk{v}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseDeliverBatch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_DeliverBatch{v}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			m.RequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11496,6 +12217,45 @@ func (m *ResponseInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 101:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverBatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeliverBatch = bool(v != 0)
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -12772,6 +13532,90 @@ func (m *ResponseDeliverTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseDeliverBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseDeliverBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseDeliverBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &ResponseDeliverTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12943,6 +13787,25 @@ func (m *ResponseEndBlock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 102:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -13654,6 +14517,42 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Synchrony == nil {
+				m.Synchrony = &types1.SynchronyParams{}
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1,9 +1,8 @@
-package v2
+package blockchain
 
 import (
 	"fmt"
 
-	bc "github.com/tendermint/tendermint/blockchain"
 	"github.com/tendermint/tendermint/p2p"
 	bcproto "github.com/tendermint/tendermint/proto/tendermint/blockchain"
 	"github.com/tendermint/tendermint/state"
@@ -47,7 +46,7 @@ func (sio *switchIO) sendBlockRequest(peerID p2p.ID, height int64) error {
 	if peer == nil {
 		return fmt.Errorf("peer not found")
 	}
-	msgBytes, err := bc.EncodeMsg(&bcproto.BlockRequest{Height: height})
+	msgBytes, err := EncodeMsg(&bcproto.BlockRequest{Height: height})
 	if err != nil {
 		return err
 	}
@@ -65,7 +64,7 @@ func (sio *switchIO) sendStatusResponse(base int64, height int64, peerID p2p.ID)
 		return fmt.Errorf("peer not found")
 	}
 
-	msgBytes, err := bc.EncodeMsg(&bcproto.StatusResponse{Height: height, Base: base})
+	msgBytes, err := EncodeMsg(&bcproto.StatusResponse{Height: height, Base: base})
 	if err != nil {
 		return err
 	}
@@ -91,7 +90,7 @@ func (sio *switchIO) sendBlockToPeer(block *types.Block, peerID p2p.ID) error {
 		return err
 	}
 
-	msgBytes, err := bc.EncodeMsg(&bcproto.BlockResponse{Block: bpb})
+	msgBytes, err := EncodeMsg(&bcproto.BlockResponse{Block: bpb})
 	if err != nil {
 		return err
 	}
@@ -107,7 +106,7 @@ func (sio *switchIO) sendBlockNotFound(height int64, peerID p2p.ID) error {
 	if peer == nil {
 		return fmt.Errorf("peer not found")
 	}
-	msgBytes, err := bc.EncodeMsg(&bcproto.NoBlockResponse{Height: height})
+	msgBytes, err := EncodeMsg(&bcproto.NoBlockResponse{Height: height})
 	if err != nil {
 		return err
 	}
@@ -128,7 +127,7 @@ func (sio *switchIO) trySwitchToConsensus(state state.State, skipWAL bool) bool
 }
 
 func (sio *switchIO) broadcastStatusRequest() error {
-	msgBytes, err := bc.EncodeMsg(&bcproto.StatusRequest{})
+	msgBytes, err := EncodeMsg(&bcproto.StatusRequest{})
 	if err != nil {
 		return err
 	}
@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"github.com/go-kit/kit/metrics"
@@ -15,6 +15,9 @@ const (
 
 // Metrics contains metrics exposed by this package.
 type Metrics struct {
+	// The events and errors of the routines are labeled with the names of the
+	// routines ("routine").
+
 	// events_in
 	EventsIn metrics.Counter
 	// events_in
@@ -35,75 +38,103 @@ type Metrics struct {
 	ErrorsSent metrics.Counter
 	// errors_shed
 	ErrorsShed metrics.Counter
+
+	// Number of commits scheduled for verification, which are not verified yet.
+	VerificationBacklog metrics.Gauge
+	// Number of commits verified ahead of the execution of their blocks.
+	CommitsVerifiedAhead metrics.Counter
+	// Number of commits verified on the execution of their blocks.
+	CommitsVerifiedInline metrics.Counter
 }
 
-// PrometheusMetrics returns metrics for in and out events, errors, etc. handled by routines.
-// Can we burn in the routine name here?
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
 func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 	labels := []string{}
 	for i := 0; i < len(labelsAndValues); i += 2 {
 		labels = append(labels, labelsAndValues[i])
 	}
+	// the events and errors are counted per routine
+	routineLabels := append(append([]string{}, labels...), "routine")
 	return &Metrics{
 		EventsIn: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "events_in",
 			Help:      "Events read from the channel.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		EventsHandled: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "events_handled",
 			Help:      "Events handled",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		EventsOut: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "events_out",
 			Help:      "Events output from routine.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		ErrorsIn: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "errors_in",
 			Help:      "Errors read from the channel.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		ErrorsHandled: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "errors_handled",
 			Help:      "Errors handled.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		ErrorsOut: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "errors_out",
 			Help:      "Errors output from routine.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		ErrorsSent: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "errors_sent",
 			Help:      "Errors sent to routine.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		ErrorsShed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "errors_shed",
 			Help:      "Errors dropped from sending.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		EventsSent: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "events_sent",
 			Help:      "Events sent to routine.",
-		}, labels).With(labelsAndValues...),
+		}, routineLabels).With(labelsAndValues...),
 		EventsShed: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
 			Name:      "events_shed",
 			Help:      "Events dropped from sending.",
+		}, routineLabels).With(labelsAndValues...),
+		VerificationBacklog: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "verification_backlog",
+			Help:      "Number of commits scheduled for verification, which are not verified yet.",
+		}, labels).With(labelsAndValues...),
+		CommitsVerifiedAhead: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commits_verified_ahead",
+			Help:      "Number of commits verified ahead of the execution of their blocks.",
+		}, labels).With(labelsAndValues...),
+		CommitsVerifiedInline: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "commits_verified_inline",
+			Help:      "Number of commits verified on the execution of their blocks.",
 		}, labels).With(labelsAndValues...),
 	}
 }
@@ -111,15 +142,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 // NopMetrics returns no-op Metrics.
 func NopMetrics() *Metrics {
 	return &Metrics{
-		EventsIn:      discard.NewCounter(),
-		EventsHandled: discard.NewCounter(),
-		EventsOut:     discard.NewCounter(),
-		ErrorsIn:      discard.NewCounter(),
-		ErrorsHandled: discard.NewCounter(),
-		ErrorsOut:     discard.NewCounter(),
-		EventsShed:    discard.NewCounter(),
-		EventsSent:    discard.NewCounter(),
-		ErrorsSent:    discard.NewCounter(),
-		ErrorsShed:    discard.NewCounter(),
+		EventsIn:              discard.NewCounter(),
+		EventsHandled:         discard.NewCounter(),
+		EventsOut:             discard.NewCounter(),
+		ErrorsIn:              discard.NewCounter(),
+		ErrorsHandled:         discard.NewCounter(),
+		ErrorsOut:             discard.NewCounter(),
+		EventsShed:            discard.NewCounter(),
+		EventsSent:            discard.NewCounter(),
+		ErrorsSent:            discard.NewCounter(),
+		ErrorsShed:            discard.NewCounter(),
+		VerificationBacklog:   discard.NewGauge(),
+		CommitsVerifiedAhead:  discard.NewCounter(),
+		CommitsVerifiedInline: discard.NewCounter(),
 	}
 }
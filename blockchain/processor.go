@@ -1,6 +1,7 @@
-package v2
+package blockchain
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/tendermint/tendermint/p2p"
@@ -15,11 +16,13 @@ type pcBlockVerificationFailure struct {
 	height       int64
 	firstPeerID  p2p.ID
 	secondPeerID p2p.ID
+	// the peer, which supplied the invalid block or the invalid commit
+	faultyPeerID p2p.ID
 }
 
 func (e pcBlockVerificationFailure) String() string {
-	return fmt.Sprintf("pcBlockVerificationFailure{%d 1st peer: %v, 2nd peer: %v}",
-		e.height, e.firstPeerID, e.secondPeerID)
+	return fmt.Sprintf("pcBlockVerificationFailure{%d 1st peer: %v, 2nd peer: %v, faulty peer: %v}",
+		e.height, e.firstPeerID, e.secondPeerID, e.faultyPeerID)
 }
 
 // successful block execution
@@ -105,6 +108,14 @@ func (state *pcState) enqueue(peerID p2p.ID, block *types.Block, height int64) {
 	state.queue[height] = queueItem{block: block, peerID: peerID}
 }
 
+// blockAt returns the enqueued block at the height, if any.
+func (state *pcState) blockAt(height int64) *types.Block {
+	if item, ok := state.queue[height]; ok {
+		return item.block
+	}
+	return nil
+}
+
 func (state *pcState) height() int64 {
 	return state.context.tmState().LastBlockHeight
 }
@@ -150,6 +161,15 @@ func (state *pcState) handle(event Event) (Event, error) {
 
 	case rProcessBlock:
 		tmState := state.context.tmState()
+		// the blocks after the halt height are not valid until the chain is
+		// resumed, so the sync stops at the halt height
+		if tmState.IsHalted() {
+			return pcFinished{tmState: tmState, blocksSynced: state.blocksSynced}, nil
+		}
+
+		// verify the commits of the blocks ahead in the background
+		state.context.scheduleVerification(state.blockAt)
+
 		firstItem, secondItem, err := state.nextTwo()
 		if err != nil {
 			if state.draining {
@@ -158,22 +178,23 @@ func (state *pcState) handle(event Event) (Event, error) {
 			return noOp, nil
 		}
 
-		var (
-			first, second = firstItem.block, secondItem.block
-			firstParts    = first.MakePartSet(types.BlockPartSizeBytes)
-			firstID       = types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
-			firstStateID  = types.StateID{LastAppHash: first.Header.AppHash}
-		)
+		first, second := firstItem.block, secondItem.block
 
 		// verify if +second+ last commit "confirms" +first+ block
-		err = state.context.verifyCommit(tmState.ChainID, firstID, firstStateID, first.Height, second.LastCommit)
+		firstID, firstParts, err := state.context.verifyBlock(first, second)
 		if err != nil {
 			state.purgePeer(firstItem.peerID)
 			if firstItem.peerID != secondItem.peerID {
 				state.purgePeer(secondItem.peerID)
 			}
+			// the commit of +second+ is at fault, unless it's valid on its own
+			faultyPeerID := secondItem.peerID
+			if errors.As(err, &errInvalidBlock{}) {
+				faultyPeerID = firstItem.peerID
+			}
 			return pcBlockVerificationFailure{
-					height: first.Height, firstPeerID: firstItem.peerID, secondPeerID: secondItem.peerID},
+					height: first.Height, firstPeerID: firstItem.peerID, secondPeerID: secondItem.peerID,
+					faultyPeerID: faultyPeerID},
 				nil
 		}
 
@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"fmt"
@@ -11,7 +11,8 @@ import (
 
 type processorContext interface {
 	applyBlock(blockID types.BlockID, block *types.Block) error
-	verifyCommit(chainID string, blockID types.BlockID, stateID types.StateID, height int64, commit *types.Commit) error
+	verifyBlock(block, next *types.Block) (types.BlockID, *types.PartSet, error)
+	scheduleVerification(blockAt func(height int64) *types.Block)
 	saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit)
 	tmState() state.State
 	setState(state.State)
@@ -21,14 +22,17 @@ type pContext struct {
 	store         blockStore
 	nodeProTxHash *crypto.ProTxHash
 	applier       blockApplier
+	verifier      *CommitVerifier
 	state         state.State
 }
 
-func newProcessorContext(st blockStore, nodeProTxHash *crypto.ProTxHash, ex blockApplier, s state.State) *pContext {
+func newProcessorContext(st blockStore, nodeProTxHash *crypto.ProTxHash, ex blockApplier,
+	verifier *CommitVerifier, s state.State) *pContext {
 	return &pContext{
 		store:         st,
 		nodeProTxHash: nodeProTxHash,
 		applier:       ex,
+		verifier:      verifier,
 		state:         s,
 	}
 }
@@ -47,9 +51,12 @@ func (pc *pContext) setState(state state.State) {
 	pc.state = state
 }
 
-func (pc pContext) verifyCommit(chainID string, blockID types.BlockID, stateID types.StateID,
-	height int64, commit *types.Commit) error {
-	return pc.state.Validators.VerifyCommit(chainID, blockID, stateID, height, commit)
+func (pc pContext) verifyBlock(block, next *types.Block) (types.BlockID, *types.PartSet, error) {
+	return pc.verifier.Verify(pc.state, block, next)
+}
+
+func (pc pContext) scheduleVerification(blockAt func(height int64) *types.Block) {
+	pc.verifier.ScheduleAhead(pc.state, blockAt)
 }
 
 func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
@@ -59,16 +66,20 @@ func (pc *pContext) saveBlock(block *types.Block, blockParts *types.PartSet, see
 type mockPContext struct {
 	applicationBL  []int64
 	verificationBL []int64
-	state          state.State
+	// heights of the blocks with invalid last commits
+	commitBL []int64
+	state    state.State
 }
 
 func newMockProcessorContext(
 	state state.State,
 	verificationBlackList []int64,
-	applicationBlackList []int64) *mockPContext {
+	applicationBlackList []int64,
+	commitBlackList []int64) *mockPContext {
 	return &mockPContext{
 		applicationBL:  applicationBlackList,
 		verificationBL: verificationBlackList,
+		commitBL:       commitBlackList,
 		state:          state,
 	}
 }
@@ -83,14 +94,22 @@ func (mpc *mockPContext) applyBlock(blockID types.BlockID, block *types.Block) e
 	return nil
 }
 
-func (mpc *mockPContext) verifyCommit(chainID string, blockID types.BlockID, stateID types.StateID,
-	height int64, commit *types.Commit) error {
+func (mpc *mockPContext) verifyBlock(block, next *types.Block) (types.BlockID, *types.PartSet, error) {
 	for _, h := range mpc.verificationBL {
-		if h == height {
-			return fmt.Errorf("generic verification error")
+		if h == block.Height {
+			return types.BlockID{}, nil, errInvalidBlock{fmt.Errorf("generic verification error")}
 		}
 	}
-	return nil
+	for _, h := range mpc.commitBL {
+		if h == next.Height {
+			return types.BlockID{}, nil, fmt.Errorf("generic commit verification error")
+		}
+	}
+	return types.BlockID{}, nil, nil
+}
+
+func (mpc *mockPContext) scheduleVerification(blockAt func(height int64) *types.Block) {
+
 }
 
 func (mpc *mockPContext) saveBlock(block *types.Block, blockParts *types.PartSet, seenCommit *types.Commit) {
@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"testing"
@@ -19,10 +19,12 @@ type pcBlock struct {
 // params is a test structure used to create processor state.
 type params struct {
 	height       int64
+	haltHeight   int64
 	items        []pcBlock
 	blocksSynced int
 	verBL        []int64
 	appBL        []int64
+	commitBL     []int64
 	draining     bool
 }
 
@@ -34,8 +36,8 @@ func makePcBlock(height int64) *types.Block {
 // makeState takes test parameters and creates a specific processor state.
 func makeState(p *params) *pcState {
 	var (
-		tmState = tmState.State{LastBlockHeight: p.height}
-		context = newMockProcessorContext(tmState, p.verBL, p.appBL)
+		tmState = tmState.State{LastBlockHeight: p.height, HaltHeight: p.haltHeight}
+		context = newMockProcessorContext(tmState, p.verBL, p.appBL, p.commitBL)
 	)
 	state := newPcState(context)
 
@@ -217,6 +219,40 @@ func TestRProcessBlockSuccess(t *testing.T) {
 	executeProcessorTests(t, tests)
 }
 
+func TestRProcessBlockHalted(t *testing.T) {
+	tests := []testFields{
+		{
+			name: "blocks up to the halt height are processed",
+			steps: []pcFsmMakeStateValues{
+				{
+					currentState:  &params{height: 1, haltHeight: 2, items: []pcBlock{{"P1", 2}, {"P2", 3}, {"P1", 4}}},
+					event:         rProcessBlock{},
+					wantState:     &params{height: 2, haltHeight: 2, items: []pcBlock{{"P2", 3}, {"P1", 4}}, blocksSynced: 1},
+					wantNextEvent: pcBlockProcessed{height: 2, peerID: "P1"},
+				},
+				{ // finish at the halt height, although the next blocks are present
+					event:         rProcessBlock{},
+					wantState:     &params{height: 2, haltHeight: 2, items: []pcBlock{{"P2", 3}, {"P1", 4}}, blocksSynced: 1},
+					wantNextEvent: pcFinished{tmState: tmState.State{LastBlockHeight: 2, HaltHeight: 2}, blocksSynced: 1},
+				},
+			},
+		},
+		{
+			name: "halted before the sync",
+			steps: []pcFsmMakeStateValues{
+				{
+					currentState:  &params{height: 5, haltHeight: 5, items: []pcBlock{{"P1", 6}, {"P2", 7}}},
+					event:         rProcessBlock{},
+					wantState:     &params{height: 5, haltHeight: 5, items: []pcBlock{{"P1", 6}, {"P2", 7}}},
+					wantNextEvent: pcFinished{tmState: tmState.State{LastBlockHeight: 5, HaltHeight: 5}},
+				},
+			},
+		},
+	}
+
+	executeProcessorTests(t, tests)
+}
+
 func TestRProcessBlockFailures(t *testing.T) {
 	tests := []testFields{
 		{
@@ -224,8 +260,21 @@ func TestRProcessBlockFailures(t *testing.T) {
 			steps: []pcFsmMakeStateValues{
 				{
 					currentState: &params{items: []pcBlock{{"P1", 1}, {"P2", 2}}, verBL: []int64{1}}, event: rProcessBlock{},
-					wantState:     &params{items: []pcBlock{}, verBL: []int64{1}},
-					wantNextEvent: pcBlockVerificationFailure{height: 1, firstPeerID: "P1", secondPeerID: "P2"},
+					wantState: &params{items: []pcBlock{}, verBL: []int64{1}},
+					wantNextEvent: pcBlockVerificationFailure{height: 1, firstPeerID: "P1", secondPeerID: "P2",
+						faultyPeerID: "P1"},
+				},
+			},
+		},
+		{
+			name: "blocks H+1 and H+2 present from different peers - H+2 last commit verification fails ",
+			steps: []pcFsmMakeStateValues{
+				{
+					currentState: &params{items: []pcBlock{{"P1", 1}, {"P2", 2}}, commitBL: []int64{2}},
+					event:        rProcessBlock{},
+					wantState:    &params{items: []pcBlock{}, commitBL: []int64{2}},
+					wantNextEvent: pcBlockVerificationFailure{height: 1, firstPeerID: "P1", secondPeerID: "P2",
+						faultyPeerID: "P2"},
 				},
 			},
 		},
@@ -244,8 +293,9 @@ func TestRProcessBlockFailures(t *testing.T) {
 				{
 					currentState: &params{height: 0, items: []pcBlock{{"P1", 1}, {"P1", 2}, {"P2", 3}},
 						verBL: []int64{1}}, event: rProcessBlock{},
-					wantState:     &params{height: 0, items: []pcBlock{{"P2", 3}}, verBL: []int64{1}},
-					wantNextEvent: pcBlockVerificationFailure{height: 1, firstPeerID: "P1", secondPeerID: "P1"},
+					wantState: &params{height: 0, items: []pcBlock{{"P2", 3}}, verBL: []int64{1}},
+					wantNextEvent: pcBlockVerificationFailure{height: 1, firstPeerID: "P1", secondPeerID: "P1",
+						faultyPeerID: "P1"},
 				},
 			},
 		},
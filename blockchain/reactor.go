@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"errors"
@@ -8,7 +8,6 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/tendermint/tendermint/behaviour"
-	bc "github.com/tendermint/tendermint/blockchain"
 	"github.com/tendermint/tendermint/libs/log"
 	tmsync "github.com/tendermint/tendermint/libs/sync"
 	"github.com/tendermint/tendermint/p2p"
@@ -37,6 +36,8 @@ type BlockchainReactor struct {
 	stateSynced bool // set to true when SwitchToFastSync is called by state sync
 	scheduler   *Routine
 	processor   *Routine
+	verifier    *CommitVerifier
+	scorer      PeerScorer
 	logger      log.Logger
 
 	mtx           tmsync.RWMutex
@@ -63,27 +64,63 @@ type blockApplier interface {
 	) (state.State, int64, error)
 }
 
+// ReactorOption sets an optional parameter on the BlockchainReactor.
+type ReactorOption func(*BlockchainReactor)
+
+// ReactorWithPeerScorer sets the scorer of the peers. By default, the peers
+// are not scored.
+func ReactorWithPeerScorer(scorer PeerScorer) ReactorOption {
+	return func(r *BlockchainReactor) { r.scorer = scorer }
+}
+
+// ReactorWithCommitVerifier configures the verification of the commits of the
+// fetched blocks.
+func ReactorWithCommitVerifier(options ...CommitVerifierOption) ReactorOption {
+	return func(r *BlockchainReactor) {
+		for _, option := range options {
+			option(r.verifier)
+		}
+	}
+}
+
+// ReactorWithMetrics sets the metrics.
+func ReactorWithMetrics(metrics *Metrics) ReactorOption {
+	return func(r *BlockchainReactor) {
+		r.scheduler.setMetrics(metrics)
+		r.processor.setMetrics(metrics)
+		CommitVerifierWithMetrics(metrics)(r.verifier)
+	}
+}
+
 // XXX: unify naming in this package around tmState
 func newReactor(state state.State, nodeProTxHash *crypto.ProTxHash, store blockStore, reporter behaviour.Reporter,
-	blockApplier blockApplier, fastSync bool) *BlockchainReactor {
+	blockApplier blockApplier, fastSync bool, options ...ReactorOption) *BlockchainReactor {
 	initHeight := state.LastBlockHeight + 1
 	if initHeight == 1 {
 		initHeight = state.InitialHeight
 	}
 	scheduler := newScheduler(initHeight, time.Now())
-	pContext := newProcessorContext(store, nodeProTxHash, blockApplier, state)
+	verifier := NewCommitVerifier()
+	pContext := newProcessorContext(store, nodeProTxHash, blockApplier, verifier, state)
 	// TODO: Fix naming to just newProcesssor
 	// newPcState requires a processorContext
 	processor := newPcState(pContext)
 
-	return &BlockchainReactor{
+	r := &BlockchainReactor{
 		scheduler: newRoutine("scheduler", scheduler.handle, chBufferSize),
 		processor: newRoutine("processor", processor.handle, chBufferSize),
+		verifier:  verifier,
+		scorer:    nopPeerScorer{},
 		store:     store,
 		reporter:  reporter,
 		logger:    log.NewNopLogger(),
 		fastSync:  fastSync,
 	}
+	for _, option := range options {
+		option(r)
+	}
+	scheduler.scorer = r.scorer
+	return r
 }
 
 // NewBlockchainReactor creates a new reactor instance.
@@ -92,9 +129,10 @@ func NewBlockchainReactor(
 	blockApplier blockApplier,
 	store blockStore,
 	nodeProTxHash *crypto.ProTxHash,
-	fastSync bool) *BlockchainReactor {
+	fastSync bool,
+	options ...ReactorOption) *BlockchainReactor {
 	reporter := behaviour.NewMockReporter()
-	return newReactor(state, nodeProTxHash, store, reporter, blockApplier, fastSync)
+	return newReactor(state, nodeProTxHash, store, reporter, blockApplier, fastSync, options...)
 }
 
 // SetSwitch implements Reactor interface.
@@ -133,6 +171,7 @@ func (r *BlockchainReactor) SetLogger(logger log.Logger) {
 	r.logger = logger
 	r.scheduler.setLogger(logger)
 	r.processor.setLogger(logger)
+	r.verifier.SetLogger(logger)
 }
 
 // Start implements cmn.Service interface
@@ -156,6 +195,9 @@ func (r *BlockchainReactor) startSync(state *state.State) error {
 		return errors.New("fast sync already in progress")
 	}
 	r.events = make(chan Event, chBufferSize)
+	if err := r.verifier.Start(); err != nil {
+		return fmt.Errorf("failed to start commit verifier: %w", err)
+	}
 	go r.scheduler.start()
 	go r.processor.start()
 	if state != nil {
@@ -178,6 +220,11 @@ func (r *BlockchainReactor) endSync() {
 	r.events = nil
 	r.scheduler.stop()
 	r.processor.stop()
+	if r.verifier.IsRunning() {
+		if err := r.verifier.Stop(); err != nil {
+			r.logger.Error("failed to stop commit verifier", "err", err)
+		}
+	}
 }
 
 // SwitchToFastSync is called by the state sync reactor when switching to fast sync.
@@ -381,6 +428,7 @@ func (r *BlockchainReactor) demux(events <-chan Event) {
 		case event := <-r.scheduler.next():
 			switch event := event.(type) {
 			case scBlockReceived:
+				r.scorer.BlockReceived(event.peerID, event.latency)
 				r.processor.send(event)
 			case scPeerError:
 				r.processor.send(event)
@@ -399,6 +447,7 @@ func (r *BlockchainReactor) demux(events <-chan Event) {
 			case scPeersPruned:
 				// Remove peers from the processor.
 				for _, peerID := range event.peers {
+					r.scorer.Timeout(peerID)
 					r.processor.send(scPeerError{peerID: peerID, reason: errors.New("peer was pruned")})
 				}
 				r.logger.Debug("Pruned peers", "count", len(event.peers))
@@ -420,6 +469,7 @@ func (r *BlockchainReactor) demux(events <-chan Event) {
 				}
 				r.scheduler.send(event)
 			case pcBlockVerificationFailure:
+				r.scorer.InvalidBlock(event.faultyPeerID)
 				r.scheduler.send(event)
 			case pcFinished:
 				r.logger.Info("Fast sync complete, switching to consensus")
@@ -464,7 +514,7 @@ func (r *BlockchainReactor) Stop() error {
 
 // Receive implements Reactor by handling different message types.
 func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
-	msg, err := bc.DecodeMsg(msgBytes)
+	msg, err := DecodeMsg(msgBytes)
 	if err != nil {
 		r.logger.Error("error decoding message",
 			"src", src.ID(), "chId", chID, "msg", msg, "err", err)
@@ -472,7 +522,7 @@ func (r *BlockchainReactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 		return
 	}
 
-	if err = bc.ValidateMsg(msg); err != nil {
+	if err = ValidateMsg(msg); err != nil {
 		r.logger.Error("peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
 		_ = r.reporter.Report(behaviour.BadMessage(src.ID(), err.Error()))
 		return
@@ -548,6 +598,8 @@ func (r *BlockchainReactor) AddPeer(peer p2p.Peer) {
 
 // RemovePeer implements Reactor interface.
 func (r *BlockchainReactor) RemovePeer(peer p2p.Peer, reason interface{}) {
+	r.scorer.PeerRemoved(peer.ID())
+
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if r.events != nil {
@@ -564,9 +616,9 @@ func (r *BlockchainReactor) GetChannels() []*p2p.ChannelDescriptor {
 		{
 			ID:                  BlockchainChannel,
 			Priority:            5,
-			SendQueueCapacity:   2000,
+			SendQueueCapacity:   1000,
 			RecvBufferCapacity:  50 * 4096,
-			RecvMessageCapacity: bc.MaxMsgSize,
+			RecvMessageCapacity: MaxMsgSize,
 		},
 	}
 }
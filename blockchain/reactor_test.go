@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"fmt"
@@ -15,7 +15,6 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/behaviour"
-	bc "github.com/tendermint/tendermint/blockchain"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/libs/service"
@@ -208,7 +207,7 @@ func newTestReactor(p testReactorParams) *BlockchainReactor {
 // future improvement in [#4482](https://github.com/tendermint/tendermint/issues/4482).
 // func TestReactorTerminationScenarios(t *testing.T) {
 
-// 	config := cfg.ResetTestRoot("blockchain_reactor_v2_test")
+// 	config := cfg.ResetTestRoot("blockchain_reactor_test")
 // 	defer os.RemoveAll(config.RootDir)
 // 	genDoc, privVals := randGenesisDoc(config.ChainID(), 1, false, 30)
 // 	refStore, _, _ := newReactorStore(genDoc, privVals, 20)
@@ -385,7 +384,7 @@ func TestReactorHelperMode(t *testing.T) {
 		channelID = byte(0x40)
 	)
 
-	config := cfg.ResetTestRoot("blockchain_reactor_v2_test")
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
 	genDoc, privVals := randGenesisDoc(config.ChainID(), 1)
 
@@ -433,20 +432,20 @@ func TestReactorHelperMode(t *testing.T) {
 				switch ev := step.event.(type) {
 				case bcproto.StatusRequest:
 					old := mockSwitch.numStatusResponse
-					msg, err := bc.EncodeMsg(&ev)
+					msg, err := EncodeMsg(&ev)
 					assert.NoError(t, err)
 					reactor.Receive(channelID, mockPeer{id: p2p.ID(step.peer)}, msg)
 					assert.Equal(t, old+1, mockSwitch.numStatusResponse)
 				case bcproto.BlockRequest:
 					if ev.Height > params.startHeight {
 						old := mockSwitch.numNoBlockResponse
-						msg, err := bc.EncodeMsg(&ev)
+						msg, err := EncodeMsg(&ev)
 						assert.NoError(t, err)
 						reactor.Receive(channelID, mockPeer{id: p2p.ID(step.peer)}, msg)
 						assert.Equal(t, old+1, mockSwitch.numNoBlockResponse)
 					} else {
 						old := mockSwitch.numBlockResponse
-						msg, err := bc.EncodeMsg(&ev)
+						msg, err := EncodeMsg(&ev)
 						assert.NoError(t, err)
 						assert.NoError(t, err)
 						reactor.Receive(channelID, mockPeer{id: p2p.ID(step.peer)}, msg)
@@ -461,7 +460,7 @@ func TestReactorHelperMode(t *testing.T) {
 }
 
 func TestReactorSetSwitchNil(t *testing.T) {
-	config := cfg.ResetTestRoot("blockchain_reactor_v2_test")
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
 	genDoc, privVals := randGenesisDoc(config.ChainID(), 1)
 
@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"fmt"
@@ -52,7 +52,6 @@ func (rt *Routine) setLogger(logger log.Logger) {
 	rt.logger = logger
 }
 
-// nolint:unused
 func (rt *Routine) setMetrics(metrics *Metrics) {
 	rt.metrics = metrics
 }
@@ -1,11 +1,13 @@
-package v2
+package blockchain
 
 import (
 	"fmt"
 	"testing"
 	"time"
 
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type eventA struct {
@@ -161,3 +163,41 @@ func TestPriority(t *testing.T) {
 	assert.False(t, routine.isRunning(),
 		"expected an started routine")
 }
+
+// The events of a routine are counted by the Prometheus metrics of the node,
+// labeled with the name of the routine.
+func TestRoutinePrometheusMetrics(t *testing.T) {
+	var (
+		bufferSize = 10
+		routine    = newRoutine("metricsRoutine", simpleHandler, bufferSize)
+		metrics    = PrometheusMetrics("routine_test", "chain_id", "test-chain")
+	)
+	routine.setMetrics(metrics)
+
+	go routine.start()
+	<-routine.ready()
+
+	assert.True(t, routine.send(eventA{}),
+		"expected sending to a ready routine to succeed")
+	assert.Equal(t, errDone, <-routine.final(),
+		"expected the final event to be done")
+
+	families, err := stdprometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	counters := make(map[string]float64)
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["chain_id"] == "test-chain" && labels["routine"] == "metricsRoutine" {
+				counters[family.GetName()] = m.GetCounter().GetValue()
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"routine_test_blockchain_events_sent":    1,
+		"routine_test_blockchain_events_handled": 1,
+	}, counters)
+}
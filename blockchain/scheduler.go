@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"bytes"
@@ -37,8 +37,9 @@ func (e scBlockRequest) String() string {
 // a block has been received and validated by the scheduler
 type scBlockReceived struct {
 	priorityNormal
-	peerID p2p.ID
-	block  *types.Block
+	peerID  p2p.ID
+	block   *types.Block
+	latency time.Duration // time between the request and the response
 }
 
 func (e scBlockReceived) String() string {
@@ -175,6 +176,10 @@ type scheduler struct {
 	peerTimeout time.Duration // maximum response time from a peer otherwise prune
 	minRecvRate int64         // minimum receive rate from peer otherwise prune
 
+	// the scorer of the peers, whose trust values are used to select the peers
+	// blocks are requested from, and to prune the ones below minTrustValue
+	scorer PeerScorer
+
 	// the maximum number of blocks that should be New, Received or Pending at any point
 	// in time. This is used to enforce a limit on the blockStates map.
 	targetPending int
@@ -211,6 +216,7 @@ func newScheduler(initHeight int64, startTime time.Time) *scheduler {
 		targetPending:  10,               // TODO - pass as param
 		peerTimeout:    15 * time.Second, // TODO - pass as param
 		minRecvRate:    0,                // int64(7680), TODO - pass as param
+		scorer:         nopPeerScorer{},
 	}
 
 	return &sc
@@ -352,7 +358,8 @@ func (sc *scheduler) prunablePeers(peerTimout time.Duration, minRecvRate int64,
 		if peer.state != peerStateReady {
 			continue
 		}
-		if now.Sub(peer.lastTouched) > peerTimout || peer.lastRate < minRecvRate {
+		if now.Sub(peer.lastTouched) > peerTimout || peer.lastRate < minRecvRate ||
+			sc.scorer.TrustValue(peerID) < minTrustValue {
 			prunable = append(prunable, peerID)
 		}
 	}
@@ -483,7 +490,15 @@ func (sc *scheduler) pendingFrom(peerID p2p.ID) []int64 {
 }
 
 func (sc *scheduler) selectPeer(height int64) (p2p.ID, error) {
-	peers := sc.getPeersWithHeight(height)
+	// the untrusted peers are not requested blocks, they are pruned instead
+	peers := make([]p2p.ID, 0)
+	trustValues := make(map[p2p.ID]float64)
+	for _, peerID := range sc.getPeersWithHeight(height) {
+		if tv := sc.scorer.TrustValue(peerID); tv >= minTrustValue {
+			peers = append(peers, peerID)
+			trustValues[peerID] = tv
+		}
+	}
 	if len(peers) == 0 {
 		return "", fmt.Errorf("cannot find peer for height %d", height)
 	}
@@ -504,8 +519,17 @@ func (sc *scheduler) selectPeer(height int64) (p2p.ID, error) {
 		}
 	}
 
-	sort.Sort(PeerByID(pendingFrom[int(minPending)]))
-	return pendingFrom[int(minPending)][0], nil
+	// select the most trusted of them, or the first one by ID of the equally
+	// trusted ones
+	candidates := pendingFrom[int(minPending)]
+	sort.Sort(PeerByID(candidates))
+	selected := candidates[0]
+	for _, peerID := range candidates[1:] {
+		if trustValues[peerID] > trustValues[selected] {
+			selected = peerID
+		}
+	}
+	return selected, nil
 }
 
 // PeerByID is a list of peers sorted by peerID.
@@ -532,13 +556,14 @@ func (sc *scheduler) handleBlockResponse(event bcBlockResponse) (Event, error) {
 		return noOp, nil
 	}
 
+	requestTime := sc.pendingTime[event.block.Height]
 	err = sc.markReceived(event.peerID, event.block.Height, event.size, event.time)
 	if err != nil {
 		sc.removePeer(event.peerID)
 		return scPeerError{peerID: event.peerID, reason: err}, nil
 	}
 
-	return scBlockReceived{peerID: event.peerID, block: event.block}, nil
+	return scBlockReceived{peerID: event.peerID, block: event.block, latency: event.time.Sub(requestTime)}, nil
 }
 
 func (sc *scheduler) handleNoBlockResponse(event bcNoBlockResponse) (Event, error) {
@@ -608,16 +633,21 @@ func (sc *scheduler) handleRemovePeer(event bcRemovePeer) (Event, error) {
 
 func (sc *scheduler) handleTryPrunePeer(event rTryPrunePeer) (Event, error) {
 	// Check behavior of peer responsible to deliver block at sc.height.
+	prunablePeers := sc.prunablePeers(sc.peerTimeout, sc.minRecvRate, event.time)
+
 	timeHeightAsked, ok := sc.pendingTime[sc.height]
 	if ok && time.Since(timeHeightAsked) > sc.peerTimeout {
 		// A request was sent to a peer for block at sc.height but a response was not received
 		// from that peer within sc.peerTimeout. Remove the peer. This is to ensure that a peer
 		// will be timed out even if it sends blocks at higher heights but prevents progress by
 		// not sending the block at current height.
-		sc.removePeer(sc.pendingBlocks[sc.height])
+		peerID := sc.pendingBlocks[sc.height]
+		if peer, ok := sc.peers[peerID]; ok && peer.state == peerStateReady && !containsPeer(prunablePeers, peerID) {
+			prunablePeers = append(prunablePeers, peerID)
+			sort.Sort(PeerByID(prunablePeers))
+		}
 	}
 
-	prunablePeers := sc.prunablePeers(sc.peerTimeout, sc.minRecvRate, event.time)
 	if len(prunablePeers) == 0 {
 		return noOp, nil
 	}
@@ -633,6 +663,15 @@ func (sc *scheduler) handleTryPrunePeer(event rTryPrunePeer) (Event, error) {
 	return scPeersPruned{peers: prunablePeers}, nil
 }
 
+func containsPeer(peers []p2p.ID, peerID p2p.ID) bool {
+	for _, id := range peers {
+		if id == peerID {
+			return true
+		}
+	}
+	return false
+}
+
 func (sc *scheduler) handleResetState(event bcResetState) (Event, error) {
 	initHeight := event.state.LastBlockHeight + 1
 	if initHeight == 1 {
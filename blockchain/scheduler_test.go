@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"fmt"
@@ -358,6 +358,35 @@ func TestScPrunablePeers(t *testing.T) {
 	}
 }
 
+// trustValues is a PeerScorer scoring the peers with fixed trust values. The
+// peers without a trust value are trusted.
+type trustValues map[p2p.ID]float64
+
+func (trustValues) BlockReceived(p2p.ID, time.Duration) {}
+func (trustValues) InvalidBlock(p2p.ID)                 {}
+func (trustValues) Timeout(p2p.ID)                      {}
+func (trustValues) PeerRemoved(p2p.ID)                  {}
+
+func (tvs trustValues) TrustValue(peerID p2p.ID) float64 {
+	if tv, ok := tvs[peerID]; ok {
+		return tv
+	}
+	return 1
+}
+
+func TestScPrunableUntrustedPeers(t *testing.T) {
+	now := time.Now()
+	sc := newTestScheduler(scTestParams{peers: map[string]*scPeer{
+		"P1": {state: peerStateReady, lastTouched: now},
+		"P2": {state: peerStateReady, lastTouched: now},
+		"P3": {state: peerStateReady, lastTouched: now},
+		"P4": {state: peerStateRemoved, lastTouched: now},
+	}})
+	sc.scorer = trustValues{"P2": minTrustValue, "P3": minTrustValue / 2, "P4": 0}
+
+	assert.Equal(t, []p2p.ID{"P3"}, sc.prunablePeers(time.Second, 0, now))
+}
+
 func TestScRemovePeer(t *testing.T) {
 
 	type args struct {
@@ -1348,6 +1377,35 @@ func TestScSelectPeer(t *testing.T) {
 	}
 }
 
+func TestScSelectTrustedPeer(t *testing.T) {
+	sc := newTestScheduler(scTestParams{
+		peers: map[string]*scPeer{
+			"P1": {height: 10, state: peerStateReady},
+			"P2": {height: 10, state: peerStateReady},
+			"P3": {height: 10, state: peerStateReady},
+			"P4": {height: 10, state: peerStateReady},
+		},
+		allB:    []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		pending: map[int64]p2p.ID{1: "P4"},
+	})
+
+	// the most trusted of the peers with the fewest pending requests is selected
+	sc.scorer = trustValues{"P1": 0.5, "P2": 0.9, "P3": 0.9}
+	peerID, err := sc.selectPeer(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, "P2", peerID)
+
+	// the untrusted peers are never selected
+	sc.scorer = trustValues{"P1": 0, "P2": 0, "P3": 0}
+	peerID, err = sc.selectPeer(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, "P4", peerID)
+
+	sc.scorer = trustValues{"P1": 0, "P2": 0, "P3": 0, "P4": 0}
+	_, err = sc.selectPeer(2)
+	assert.Error(t, err)
+}
+
 // makeScBlock makes an empty block.
 func makeScBlock(height int64) *types.Block {
 	return &types.Block{Header: types.Header{Height: height}}
@@ -1456,7 +1514,7 @@ func TestScHandleBlockResponse(t *testing.T) {
 				pendingTime: map[int64]time.Time{6: now},
 			},
 			args:      args{event: block6FromP1},
-			wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(6)},
+			wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(6), latency: time.Millisecond},
 		},
 	}
 
@@ -2132,7 +2190,7 @@ func TestScHandle(t *testing.T) {
 				},
 				{ // block response 1
 					args:      args{event: bcBlockResponse{peerID: "P1", time: tick[4], size: 100, block: makeScBlock(1)}},
-					wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(1)},
+					wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(1), latency: tick[4].Sub(tick[1])},
 					wantSc: &scTestParams{
 						startTime:   now,
 						peers:       map[string]*scPeer{"P1": {height: 3, state: peerStateReady, lastTouched: tick[4]}},
@@ -2145,7 +2203,7 @@ func TestScHandle(t *testing.T) {
 				},
 				{ // block response 2
 					args:      args{event: bcBlockResponse{peerID: "P1", time: tick[5], size: 100, block: makeScBlock(2)}},
-					wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(2)},
+					wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(2), latency: tick[5].Sub(tick[2])},
 					wantSc: &scTestParams{
 						startTime:   now,
 						peers:       map[string]*scPeer{"P1": {height: 3, state: peerStateReady, lastTouched: tick[5]}},
@@ -2158,7 +2216,7 @@ func TestScHandle(t *testing.T) {
 				},
 				{ // block response 3
 					args:      args{event: bcBlockResponse{peerID: "P1", time: tick[6], size: 100, block: makeScBlock(3)}},
-					wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(3)},
+					wantEvent: scBlockReceived{peerID: "P1", block: makeScBlock(3), latency: tick[6].Sub(tick[3])},
 					wantSc: &scTestParams{
 						startTime: now,
 						peers:     map[string]*scPeer{"P1": {height: 3, state: peerStateReady, lastTouched: tick[6]}},
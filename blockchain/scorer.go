@@ -0,0 +1,98 @@
+package blockchain
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/trust"
+)
+
+const (
+	// slowResponseLatency is the latency of a block response, above which the
+	// response counts against the peer.
+	slowResponseLatency = 2 * time.Second
+
+	// invalidBlockPenalty is the number of bad events an invalid block counts
+	// for, so that a single invalid block outweighs many good responses.
+	invalidBlockPenalty = 10
+
+	// timeoutPenalty is the number of bad events a timeout counts for.
+	timeoutPenalty = 2
+
+	// minTrustValue is the trust value, below which the peers are neither
+	// requested blocks nor kept by the scheduler.
+	minTrustValue = 0.2
+)
+
+// PeerScorer scores the peers by their behaviour during fast sync.
+type PeerScorer interface {
+	// BlockReceived is called when the peer responds to a block request,
+	// along with the time it took to respond.
+	BlockReceived(peerID p2p.ID, latency time.Duration)
+	// InvalidBlock is called when a block sent by the peer fails verification.
+	InvalidBlock(peerID p2p.ID)
+	// Timeout is called when the peer is pruned for not responding in time, for
+	// responding too slowly or for being untrusted.
+	Timeout(peerID p2p.ID)
+	// PeerRemoved is called when the peer is removed from the reactor.
+	PeerRemoved(peerID p2p.ID)
+	// TrustValue returns the trust of the peer, from 0 (untrusted) to 1.
+	TrustValue(peerID p2p.ID) float64
+}
+
+// nopPeerScorer is a PeerScorer which doesn't score the peers.
+type nopPeerScorer struct{}
+
+var _ PeerScorer = nopPeerScorer{}
+
+func (nopPeerScorer) BlockReceived(p2p.ID, time.Duration) {}
+func (nopPeerScorer) InvalidBlock(p2p.ID)                 {}
+func (nopPeerScorer) Timeout(p2p.ID)                      {}
+func (nopPeerScorer) PeerRemoved(p2p.ID)                  {}
+func (nopPeerScorer) TrustValue(p2p.ID) float64           { return 1 }
+
+// TrustMetricScorer is a PeerScorer recording the behaviour of the peers as
+// good and bad events of their trust metrics.
+type TrustMetricScorer struct {
+	store *trust.MetricStore
+}
+
+var _ PeerScorer = (*TrustMetricScorer)(nil)
+
+// NewTrustMetricScorer returns a new TrustMetricScorer recording the events in
+// the trust metrics of the store.
+func NewTrustMetricScorer(store *trust.MetricStore) *TrustMetricScorer {
+	return &TrustMetricScorer{store: store}
+}
+
+// BlockReceived implements PeerScorer by recording a good event, or a bad one
+// if the response was slow.
+func (s *TrustMetricScorer) BlockReceived(peerID p2p.ID, latency time.Duration) {
+	tm := s.store.GetPeerTrustMetric(string(peerID))
+	if latency > slowResponseLatency {
+		tm.BadEvents(1)
+		return
+	}
+	tm.GoodEvents(1)
+}
+
+// InvalidBlock implements PeerScorer.
+func (s *TrustMetricScorer) InvalidBlock(peerID p2p.ID) {
+	s.store.GetPeerTrustMetric(string(peerID)).BadEvents(invalidBlockPenalty)
+}
+
+// Timeout implements PeerScorer.
+func (s *TrustMetricScorer) Timeout(peerID p2p.ID) {
+	s.store.GetPeerTrustMetric(string(peerID)).BadEvents(timeoutPenalty)
+}
+
+// PeerRemoved implements PeerScorer by pausing the trust metric of the peer.
+func (s *TrustMetricScorer) PeerRemoved(peerID p2p.ID) {
+	s.store.PeerDisconnected(string(peerID))
+}
+
+// TrustValue implements PeerScorer by returning the trust value of the trust
+// metric of the peer.
+func (s *TrustMetricScorer) TrustValue(peerID p2p.ID) float64 {
+	return s.store.GetPeerTrustMetric(string(peerID)).TrustValue()
+}
@@ -0,0 +1,54 @@
+package blockchain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/p2p/trust"
+)
+
+func TestTrustMetricScorer(t *testing.T) {
+	store := trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig())
+	store.SetLogger(log.TestingLogger())
+	require.NoError(t, store.Start())
+	t.Cleanup(func() { _ = store.Stop() })
+
+	scorer := NewTrustMetricScorer(store)
+	trustValue := func(peerID p2p.ID) float64 {
+		return store.GetPeerTrustMetric(string(peerID)).TrustValue()
+	}
+
+	// fast responses are good events
+	scorer.BlockReceived("fast", time.Millisecond)
+	scorer.BlockReceived("fast", time.Second)
+	assert.EqualValues(t, 1, trustValue("fast"))
+
+	// slow responses are bad events
+	scorer.BlockReceived("slow", time.Millisecond)
+	scorer.BlockReceived("slow", slowResponseLatency+time.Second)
+	assert.Less(t, trustValue("slow"), trustValue("fast"))
+
+	// timeouts weigh more than slow responses
+	scorer.BlockReceived("timeout", time.Millisecond)
+	scorer.Timeout("timeout")
+	assert.Less(t, trustValue("timeout"), trustValue("slow"))
+
+	// and invalid blocks weigh more than timeouts
+	scorer.BlockReceived("invalid", time.Millisecond)
+	scorer.InvalidBlock("invalid")
+	assert.Less(t, trustValue("invalid"), trustValue("timeout"))
+
+	// peers sending invalid blocks are no longer trusted by the scheduler
+	assert.Less(t, scorer.TrustValue("invalid"), minTrustValue)
+	assert.GreaterOrEqual(t, scorer.TrustValue("slow"), minTrustValue)
+
+	// the metrics of the removed peers are kept
+	scorer.PeerRemoved("invalid")
+	assert.Equal(t, 4, store.Size())
+}
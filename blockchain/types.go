@@ -1,4 +1,4 @@
-package v2
+package blockchain
 
 import (
 	"github.com/Workiva/go-datastructures/queue"
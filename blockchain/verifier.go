@@ -0,0 +1,275 @@
+package blockchain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/service"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	// DefaultVerificationWorkers is the default number of workers verifying
+	// the commits of the fetched blocks ahead of their execution.
+	DefaultVerificationWorkers = 4
+
+	// maxVerificationsAhead is the maximum number of heights, following the
+	// last block of the state, whose commits are verified ahead of execution.
+	maxVerificationsAhead = 100
+)
+
+// CommitVerifierOption sets an optional parameter on the CommitVerifier.
+type CommitVerifierOption func(*CommitVerifier)
+
+// CommitVerifierWithWorkers sets the number of workers verifying the commits
+// ahead of execution. If it is 0, the commits are only verified on the
+// execution of their blocks.
+func CommitVerifierWithWorkers(workers int) CommitVerifierOption {
+	return func(v *CommitVerifier) { v.workers = workers }
+}
+
+// CommitVerifierWithMetrics sets the metrics.
+func CommitVerifierWithMetrics(metrics *Metrics) CommitVerifierOption {
+	return func(v *CommitVerifier) { v.metrics = metrics }
+}
+
+// CommitVerifier verifies the commits of the blocks fetched by fast sync in a
+// pool of workers, ahead of the execution of the blocks, which stays
+// sequential.
+//
+// The validator set of a height is only known once the previous block is
+// applied, so a commit is verified ahead with the latest known validator set
+// of the state, and the result is only used if the validator set of the state
+// the block is applied to turns out to be the same. Otherwise, the commit is
+// verified again on execution.
+type CommitVerifier struct {
+	service.BaseService
+
+	workers int
+	metrics *Metrics
+
+	mtx           tmsync.Mutex
+	verifications map[int64]*verification // by the height of the verified block
+	backlog       int
+	jobs          chan *verification
+}
+
+// verification is the verification of the commit, included in next block,
+// of the block.
+type verification struct {
+	chainID    string
+	valSet     *types.ValidatorSet
+	valSetHash []byte
+	block      *types.Block
+	next       *types.Block
+
+	// claimed is set once a worker or Verify takes the verification on,
+	// protected by the mutex of the CommitVerifier.
+	claimed bool
+	done    chan struct{}
+	blockID types.BlockID
+	parts   *types.PartSet
+	err     error
+}
+
+// NewCommitVerifier returns a new CommitVerifier. The commits are only
+// verified ahead of execution while it is running.
+func NewCommitVerifier(options ...CommitVerifierOption) *CommitVerifier {
+	v := &CommitVerifier{
+		workers:       DefaultVerificationWorkers,
+		metrics:       NopMetrics(),
+		verifications: make(map[int64]*verification),
+	}
+	for _, option := range options {
+		option(v)
+	}
+	v.BaseService = *service.NewBaseService(nil, "CommitVerifier", v)
+	return v
+}
+
+// OnStart implements service.Service by starting the workers.
+func (v *CommitVerifier) OnStart() error {
+	v.jobs = make(chan *verification, maxVerificationsAhead)
+	for i := 0; i < v.workers; i++ {
+		go v.verifyRoutine()
+	}
+	return nil
+}
+
+// OnStop implements service.Service by dropping the scheduled verifications.
+func (v *CommitVerifier) OnStop() {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	v.verifications = make(map[int64]*verification)
+	v.backlog = 0
+	v.metrics.VerificationBacklog.Set(0)
+}
+
+// ScheduleAhead schedules the verification of the commits of the blocks
+// following the last block of the state, which blockAt returns by height. The
+// blocks missing the block of the next height are skipped. It is a no-op if
+// the verifier is not running.
+func (v *CommitVerifier) ScheduleAhead(state sm.State, blockAt func(height int64) *types.Block) {
+	if v.workers == 0 || !v.IsRunning() || state.Validators == nil || state.NextValidators == nil {
+		return
+	}
+
+	blocks := make([]*types.Block, 0, maxVerificationsAhead+1)
+	for height := state.LastBlockHeight + 1; height <= state.LastBlockHeight+maxVerificationsAhead+1; height++ {
+		blocks = append(blocks, blockAt(height))
+	}
+
+	var (
+		valSetHash     = state.Validators.Hash()
+		nextValSetHash = state.NextValidators.Hash()
+	)
+
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for height := range v.verifications {
+		if height <= state.LastBlockHeight {
+			delete(v.verifications, height)
+		}
+	}
+
+	for i := 0; i < maxVerificationsAhead; i++ {
+		block, next := blocks[i], blocks[i+1]
+		if block == nil || next == nil {
+			continue
+		}
+
+		// the validator set of the height following the next one is not
+		// known yet, so the latest known one is used
+		valSet, hash := state.NextValidators, nextValSetHash
+		if i == 0 {
+			valSet, hash = state.Validators, valSetHash
+		}
+
+		if ver, ok := v.verifications[block.Height]; ok && ver.block == block && ver.next == next &&
+			ver.chainID == state.ChainID && bytes.Equal(ver.valSetHash, hash) {
+			continue
+		}
+
+		ver := &verification{
+			chainID:    state.ChainID,
+			valSet:     valSet,
+			valSetHash: hash,
+			block:      block,
+			next:       next,
+			done:       make(chan struct{}),
+		}
+		select {
+		case v.jobs <- ver:
+			v.verifications[block.Height] = ver
+			v.backlog++
+		default:
+			// the workers are saturated, the commit is verified on execution
+			v.metrics.VerificationBacklog.Set(float64(v.backlog))
+			return
+		}
+	}
+	v.metrics.VerificationBacklog.Set(float64(v.backlog))
+}
+
+// Verify verifies that the last commit of next commits to block, which must
+// be the block following the last block of the state, and returns the ID and
+// the parts of the block. It uses the result of the verification scheduled
+// ahead, if it was scheduled with the same blocks and validator set, and
+// verifies the commit right away otherwise.
+func (v *CommitVerifier) Verify(state sm.State, block, next *types.Block) (types.BlockID, *types.PartSet, error) {
+	v.mtx.Lock()
+	ver, ok := v.verifications[block.Height]
+	delete(v.verifications, block.Height)
+	if ok && ver.block == block && ver.next == next && ver.chainID == state.ChainID &&
+		ver.valSet.QuorumType == state.Validators.QuorumType && len(ver.valSetHash) > 0 &&
+		bytes.Equal(ver.valSetHash, state.Validators.Hash()) {
+		// a verification which is not claimed by any worker yet is cheaper
+		// to do right away than to wait for
+		ok = ver.claimed
+		ver.claimed = true
+	} else {
+		ok = false
+	}
+	v.mtx.Unlock()
+
+	if ok {
+		select {
+		case <-ver.done:
+			v.metrics.CommitsVerifiedAhead.Add(1)
+			return ver.blockID, ver.parts, ver.err
+		case <-v.Quit():
+		}
+	}
+
+	v.metrics.CommitsVerifiedInline.Add(1)
+	return verifyCommit(state.ChainID, state.Validators, block, next)
+}
+
+func (v *CommitVerifier) verifyRoutine() {
+	for {
+		select {
+		case ver := <-v.jobs:
+			v.mtx.Lock()
+			claimed := ver.claimed
+			ver.claimed = true
+			v.mtx.Unlock()
+
+			if !claimed {
+				ver.blockID, ver.parts, ver.err = verifyCommit(ver.chainID, ver.valSet, ver.block, ver.next)
+				close(ver.done)
+			}
+
+			v.mtx.Lock()
+			if v.backlog > 0 {
+				v.backlog--
+			}
+			v.metrics.VerificationBacklog.Set(float64(v.backlog))
+			v.mtx.Unlock()
+
+		case <-v.Quit():
+			return
+		}
+	}
+}
+
+// errInvalidBlock is returned by verifyCommit, if the last commit of next is
+// signed by the validators, but for another block, i.e. the block is invalid,
+// not the commit.
+type errInvalidBlock struct {
+	err error
+}
+
+func (e errInvalidBlock) Error() string {
+	return fmt.Sprintf("invalid block: %v", e.err)
+}
+
+func (e errInvalidBlock) Unwrap() error {
+	return e.err
+}
+
+// verifyCommit verifies that the last commit of next commits to block and
+// returns the ID and the parts of the block. If the commit is valid on its
+// own, but not for the block, the error is errInvalidBlock.
+func verifyCommit(
+	chainID string,
+	valSet *types.ValidatorSet,
+	block, next *types.Block,
+) (types.BlockID, *types.PartSet, error) {
+	// NOTE: calling block.Hash() doesn't verify the tx contents, so
+	// MakePartSet() is currently necessary.
+	parts := block.MakePartSet(types.BlockPartSizeBytes)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+	stateID := types.StateID{LastAppHash: block.Header.AppHash}
+
+	commit := next.LastCommit
+	err := valSet.VerifyCommit(chainID, blockID, stateID, block.Height, commit)
+	if err != nil && commit != nil &&
+		valSet.VerifyCommit(chainID, commit.BlockID, commit.StateID, block.Height, commit) == nil {
+		err = errInvalidBlock{err}
+	}
+	return blockID, parts, err
+}
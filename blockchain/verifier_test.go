@@ -0,0 +1,122 @@
+package blockchain
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testPubKey is a threshold public key accepting the signatures equal to its
+// bytes.
+type testPubKey struct {
+	crypto.PubKey
+	key []byte
+}
+
+func (pk testPubKey) Bytes() []byte { return pk.key }
+
+func (pk testPubKey) VerifySignatureDigest(hash []byte, sig []byte) bool {
+	return bytes.Equal(pk.key, sig)
+}
+
+func testValidatorSet(key string) *types.ValidatorSet {
+	return &types.ValidatorSet{
+		QuorumType:         btcjson.LLMQType_5_60,
+		QuorumHash:         crypto.RandQuorumHash(),
+		ThresholdPublicKey: testPubKey{key: []byte(key)},
+	}
+}
+
+// makeBlocks returns the blocks from 1 to maxHeight, whose commits are signed
+// with the key.
+func makeBlocks(maxHeight int64, key string) map[int64]*types.Block {
+	blocks := make(map[int64]*types.Block)
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= maxHeight; height++ {
+		block := types.MakeBlock(height, 0, nil, []types.Tx{types.Tx("tx")}, lastCommit, nil, 0)
+		parts := block.MakePartSet(types.BlockPartSizeBytes)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: parts.Header()}
+		lastCommit = types.NewCommit(height, 0, blockID, types.StateID{LastAppHash: block.AppHash}, nil,
+			[]byte(key), []byte(key))
+		blocks[height] = block
+	}
+	return blocks
+}
+
+func TestCommitVerifier(t *testing.T) {
+	var (
+		valSet      = testValidatorSet("a")
+		otherValSet = testValidatorSet("b")
+		blocks      = makeBlocks(5, "a")
+		blockAt     = func(height int64) *types.Block { return blocks[height] }
+		metrics     = &Metrics{
+			VerificationBacklog:   generic.NewGauge("backlog"),
+			CommitsVerifiedAhead:  generic.NewCounter("ahead"),
+			CommitsVerifiedInline: generic.NewCounter("inline"),
+		}
+		state = sm.State{ChainID: "test", LastBlockHeight: 0, Validators: valSet, NextValidators: valSet}
+	)
+
+	v := NewCommitVerifier(CommitVerifierWithWorkers(2), CommitVerifierWithMetrics(metrics))
+	require.NoError(t, v.Start())
+	t.Cleanup(func() { _ = v.Stop() })
+
+	// the commits of the blocks from 1 to 4 are verified ahead, block 5 has
+	// no next block yet
+	v.ScheduleAhead(state, blockAt)
+	require.Eventually(t, func() bool {
+		return metrics.VerificationBacklog.(*generic.Gauge).Value() == 0
+	}, time.Second, 10*time.Millisecond)
+
+	blockID, parts, err := v.Verify(state, blocks[1], blocks[2])
+	require.NoError(t, err)
+	assert.Equal(t, blocks[2].LastCommit.BlockID, blockID)
+	assert.Equal(t, blockID.PartSetHeader, parts.Header())
+
+	// the validator set of height 2 turns out to be the one the commit was
+	// verified ahead with
+	state.LastBlockHeight, state.NextValidators = 1, otherValSet
+	_, _, err = v.Verify(state, blocks[2], blocks[3])
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, metrics.CommitsVerifiedAhead.(*generic.Counter).Value())
+	assert.EqualValues(t, 0, metrics.CommitsVerifiedInline.(*generic.Counter).Value())
+
+	// the validator set of height 3 changed, so the commit is verified again
+	state.LastBlockHeight, state.Validators = 2, otherValSet
+	_, _, err = v.Verify(state, blocks[3], blocks[4])
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, metrics.CommitsVerifiedInline.(*generic.Counter).Value())
+
+	// the result verified ahead is not used for other blocks
+	state.Validators = valSet
+	otherBlocks := makeBlocks(5, "b")
+	_, _, err = v.Verify(state, otherBlocks[4], otherBlocks[5])
+	assert.Error(t, err)
+	assert.EqualValues(t, 2, metrics.CommitsVerifiedInline.(*generic.Counter).Value())
+}
+
+func TestCommitVerifierNotRunning(t *testing.T) {
+	var (
+		valSet = testValidatorSet("a")
+		blocks = makeBlocks(3, "a")
+		state  = sm.State{ChainID: "test", LastBlockHeight: 1, Validators: valSet, NextValidators: valSet}
+	)
+
+	// the commits are verified on execution
+	v := NewCommitVerifier()
+	v.ScheduleAhead(state, func(height int64) *types.Block { return blocks[height] })
+	_, _, err := v.Verify(state, blocks[2], blocks[3])
+	require.NoError(t, err)
+
+	_, _, err = v.Verify(state, blocks[2], blocks[2])
+	assert.Error(t, err)
+}
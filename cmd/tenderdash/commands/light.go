@@ -23,6 +23,7 @@ import (
 	lproxy "github.com/tendermint/tendermint/light/proxy"
 	lrpc "github.com/tendermint/tendermint/light/rpc"
 	dbs "github.com/tendermint/tendermint/light/store/db"
+	nm "github.com/tendermint/tendermint/node"
 	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
 )
 
@@ -69,6 +70,9 @@ var (
 	dashCoreRPCHost string
 	dashCoreRPCUser string
 	dashCoreRPCPass string
+
+	proofOps     string
+	proofKeyPath string
 )
 
 func init() {
@@ -93,6 +97,13 @@ func init() {
 		"Dash Core RPC node user")
 	LightCmd.Flags().StringVar(&dashCoreRPCHost, "dcpass", "",
 		"Dash Core RPC node password")
+	LightCmd.Flags().StringVar(&proofOps, "proof-ops", "",
+		"proof operators ABCI query proofs are verified with, comma-separated (default all: "+
+			strings.Join(lrpc.RegisteredProofOps(), ",")+")")
+	LightCmd.Flags().StringVar(&proofKeyPath, "proof-key-path", "store",
+		"how the merkle key path of ABCI query proofs is built: "+
+			"\"store\" - from the store name in the /store/<name>/key path and the key, "+
+			"\"key\" - from the key only")
 }
 
 func runProxy(cmd *cobra.Command, args []string) error {
@@ -178,6 +189,9 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	cfg.MaxBodyBytes = config.RPC.MaxBodyBytes
 	cfg.MaxHeaderBytes = config.RPC.MaxHeaderBytes
 	cfg.MaxOpenConnections = maxOpenConnections
+	if err := nm.ConfigureRPCAccess(config.RPC, cfg); err != nil {
+		return err
+	}
 	// If necessary adjust global WriteTimeout to ensure it's greater than
 	// TimeoutBroadcastTxCommit.
 	// See https://github.com/tendermint/tendermint/issues/3435
@@ -185,13 +199,18 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		cfg.WriteTimeout = config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
 
+	proofOpts, err := proofOptions(proofOps, proofKeyPath)
+	if err != nil {
+		return err
+	}
+
 	p, err := lproxy.NewProxy(
 		c,
 		listenAddr,
 		primaryAddr,
 		cfg,
 		logger,
-		lrpc.KeyPathFn(lrpc.DefaultMerkleKeyPathFn()),
+		proofOpts...,
 	)
 	if err != nil {
 		return err
@@ -202,8 +221,17 @@ func runProxy(cmd *cobra.Command, args []string) error {
 		p.Listener.Close()
 	})
 
-	logger.Info("Starting proxy...", "laddr", listenAddr)
-	if err := p.ListenAndServe(); err != http.ErrServerClosed {
+	// With tls_client_ca_file set, every client of the proxy must present a
+	// certificate signed by it.
+	if config.RPC.IsTLSEnabled() {
+		cfg.RequireClientCert = cfg.ClientCAs != nil
+		logger.Info("Starting proxy...", "laddr", listenAddr, "tls", true, "client_certs", cfg.RequireClientCert)
+		err = p.ListenAndServeTLS(config.RPC.CertFile(), config.RPC.KeyFile())
+	} else {
+		logger.Info("Starting proxy...", "laddr", listenAddr)
+		err = p.ListenAndServe()
+	}
+	if err != http.ErrServerClosed {
 		// Error starting or closing listener:
 		logger.Error("proxy ListenAndServe", "err", err)
 	}
@@ -211,6 +239,31 @@ func runProxy(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// proofOptions returns the options of the proxy's client, which verify ABCI
+// query proofs with the given proof operators and key path.
+func proofOptions(proofOps, proofKeyPath string) ([]lrpc.Option, error) {
+	var opTypes []string
+	if proofOps != "" {
+		opTypes = strings.Split(proofOps, ",")
+	}
+	prt, err := lrpc.NewProofRuntime(opTypes...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --proof-ops: %w", err)
+	}
+
+	var keyPathFn lrpc.KeyPathFunc
+	switch proofKeyPath {
+	case "store":
+		keyPathFn = lrpc.DefaultMerkleKeyPathFn()
+	case "key":
+		keyPathFn = lrpc.KeyOnlyKeyPathFn()
+	default:
+		return nil, fmt.Errorf("invalid --proof-key-path %q, expected \"store\" or \"key\"", proofKeyPath)
+	}
+
+	return []lrpc.Option{lrpc.ProofRuntime(prt), lrpc.KeyPathFn(keyPathFn)}, nil
+}
+
 func checkForExistingProviders(db dbm.DB) (string, []string, error) {
 	primaryBytes, err := db.Get(primaryKey)
 	if err != nil {
@@ -0,0 +1,284 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	nm "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
+	"github.com/tendermint/tendermint/state/indexer/sqlsink"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+// reindexProgressInterval is the number of heights between progress reports.
+const reindexProgressInterval = 1000
+
+var (
+	reindexStartHeight int64
+	reindexEndHeight   int64
+	reindexResume      bool
+)
+
+// ReIndexEventCmd rebuilds the indexes of the configured indexers from the
+// stored blocks and ABCI responses.
+var ReIndexEventCmd = &cobra.Command{
+	Use:   "reindex-event",
+	Short: "Reindex blocks and transactions of the configured indexers",
+	Long: `
+Reindex-event rebuilds the indexes of the indexers configured in the [tx_index]
+section, e.g. after an indexer is enabled or its database is corrupted. The
+events are read from the blocks and ABCI responses stored by the node, so only
+heights which haven't been pruned can be reindexed. By default, all stored
+heights are reindexed.
+
+Transactions are indexed before their block, so with --resume, heights already
+indexed by every indexer are skipped and an interrupted reindex can continue
+where it stopped.
+
+The node must be stopped.
+`,
+	Example: `
+	tenderdash reindex-event
+	tenderdash reindex-event --start-height 2
+	tenderdash reindex-event --end-height 10
+	tenderdash reindex-event --start-height 2 --end-height 10 --resume
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return ReIndexEvent(config, cmd.OutOrStdout(), reindexStartHeight, reindexEndHeight, reindexResume)
+	},
+}
+
+func init() {
+	ReIndexEventCmd.Flags().Int64Var(&reindexStartHeight, "start-height", 0,
+		"the block height to start reindexing at (default: the lowest stored height)")
+	ReIndexEventCmd.Flags().Int64Var(&reindexEndHeight, "end-height", 0,
+		"the block height to stop reindexing at (default: the latest height)")
+	ReIndexEventCmd.Flags().BoolVar(&reindexResume, "resume", false,
+		"skip the heights already indexed by all indexers")
+}
+
+// ReIndexEvent reindexes the heights from start to end (both inclusive, 0
+// meaning the lowest and the latest stored height) with the indexers
+// configured in config, writing the progress to out.
+func ReIndexEvent(config *cfg.Config, out io.Writer, start, end int64, resume bool) error {
+	blockStoreDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	if state.IsEmpty() {
+		return errors.New("no state found; nothing to reindex")
+	}
+
+	sinks, closeSinks, err := loadEventSinks(config, state.ChainID)
+	if err != nil {
+		return err
+	}
+	defer closeSinks()
+
+	// the ABCI responses of the last block in the block store may not have
+	// been saved yet
+	latest := blockStore.Height()
+	if state.LastBlockHeight < latest {
+		latest = state.LastBlockHeight
+	}
+	if start == 0 {
+		start = blockStore.Base()
+	}
+	if end == 0 {
+		end = latest
+	}
+	if err := checkReIndexHeights(start, end, blockStore.Base(), latest); err != nil {
+		return err
+	}
+
+	return reIndexEvents(out, sinks, blockStore, stateStore, start, end, resume)
+}
+
+// loadEventSinks opens the indexers enabled in the config. The returned
+// function closes their databases.
+func loadEventSinks(config *cfg.Config, chainID string) ([]txindex.EventSink, func(), error) {
+	var (
+		sinks   []txindex.EventSink
+		closers []io.Closer
+	)
+	closeAll := func() {
+		for _, c := range closers {
+			c.Close()
+		}
+	}
+
+	if config.TxIndex.IsEnabled("kv") {
+		store, err := nm.DefaultDBProvider(&nm.DBContext{ID: "tx_index", Config: config})
+		if err != nil {
+			return nil, nil, err
+		}
+		closers = append(closers, store)
+		sinks = append(sinks, txindex.EventSink{
+			Name:         "kv",
+			TxIndexer:    kv.NewTxIndex(store),
+			BlockIndexer: blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))),
+		})
+	}
+
+	if config.TxIndex.IsEnabled("sql") {
+		sink, err := sqlsink.Open(config.TxIndex.SQLDriver, config.TxIndex.SQLConn, chainID)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("failed to open sql event sink: %w", err)
+		}
+		closers = append(closers, sink)
+		sinks = append(sinks, txindex.EventSink{
+			Name:         "sql",
+			TxIndexer:    sink.TxIndexer(),
+			BlockIndexer: sink.BlockIndexer(),
+		})
+	}
+
+	if len(sinks) == 0 {
+		return nil, nil, errors.New("no indexer is enabled in the [tx_index] section; nothing to reindex")
+	}
+	return sinks, closeAll, nil
+}
+
+func checkReIndexHeights(start, end, base, latest int64) error {
+	if start < 1 || end < 1 {
+		return fmt.Errorf("heights must be positive, got start height %d and end height %d", start, end)
+	}
+	if start > end {
+		return fmt.Errorf("start height %d is greater than end height %d", start, end)
+	}
+	if start < base {
+		return fmt.Errorf("start height %d is below the lowest stored height %d", start, base)
+	}
+	if end > latest {
+		return fmt.Errorf("end height %d is above the latest height %d", end, latest)
+	}
+	return nil
+}
+
+// reIndexEvents indexes the heights from start to end with every sink.
+func reIndexEvents(
+	out io.Writer,
+	sinks []txindex.EventSink,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	start, end int64,
+	resume bool,
+) error {
+	var (
+		total   = end - start + 1
+		skipped int64
+	)
+	fmt.Fprintf(out, "Reindexing heights %d-%d\n", start, end)
+
+	for height := start; height <= end; height++ {
+		if resume {
+			indexed, err := isIndexed(sinks, height)
+			if err != nil {
+				return fmt.Errorf("failed to check height %d: %w", height, err)
+			}
+			if indexed {
+				skipped++
+				continue
+			}
+		}
+
+		if err := reIndexHeight(sinks, blockStore, stateStore, height); err != nil {
+			return fmt.Errorf("failed to reindex height %d (rerun with --start-height %d to resume): %w",
+				height, height, err)
+		}
+
+		if done := height - start + 1; done%reindexProgressInterval == 0 && height != end {
+			fmt.Fprintf(out, "Reindexed %d/%d heights (latest %d)\n", done, total, height)
+		}
+	}
+
+	fmt.Fprintf(out, "Reindexed %d heights (%d skipped)\n", total-skipped, skipped)
+	return nil
+}
+
+// isIndexed returns true if every sink has indexed the block at the height.
+func isIndexed(sinks []txindex.EventSink, height int64) (bool, error) {
+	for _, sink := range sinks {
+		ok, err := sink.BlockIndexer.Has(height)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// reIndexHeight indexes the block at the height and its transactions with
+// every sink. Transactions are indexed first, so that an indexed block implies
+// indexed transactions.
+func reIndexHeight(sinks []txindex.EventSink, blockStore sm.BlockStore, stateStore sm.Store, height int64) error {
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return fmt.Errorf("block not found")
+	}
+
+	abciResponses, err := stateStore.LoadABCIResponses(height)
+	if err != nil {
+		return err
+	}
+	if len(abciResponses.DeliverTxs) != len(block.Txs) {
+		return fmt.Errorf("got %d DeliverTx responses for %d txs", len(abciResponses.DeliverTxs), len(block.Txs))
+	}
+
+	header := types.EventDataNewBlockHeader{
+		Header: block.Header,
+		NumTxs: int64(len(block.Txs)),
+	}
+	if abciResponses.BeginBlock != nil {
+		header.ResultBeginBlock = *abciResponses.BeginBlock
+	}
+	if abciResponses.EndBlock != nil {
+		header.ResultEndBlock = *abciResponses.EndBlock
+	}
+
+	batch := txindex.NewBatch(header.NumTxs)
+	for i, tx := range block.Txs {
+		if err := batch.Add(&abci.TxResult{
+			Height: height,
+			Index:  uint32(i),
+			Tx:     tx,
+			Result: *abciResponses.DeliverTxs[i],
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, sink := range sinks {
+		if err := sink.TxIndexer.AddBatch(batch); err != nil {
+			return fmt.Errorf("failed to index txs with the %s indexer: %w", sink.Name, err)
+		}
+		if err := sink.BlockIndexer.Index(header); err != nil {
+			return fmt.Errorf("failed to index block with the %s indexer: %w", sink.Name, err)
+		}
+	}
+	return nil
+}
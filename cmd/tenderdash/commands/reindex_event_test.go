@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	sm "github.com/tendermint/tendermint/state"
+	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/types"
+)
+
+type reindexBlockStore struct {
+	sm.BlockStore
+	blocks map[int64]*types.Block
+}
+
+func (bs reindexBlockStore) LoadBlock(height int64) *types.Block {
+	return bs.blocks[height]
+}
+
+func TestCheckReIndexHeights(t *testing.T) {
+	testCases := []struct {
+		start, end int64
+		expectErr  bool
+	}{
+		{1, 10, false},
+		{3, 3, false},
+		{0, 10, true},
+		{5, 4, true},
+		{1, 11, true},
+	}
+	for _, tc := range testCases {
+		err := checkReIndexHeights(tc.start, tc.end, 1, 10)
+		assert.Equal(t, tc.expectErr, err != nil, "%d-%d", tc.start, tc.end)
+	}
+
+	assert.Error(t, checkReIndexHeights(1, 10, 2, 10))
+}
+
+func TestReIndexEvents(t *testing.T) {
+	blockStore := reindexBlockStore{blocks: make(map[int64]*types.Block)}
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	for height := int64(1); height <= 3; height++ {
+		tx := types.Tx{byte(height)}
+		blockStore.blocks[height] = &types.Block{
+			Header: types.Header{Height: height},
+			Data:   types.Data{Txs: types.Txs{tx}},
+		}
+		if height == 3 {
+			// ABCI responses of the last height are missing
+			continue
+		}
+		require.NoError(t, stateStore.SaveABCIResponses(height, &tmstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{Code: abci.CodeTypeOK, Events: []abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: []byte("sender"), Value: []byte("Ivan"), Index: true},
+				}},
+			}}},
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock:   &abci.ResponseEndBlock{},
+		}))
+	}
+
+	store := dbm.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+	sinks := []txindex.EventSink{{Name: "kv", TxIndexer: txIndexer, BlockIndexer: blockIndexer}}
+
+	out := new(bytes.Buffer)
+	require.NoError(t, reIndexEvents(out, sinks, blockStore, stateStore, 1, 1, false))
+	assert.Contains(t, out.String(), "Reindexed 1 heights (0 skipped)")
+
+	// the height which fails can be resumed
+	out.Reset()
+	err := reIndexEvents(out, sinks, blockStore, stateStore, 1, 3, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--start-height 3")
+
+	for height := int64(1); height <= 2; height++ {
+		ok, err := blockIndexer.Has(height)
+		require.NoError(t, err)
+		assert.True(t, ok, height)
+
+		res, err := txIndexer.Get(types.Tx{byte(height)}.Hash())
+		require.NoError(t, err)
+		require.NotNil(t, res, height)
+		assert.Equal(t, height, res.Height)
+		assert.Len(t, res.Result.Events, 1)
+	}
+	ok, err := blockIndexer.Has(3)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	out.Reset()
+	require.NoError(t, reIndexEvents(out, sinks, blockStore, stateStore, 1, 2, true))
+	assert.Contains(t, out.String(), "Reindexed 0 heights (2 skipped)")
+}
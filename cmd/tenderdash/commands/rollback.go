@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/consensus"
+	nm "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+)
+
+var rollbackHeight int64
+
+// RollbackCmd rolls back the state, the block store and the WAL to a
+// previous height.
+var RollbackCmd = &cobra.Command{
+	Use:   "rollback",
+	Short: "Roll back Tenderdash state, blocks and WAL to a previous height",
+	Long: `
+A state rollback is performed to recover from an incorrect application state
+transition, when Tenderdash has persisted an incorrect app hash and is thus
+unable to make progress. Rollback overwrites the state at the last height with
+the state at --height (by default, the height before the last one), removes
+all later blocks and truncates the consensus WAL. The application must be
+rolled back to the same height separately. When the node is restarted, the
+removed heights are executed again.
+
+The node must be stopped. A validator which has already signed the removed
+heights will refuse to sign them again, unless its signing state is reset.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		height, hash, err := RollbackState(config, rollbackHeight)
+		if err != nil {
+			return fmt.Errorf("failed to rollback state: %w", err)
+		}
+
+		fmt.Printf("Rolled back state to height %d and hash %X\n", height, hash)
+		return nil
+	},
+}
+
+func init() {
+	RollbackCmd.Flags().Int64Var(&rollbackHeight, "height", 0,
+		"height to roll back to (default: the height before the last one)")
+}
+
+// RollbackState takes the state at the current height n and overwrites it
+// with the state at the given height, or at height n - 1 if height is 0.
+// Blocks above the height are removed and the WAL is truncated. It returns
+// the height and the app hash of the new state.
+func RollbackState(config *cfg.Config, height int64) (int64, []byte, error) {
+	blockStoreDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return -1, nil, err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	stateDB, err := nm.DefaultDBProvider(&nm.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return -1, nil, err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB)
+
+	if height == 0 {
+		state, err := stateStore.Load()
+		if err != nil {
+			return -1, nil, err
+		}
+		height = state.LastBlockHeight - 1
+	}
+
+	state, err := sm.Rollback(blockStore, stateStore, height)
+	if err != nil {
+		return -1, nil, err
+	}
+	deleted, err := blockStore.DeleteBlocksAfter(height)
+	if err != nil {
+		return -1, nil, fmt.Errorf("failed to delete blocks: %w", err)
+	}
+	logger.Info("Deleted blocks", "count", deleted, "height", height)
+
+	backupDir, err := consensus.RollbackWAL(config.Consensus.WalFile(), height)
+	if err != nil {
+		return -1, nil, fmt.Errorf("failed to roll back WAL: %w", err)
+	}
+	if backupDir != "" {
+		logger.Info("Rolled back WAL", "backup", backupDir)
+	}
+
+	return state.LastBlockHeight, state.AppHash, nil
+}
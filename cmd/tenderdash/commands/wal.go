@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/tendermint/consensus"
+)
+
+var (
+	walFile       string
+	walHeight     int64
+	walMinHeight  int64
+	walMaxHeight  int64
+	walKinds      []string
+	walExportFile string
+)
+
+// WALCmd groups the commands which inspect and repair the consensus
+// write-ahead log. The node must not be running while they are used.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus write-ahead log (the node must be stopped)",
+}
+
+var walInspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "List heights and rounds found in the WAL with message counts",
+	RunE:  walInspect,
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check WAL checksums and EndHeightMessage continuity",
+	RunE:  walVerify,
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate",
+	Short: "Remove all messages written after the end of the given height",
+	Long: `Remove all messages written after the EndHeightMessage of the given height.
+The original WAL files are kept in a backup directory next to the WAL.`,
+	RunE: walTruncate,
+}
+
+var walExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write WAL messages as JSON, one message per line",
+	RunE:  walExport,
+}
+
+func init() {
+	WALCmd.PersistentFlags().StringVar(&walFile, "wal-file", "",
+		"path to the WAL head file (defaults to consensus.wal_file from the config)")
+
+	walTruncateCmd.Flags().Int64Var(&walHeight, "height", 0, "last height to keep in the WAL")
+	_ = walTruncateCmd.MarkFlagRequired("height")
+
+	walExportCmd.Flags().Int64Var(&walMinHeight, "min-height", 0, "lowest height to export (0 = no limit)")
+	walExportCmd.Flags().Int64Var(&walMaxHeight, "max-height", 0, "highest height to export (0 = no limit)")
+	walExportCmd.Flags().StringSliceVar(&walKinds, "type", nil, fmt.Sprintf(
+		"message types to export, any of %s (default all)",
+		strings.Join([]string{
+			consensus.WALMsgProposal, consensus.WALMsgBlockPart, consensus.WALMsgVote, consensus.WALMsgCommit,
+			consensus.WALMsgTimeout, consensus.WALMsgRoundState, consensus.WALMsgEndHeight, consensus.WALMsgOther,
+		}, ", ")))
+	walExportCmd.Flags().StringVarP(&walExportFile, "output", "o", "", "output file (default stdout)")
+
+	WALCmd.AddCommand(walInspectCmd)
+	WALCmd.AddCommand(walVerifyCmd)
+	WALCmd.AddCommand(walTruncateCmd)
+	WALCmd.AddCommand(walExportCmd)
+}
+
+func walPath() string {
+	if walFile != "" {
+		return walFile
+	}
+	return config.Consensus.WalFile()
+}
+
+func walInspect(cmd *cobra.Command, args []string) error {
+	summaries, err := consensus.InspectWAL(walPath())
+	for _, hs := range summaries {
+		end := ""
+		if hs.Ended {
+			end = " (ended)"
+		}
+		fmt.Printf("height %d%s\n", hs.Height, end)
+		for _, rs := range hs.Rounds {
+			kinds := make([]string, 0, len(rs.Messages))
+			for kind := range rs.Messages {
+				kinds = append(kinds, kind)
+			}
+			sort.Strings(kinds)
+			counts := make([]string, len(kinds))
+			for i, kind := range kinds {
+				counts[i] = fmt.Sprintf("%s=%d", kind, rs.Messages[kind])
+			}
+			fmt.Printf("  round %d: %s\n", rs.Round, strings.Join(counts, " "))
+		}
+	}
+	return err
+}
+
+func walVerify(cmd *cobra.Command, args []string) error {
+	count, err := consensus.VerifyWAL(walPath())
+	if err != nil {
+		return fmt.Errorf("WAL is invalid after %d messages: %w", count, err)
+	}
+	fmt.Printf("WAL is valid, %d messages\n", count)
+	return nil
+}
+
+func walTruncate(cmd *cobra.Command, args []string) error {
+	backupDir, err := consensus.TruncateWAL(walPath(), walHeight)
+	if err != nil {
+		return err
+	}
+	logger.Info("Truncated WAL", "height", walHeight, "file", walPath(), "backup", backupDir)
+	return nil
+}
+
+func walExport(cmd *cobra.Command, args []string) error {
+	var out io.Writer = os.Stdout
+	if walExportFile != "" {
+		f, err := os.Create(walExportFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	return consensus.ExportWAL(walPath(), out, consensus.WALExportFilter{
+		MinHeight: walMinHeight,
+		MaxHeight: walMaxHeight,
+		Kinds:     walKinds,
+	})
+}
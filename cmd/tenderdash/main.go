@@ -24,6 +24,9 @@ func main() {
 		cmd.LightCmd,
 		cmd.ReplayCmd,
 		cmd.ReplayConsoleCmd,
+		cmd.WALCmd,
+		cmd.RollbackCmd,
+		cmd.ReIndexEventCmd,
 		cmd.ResetAllCmd,
 		cmd.ResetPrivValidatorCmd,
 		cmd.ShowValidatorCmd,
@@ -76,6 +76,7 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"TimeoutBroadcastTxCommit",
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
+		"RateLimitBurst",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -83,6 +84,32 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	for _, fieldName := range []string{"RateLimit", "AuthRateLimit"} {
+		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetFloat(-1)
+		assert.Error(t, cfg.ValidateBasic())
+		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetFloat(0)
+	}
+
+	cfg.TLSClientCAFile = "ca.pem"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.TLSClientCAFile = ""
+}
+
+func TestRPCConfigClientRoutes(t *testing.T) {
+	cfg := TestRPCConfig()
+	cfg.ClientRoutes = []string{"alice:*", "explorer:block,tx_search"}
+	clientRoutes, err := cfg.ClientRoutesMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"alice":    {"*"},
+		"explorer": {"block", "tx_search"},
+	}, clientRoutes)
+
+	for _, invalid := range [][]string{{"alice"}, {":status"}, {"alice:"}, {"alice:*", "alice:status"}} {
+		cfg.ClientRoutes = invalid
+		assert.Error(t, cfg.ValidateBasic(), invalid)
+	}
 }
 
 func TestP2PConfigValidateBasic(t *testing.T) {
@@ -123,6 +150,33 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := TestTxIndexConfig()
+	require.NoError(t, cfg.ValidateBasic())
+	assert.Equal(t, OnFullBufferBlock, cfg.SinkConfig("kv").OnFullBuffer)
+	assert.Equal(t, OnFullBufferDrop, cfg.SinkConfig("sql").OnFullBuffer)
+
+	testCases := map[string]func(sink *IndexerSinkConfig){
+		"zero buffer size":        func(sink *IndexerSinkConfig) { sink.BufferSize = 0 },
+		"negative max retries":    func(sink *IndexerSinkConfig) { sink.MaxRetries = -1 },
+		"negative retry interval": func(sink *IndexerSinkConfig) { sink.RetryInterval = -1 },
+		"unknown on full buffer":  func(sink *IndexerSinkConfig) { sink.OnFullBuffer = "wait" },
+	}
+	for name, malleate := range testCases {
+		malleate := malleate
+		t.Run(name, func(t *testing.T) {
+			cfg := TestTxIndexConfig()
+			sink := cfg.SinkConfig("sql")
+			malleate(&sink)
+			cfg.Sinks["sql"] = sink
+			assert.Error(t, cfg.ValidateBasic())
+		})
+	}
+
+	cfg.Sinks["unknown"] = DefaultIndexerSinkConfig("unknown")
+	assert.Error(t, cfg.ValidateBasic())
+}
+
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
@@ -132,11 +186,10 @@ func TestFastSyncConfigValidateBasic(t *testing.T) {
 	cfg := TestFastSyncConfig()
 	assert.NoError(t, cfg.ValidateBasic())
 
-	// tamper with version
-	cfg.Version = "v1"
+	cfg.VerificationWorkers = 0
 	assert.NoError(t, cfg.ValidateBasic())
 
-	cfg.Version = "invalid"
+	cfg.VerificationWorkers = -1
 	assert.Error(t, cfg.ValidateBasic())
 }
 
@@ -160,6 +213,11 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"TimeoutPrecommitDelta negative":       {func(c *ConsensusConfig) { c.TimeoutPrecommitDelta = -1 }, true},
 		"TimeoutCommit":                        {func(c *ConsensusConfig) { c.TimeoutCommit = time.Second }, false},
 		"TimeoutCommit negative":               {func(c *ConsensusConfig) { c.TimeoutCommit = -1 }, true},
+		"AdaptiveTimeoutsWindow negative":      {func(c *ConsensusConfig) { c.AdaptiveTimeoutsWindow = -1 }, true},
+		"AdaptiveTimeoutsPercentile zero":      {func(c *ConsensusConfig) { c.AdaptiveTimeoutsPercentile = 0 }, true},
+		"AdaptiveTimeoutsPercentile too big":   {func(c *ConsensusConfig) { c.AdaptiveTimeoutsPercentile = 1.5 }, true},
+		"AdaptiveTimeoutsMin negative":         {func(c *ConsensusConfig) { c.AdaptiveTimeoutsMin = -1 }, true},
+		"AdaptiveTimeoutsMax less than min":    {func(c *ConsensusConfig) { c.AdaptiveTimeoutsMax = time.Millisecond }, true},
 		"PeerGossipSleepDuration":              {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = time.Second }, false},
 		"PeerGossipSleepDuration negative":     {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
@@ -232,6 +232,7 @@ func TestByzantinePrevoteEquivocation(t *testing.T) {
 			round,
 			lazyProposer.ValidRound,
 			propBlockID,
+			block.Header.Time,
 		)
 		p := proposal.ToProto()
 		if _, err := lazyProposer.privValidator.SignProposal(
@@ -502,7 +503,7 @@ func byzantineDecideProposalFunc(
 		Hash:          block1.Hash(),
 		PartSetHeader: blockParts1.Header(),
 	}
-	proposal1 := types.NewProposal(height, 1, round, polRound, propBlockID)
+	proposal1 := types.NewProposal(height, 1, round, polRound, propBlockID, block1.Header.Time)
 	p1 := proposal1.ToProto()
 	if _, err := cs.privValidator.SignProposal(
 		cs.state.ChainID, cs.Validators.QuorumType, cs.Validators.QuorumHash, p1,
@@ -521,7 +522,7 @@ func byzantineDecideProposalFunc(
 		Hash:          block2.Hash(),
 		PartSetHeader: blockParts2.Header(),
 	}
-	proposal2 := types.NewProposal(height, 1, round, polRound, propBlockID)
+	proposal2 := types.NewProposal(height, 1, round, polRound, propBlockID, block2.Header.Time)
 	p2 := proposal2.ToProto()
 	if _, err := cs.privValidator.SignProposal(
 		cs.state.ChainID, cs.Validators.QuorumType, cs.Validators.QuorumHash, p2,
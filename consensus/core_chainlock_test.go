@@ -122,7 +122,7 @@ func invalidProposeCoreChainLockFunc(t *testing.T, height int64, round int32, cs
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	// It is byzantine because it is not updating the LastCoreChainLockedBlockHeight
-	proposal := types.NewProposal(height, cs.state.LastCoreChainLockedBlockHeight, round, cs.ValidRound, propBlockID)
+	proposal := types.NewProposal(height, cs.state.LastCoreChainLockedBlockHeight, round, cs.ValidRound, propBlockID, block.Header.Time)
 	p := proposal.ToProto()
 	if _, err := cs.privValidator.SignProposal(
 		cs.state.ChainID, cs.Validators.QuorumType, cs.Validators.QuorumHash, p,
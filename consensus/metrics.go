@@ -60,6 +60,17 @@ type Metrics struct {
 
 	// Number of blockparts transmitted by peer.
 	BlockParts metrics.Counter
+
+	// Timeout chosen by the adaptive timeout ticker, per step.
+	StepTimeoutSeconds metrics.Gauge
+	// Observed latency of a consensus step, per step.
+	StepLatencySeconds metrics.Histogram
+
+	// Number of bytes of votes and commits gossiped to peers.
+	GossipBytes metrics.Counter
+	// Number of bytes of signature shares not gossiped, because peers received
+	// the threshold commit instead.
+	GossipBytesSaved metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -186,6 +197,30 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "block_parts",
 			Help:      "Number of blockparts transmitted by peer.",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		StepTimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "step_timeout_seconds",
+			Help:      "Timeout chosen by the adaptive timeout ticker, per step.",
+		}, append(labels, "step")).With(labelsAndValues...),
+		StepLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "step_latency_seconds",
+			Help:      "Observed latency of a consensus step, per step.",
+		}, append(labels, "step")).With(labelsAndValues...),
+		GossipBytes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_bytes",
+			Help:      "Number of bytes of votes and commits gossiped to peers.",
+		}, append(labels, "message")).With(labelsAndValues...),
+		GossipBytesSaved: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_bytes_saved",
+			Help:      "Number of bytes of signature shares replaced by threshold commits.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -216,5 +251,11 @@ func NopMetrics() *Metrics {
 		FastSyncing:     discard.NewGauge(),
 		StateSyncing:    discard.NewGauge(),
 		BlockParts:      discard.NewCounter(),
+
+		StepTimeoutSeconds: discard.NewGauge(),
+		StepLatencySeconds: discard.NewHistogram(),
+
+		GossipBytes:      discard.NewCounter(),
+		GossipBytesSaved: discard.NewCounter(),
 	}
 }
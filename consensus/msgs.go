@@ -154,6 +154,25 @@ func MsgToProto(msg Message) (*tmcons.Message, error) {
 			Sum: vsb,
 		}
 
+	case *VoteSetMissingMessage:
+		bits := msg.Missing.ToProto()
+
+		vsm := &tmcons.Message_VoteSetMissing{
+			VoteSetMissing: &tmcons.VoteSetMissing{
+				Height: msg.Height,
+				Round:  msg.Round,
+				Type:   msg.Type,
+			},
+		}
+
+		if bits != nil {
+			vsm.VoteSetMissing.Missing = *bits
+		}
+
+		pb = tmcons.Message{
+			Sum: vsm,
+		}
+
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -281,6 +300,16 @@ func MsgFromProto(msg *tmcons.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *tmcons.Message_VoteSetMissing:
+		bits := new(bits.BitArray)
+		bits.FromProto(&msg.VoteSetMissing.Missing)
+
+		pb = &VoteSetMissingMessage{
+			Height:  msg.VoteSetMissing.Height,
+			Round:   msg.VoteSetMissing.Round,
+			Type:    msg.VoteSetMissing.Type,
+			Missing: bits,
+		}
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -189,6 +189,21 @@ func TestMsgToProto(t *testing.T) {
 				},
 			},
 		}, false},
+		{"successful VoteSetMissing", &VoteSetMissingMessage{
+			Height:  1,
+			Round:   1,
+			Type:    1,
+			Missing: bits,
+		}, &tmcons.Message{
+			Sum: &tmcons.Message_VoteSetMissing{
+				VoteSetMissing: &tmcons.VoteSetMissing{
+					Height:  1,
+					Round:   1,
+					Type:    1,
+					Missing: *pbBits,
+				},
+			},
+		}, false},
 		{"failure", nil, &tmcons.Message{}, true},
 	}
 	for _, tt := range testsCases {
@@ -173,7 +173,7 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // InitPeer implements Reactor by creating a state for the peer.
 func (conR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
-	peerState := NewPeerState(peer).SetLogger(conR.Logger)
+	peerState := NewPeerState(peer).SetLogger(conR.Logger).SetMetrics(conR.Metrics)
 	peer.Set(types.PeerStateKey, peerState)
 	return peer
 }
@@ -266,6 +266,19 @@ func (conR *Reactor) Receive(chID byte, src p2p.Peer, msgBytes []byte) {
 			ps.ApplyHasVoteMessage(msg)
 		case *HasCommitMessage:
 			ps.ApplyHasCommitMessage(msg)
+		case *VoteSetMissingMessage:
+			cs := conR.conS
+			cs.mtx.Lock()
+			height, valSize := cs.Height, cs.Validators.Size()
+			cs.mtx.Unlock()
+			if height == msg.Height && msg.Missing.Size() != valSize {
+				err := fmt.Errorf("missing bit array size %d does not match the vote set size %d",
+					msg.Missing.Size(), valSize)
+				conR.Logger.Error("Peer sent us invalid msg", "peer", src, "msg", msg, "err", err)
+				conR.Switch.StopPeerForError(src, err)
+				return
+			}
+			ps.ApplyVoteSetMissingMessage(msg)
 		case *VoteSetMaj23Message:
 			cs := conR.conS
 			cs.mtx.Lock()
@@ -715,58 +728,8 @@ OUTER_LOOP:
 			sleeping = 0
 		}
 
-		// Special catchup logic.
-		// If peer is lagging by height 1, send LastCommit.
-		if prs.Height != 0 && rs.Height == prs.Height+1 && wasValidator {
-			if ps.PickSendVote(rs.LastPrecommits) {
-				logger.Debug("Picked a previous precommit vote to send", "height", prs.Height)
-				continue OUTER_LOOP
-			}
-		}
-
-		// logger.Debug("gossipVotesAndCommitRoutine", "rsHeight", rs.Height, "rsRound", rs.Round,
-		// "prsHeight", prs.Height, "prsRound", prs.Round, "prsStep", prs.Step)
-
-		// If height matches, then send LastCommit, Prevotes, Precommits.
-		if rs.Height == prs.Height {
-			heightLogger := logger.With("height", prs.Height)
-			if !wasValidator {
-				// If there are lastCommits to send...
-				if prs.Step == cstypes.RoundStepNewHeight && prs.Height+1 == rs.Height && !prs.HasCommit {
-					if ps.SendCommit(rs.LastCommit) {
-						logger.Debug("Sending LastCommit to non-validator node")
-						continue OUTER_LOOP
-					}
-				}
-			}
-			if isValidator {
-				if conR.gossipVotesForHeight(heightLogger, rs, prs, ps) {
-					continue OUTER_LOOP
-				}
-			}
-		}
-
-		// Special catchup logic.
-		// If peer is lagging by height 1, send LastCommit if we haven't already.
-		if prs.Height != 0 && rs.Height == prs.Height+1 && !prs.HasCommit && !wasValidator {
-			if ps.SendCommit(rs.LastCommit) {
-				logger.Debug("Sending LastCommit for catch up", "height", prs.Height)
-				continue OUTER_LOOP
-			}
-		}
-
-		// Catchup logic
-		// If peer is lagging by more than 1, send Commit for that height to allow them to catch up.
-		blockStoreBase := conR.conS.blockStore.Base()
-		if blockStoreBase > 0 && prs.Height != 0 && rs.Height >= prs.Height+2 &&
-			prs.Height >= blockStoreBase && !prs.HasCommit {
-			// Load the block commit for prs.Height,
-			if commit := conR.conS.blockStore.LoadBlockCommit(prs.Height); commit != nil {
-				if ps.SendCommit(commit) {
-					logger.Debug("Picked Catchup commit to send", "height", prs.Height)
-					continue OUTER_LOOP
-				}
-			}
+		if conR.gossipVotesAndCommit(logger, rs, prs, ps, isValidator, wasValidator) {
+			continue OUTER_LOOP
 		}
 
 		if sleeping == 0 {
@@ -785,6 +748,83 @@ OUTER_LOOP:
 	}
 }
 
+// gossipVotesAndCommit sends the peer the commit or a vote it is missing, if
+// any, and returns true if something was sent. The threshold commit makes the
+// signature shares redundant, so these are only sent to peers which don't have
+// the commit of their height.
+func (conR *Reactor) gossipVotesAndCommit(
+	logger log.Logger,
+	rs *cstypes.RoundState,
+	prs *cstypes.PeerRoundState,
+	ps *PeerState,
+	isValidator, wasValidator bool,
+) bool {
+	// Special catchup logic.
+	// If peer is lagging by height 1, send LastCommit. The threshold commit
+	// makes the precommit signature shares redundant, so these are only
+	// gossiped when there is no commit to send.
+	if prs.Height != 0 && rs.Height == prs.Height+1 && !prs.HasCommit {
+		if conR.sendCommit(ps, rs.LastCommit, rs.LastPrecommits) {
+			logger.Debug("Sending LastCommit for catch up", "height", prs.Height)
+			return true
+		}
+		if rs.LastCommit == nil && wasValidator && ps.PickSendVote(rs.LastPrecommits) {
+			logger.Debug("Picked a previous precommit vote to send", "height", prs.Height)
+			return true
+		}
+	}
+
+	// logger.Debug("gossipVotesAndCommitRoutine", "rsHeight", rs.Height, "rsRound", rs.Round,
+	// "prsHeight", prs.Height, "prsRound", prs.Round, "prsStep", prs.Step)
+
+	// If height matches, then send LastCommit, Prevotes, Precommits.
+	if rs.Height == prs.Height {
+		heightLogger := logger.With("height", prs.Height)
+		if !wasValidator {
+			// If there are lastCommits to send...
+			if prs.Step == cstypes.RoundStepNewHeight && prs.Height+1 == rs.Height && !prs.HasCommit {
+				if ps.SendCommit(rs.LastCommit) {
+					logger.Debug("Sending LastCommit to non-validator node")
+					return true
+				}
+			}
+		}
+		// As soon as we have recovered the threshold signatures for this
+		// height, send the commit instead of the remaining shares.
+		if !prs.HasCommit && rs.Step == cstypes.RoundStepApplyCommit {
+			precommits := rs.Votes.Precommits(rs.CommitRound)
+			if blockID, ok := precommits.TwoThirdsMajority(); ok && !blockID.IsZero() {
+				if conR.sendCommit(ps, precommits.MakeCommit(), precommits) {
+					heightLogger.Debug("Sending commit", "round", rs.CommitRound)
+					return true
+				}
+			}
+		}
+		// Peers which have the commit don't need any more shares.
+		if isValidator && !prs.HasCommit {
+			if conR.gossipVotesForHeight(heightLogger, rs, prs, ps) {
+				return true
+			}
+		}
+	}
+
+	// Catchup logic
+	// If peer is lagging by more than 1, send Commit for that height to allow them to catch up.
+	blockStoreBase := conR.conS.blockStore.Base()
+	if blockStoreBase > 0 && prs.Height != 0 && rs.Height >= prs.Height+2 &&
+		prs.Height >= blockStoreBase && !prs.HasCommit {
+		// Load the block commit for prs.Height,
+		if commit := conR.conS.blockStore.LoadBlockCommit(prs.Height); commit != nil {
+			if ps.SendCommit(commit) {
+				logger.Debug("Picked Catchup commit to send", "height", prs.Height)
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (conR *Reactor) gossipVotesForHeight(
 	logger log.Logger,
 	rs *cstypes.RoundState,
@@ -793,7 +833,7 @@ func (conR *Reactor) gossipVotesForHeight(
 ) bool {
 
 	// If there are lastPrecommits to send...
-	if prs.Step == cstypes.RoundStepNewHeight {
+	if prs.Step == cstypes.RoundStepNewHeight && rs.LastCommit == nil {
 		if ps.PickSendVote(rs.LastPrecommits) {
 			logger.Debug("Picked previous precommit vote to send")
 			return true
@@ -844,6 +884,20 @@ func (conR *Reactor) gossipVotesForHeight(
 	return false
 }
 
+// sendCommit sends the threshold commit to the peer and records how many bytes
+// of signature shares, which the peer is missing from votes, need not be sent.
+func (conR *Reactor) sendCommit(ps *PeerState, commit *types.Commit, votes *types.VoteSet) bool {
+	if commit == nil {
+		return false
+	}
+	missing, voteSize := ps.missingVotes(votes)
+	if !ps.SendCommit(commit) {
+		return false
+	}
+	ps.metrics.GossipBytesSaved.Add(float64(missing * voteSize))
+	return true
+}
+
 // NOTE: `queryMaj23Routine` has a simple crude design since it only comes
 // into play for liveness when there's a signature DDoS attack happening.
 func (conR *Reactor) queryMaj23Routine(peer p2p.Peer, ps *PeerState) {
@@ -908,6 +962,23 @@ OUTER_LOOP:
 			}
 		}
 
+		// Maybe send Height/Round/missing Precommits
+		{
+			rs := conR.conS.GetRoundState()
+			prs := ps.GetRoundState()
+			if rs.Height == prs.Height && rs.Step >= cstypes.RoundStepPrecommit && !prs.HasCommit {
+				if precommits := rs.Votes.Precommits(rs.Round); precommits != nil && !precommits.HasTwoThirdsMajority() {
+					peer.TrySend(StateChannel, MustEncode(&VoteSetMissingMessage{
+						Height:  rs.Height,
+						Round:   rs.Round,
+						Type:    tmproto.PrecommitType,
+						Missing: precommits.BitArray().Not(),
+					}))
+					time.Sleep(conR.conS.config.PeerQueryMaj23SleepDuration)
+				}
+			}
+		}
+
 		// Little point sending LastCommitRound/LastPrecommits,
 		// These are fleeting and non-blocking.
 
@@ -1016,8 +1087,9 @@ var (
 // NOTE: THIS GETS DUMPED WITH rpc/core/consensus.go.
 // Be mindful of what you Expose.
 type PeerState struct {
-	peer   p2p.Peer
-	logger log.Logger
+	peer    p2p.Peer
+	logger  log.Logger
+	metrics *Metrics
 
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
@@ -1038,8 +1110,9 @@ func (pss peerStateStats) String() string {
 // NewPeerState returns a new PeerState for the given Peer
 func NewPeerState(peer p2p.Peer) *PeerState {
 	return &PeerState{
-		peer:   peer,
-		logger: log.NewNopLogger(),
+		peer:    peer,
+		logger:  log.NewNopLogger(),
+		metrics: NopMetrics(),
 		PRS: cstypes.PeerRoundState{
 			Round:              -1,
 			ProposalPOLRound:   -1,
@@ -1057,6 +1130,13 @@ func (ps *PeerState) SetLogger(logger log.Logger) *PeerState {
 	return ps
 }
 
+// SetMetrics allows to set metrics on the peer state. Returns the peer state
+// itself.
+func (ps *PeerState) SetMetrics(metrics *Metrics) *PeerState {
+	ps.metrics = metrics
+	return ps
+}
+
 // GetRoundState returns an shallow copy of the PeerRoundState.
 // There's no point in mutating it since it won't change PeerState.
 func (ps *PeerState) GetRoundState() *cstypes.PeerRoundState {
@@ -1138,8 +1218,10 @@ func (ps *PeerState) SendCommit(commit *types.Commit) bool {
 	if commit != nil {
 		msg := &CommitMessage{commit}
 		ps.logger.Debug("Sending commit message", "peer", ps.peer, "ps", ps, "commit", commit)
-		if ps.peer.Send(VoteChannel, MustEncode(msg)) {
+		bz := MustEncode(msg)
+		if ps.peer.Send(VoteChannel, bz) {
 			ps.SetHasCommit(commit)
+			ps.metrics.GossipBytes.With("message", "commit").Add(float64(len(bz)))
 			return true
 		}
 	}
@@ -1152,8 +1234,10 @@ func (ps *PeerState) PickSendVote(votes types.VoteSetReader) bool {
 	if vote, ok := ps.PickVoteToSend(votes); ok {
 		msg := &VoteMessage{vote}
 		ps.logger.Debug("Sending vote message", "ps", ps, "vote", vote)
-		if ps.peer.Send(VoteChannel, MustEncode(msg)) {
+		bz := MustEncode(msg)
+		if ps.peer.Send(VoteChannel, bz) {
 			ps.SetHasVote(vote, nil)
+			ps.metrics.GossipBytes.With("message", "vote").Add(float64(len(bz)))
 			return true
 		}
 		return false
@@ -1191,6 +1275,28 @@ func (ps *PeerState) PickVoteToSend(votes types.VoteSetReader) (vote *types.Vote
 	return nil, false
 }
 
+// missingVotes returns the number of votes from the given set the peer is not
+// known to have, and the encoded size of a vote message from that set.
+func (ps *PeerState) missingVotes(votes *types.VoteSet) (missing int, voteSize int) {
+	if votes == nil || votes.Size() == 0 {
+		return 0, 0
+	}
+
+	ps.mtx.Lock()
+	psVotes := ps.getVoteBitArray(votes.GetHeight(), votes.GetRound(), tmproto.SignedMsgType(votes.Type()))
+	ps.mtx.Unlock()
+
+	ourVotes := votes.BitArray()
+	if psVotes != nil {
+		ourVotes = ourVotes.Sub(psVotes)
+	}
+	index, ok := ourVotes.PickRandom()
+	if !ok {
+		return 0, 0
+	}
+	return ourVotes.CountTrueBits(), len(MustEncode(&VoteMessage{votes.GetByIndex(int32(index))}))
+}
+
 func (ps *PeerState) getVoteBitArray(height int64, round int32, votesType tmproto.SignedMsgType) *bits.BitArray {
 	if !types.IsVoteTypeValid(votesType) {
 		return nil
@@ -1547,6 +1653,25 @@ func (ps *PeerState) ApplyVoteSetBitsMessage(msg *VoteSetBitsMessage, ourVotes *
 	}
 }
 
+// ApplyVoteSetMissingMessage updates the peer state for the bit-array of votes
+// it claims to be missing: every other vote is assumed to be known by the peer.
+func (ps *PeerState) ApplyVoteSetMissingMessage(msg *VoteSetMissingMessage) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != msg.Height {
+		return
+	}
+
+	votes := ps.getVoteBitArray(msg.Height, msg.Round, msg.Type)
+	if votes == nil || votes.Size() != msg.Missing.Size() {
+		return
+	}
+	for i := 0; i < votes.Size(); i++ {
+		votes.SetIndex(i, !msg.Missing.GetIndex(i))
+	}
+}
+
 // String returns a string representation of the PeerState
 func (ps *PeerState) String() string {
 	return ps.StringIndented("")
@@ -1586,6 +1711,7 @@ func init() {
 	tmjson.RegisterType(&HasCommitMessage{}, "tendermint/HasCommit")
 	tmjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	tmjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	tmjson.RegisterType(&VoteSetMissingMessage{}, "tendermint/VoteSetMissing")
 }
 
 func decodeMsg(bz []byte) (msg Message, err error) {
@@ -1933,3 +2059,37 @@ func (m *VoteSetBitsMessage) String() string {
 }
 
 //-------------------------------------
+
+// VoteSetMissingMessage is sent to communicate the bit-array of vote signature
+// shares the sender is still missing. It replaces the HasVote messages which
+// would otherwise be needed to tell the peer which shares are worth sending.
+type VoteSetMissingMessage struct {
+	Height  int64
+	Round   int32
+	Type    tmproto.SignedMsgType
+	Missing *bits.BitArray
+}
+
+// ValidateBasic performs basic validation.
+func (m *VoteSetMissingMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if !types.IsVoteTypeValid(m.Type) {
+		return errors.New("invalid Type")
+	}
+	if m.Missing.Size() > types.MaxVotesCount {
+		return fmt.Errorf("missing bit array is too big: %d, max: %d", m.Missing.Size(), types.MaxVotesCount)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *VoteSetMissingMessage) String() string {
+	return fmt.Sprintf("[VSMiss %v/%02d/%v %v]", m.Height, m.Round, m.Type, m.Missing)
+}
+
+//-------------------------------------
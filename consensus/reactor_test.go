@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/go-kit/kit/metrics"
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/stretchr/testify/assert"
@@ -334,6 +335,120 @@ func TestReactorReceivePanicsIfInitPeerHasntBeenCalledYet(t *testing.T) {
 	})
 }
 
+// Test the threshold commit is gossiped to a lagging peer instead of the
+// remaining precommit shares, and no more shares are gossiped to it afterwards.
+func TestReactorGossipsCommitInsteadOfShares(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", newMockTickerFunc(true), newCounter)
+	defer cleanup()
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	// wait till everyone makes the first new block
+	timeoutWaitGroup(t, N, func(j int) {
+		<-blocksSubs[j].Out()
+	}, css)
+
+	var (
+		reactor = reactors[0]
+		logger  = log.TestingLogger()
+		rs      = reactor.conS.GetRoundState()
+		counts  = &byteCounts{bytes: make(map[string]float64)}
+		ps      = NewPeerState(p2pmock.NewPeer(nil)).SetMetrics(&Metrics{
+			GossipBytes:      byteCounter{counts: counts},
+			GossipBytesSaved: byteCounter{counts: counts, label: "saved"},
+		})
+	)
+	require.NotNil(t, rs.LastCommit)
+
+	// the peer is a validator lagging by one height, which has none of the
+	// precommits of that height
+	ps.ApplyNewRoundStepMessage(&NewRoundStepMessage{
+		Height: rs.Height - 1,
+		Round:  rs.LastCommit.Round,
+		Step:   cstypes.RoundStepPrecommit,
+	})
+	ps.EnsureVoteBitArrays(rs.Height-1, rs.LastValidators.Size())
+
+	// without the commit, the precommit shares are gossiped
+	noCommit := *rs
+	noCommit.LastCommit = nil
+	require.True(t, reactor.gossipVotesAndCommit(logger, &noCommit, ps.GetRoundState(), ps, true, true))
+	voteBytes := counts.get("vote")
+	assert.Positive(t, voteBytes)
+	assert.Zero(t, counts.get("commit"))
+	assert.Zero(t, counts.get("saved"))
+
+	// with the commit, it is sent instead of the remaining shares
+	require.True(t, reactor.gossipVotesAndCommit(logger, rs, ps.GetRoundState(), ps, true, true))
+	assert.True(t, ps.GetRoundState().HasCommit)
+	assert.Positive(t, counts.get("commit"))
+	assert.Positive(t, counts.get("saved"))
+
+	// and no more shares are gossiped to the peer
+	for i := 0; i < N; i++ {
+		assert.False(t, reactor.gossipVotesAndCommit(logger, &noCommit, ps.GetRoundState(), ps, true, true))
+	}
+	assert.Equal(t, voteBytes, counts.get("vote"))
+}
+
+// byteCounts records the bytes counted by byteCounters, per label value.
+type byteCounts struct {
+	mtx   tmsync.Mutex
+	bytes map[string]float64
+}
+
+func (c *byteCounts) get(label string) float64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.bytes[label]
+}
+
+// byteCounter is a metrics.Counter adding to the byteCounts of its last label
+// value.
+type byteCounter struct {
+	counts *byteCounts
+	label  string
+}
+
+func (c byteCounter) With(labelValues ...string) metrics.Counter {
+	return byteCounter{counts: c.counts, label: labelValues[len(labelValues)-1]}
+}
+
+func (c byteCounter) Add(delta float64) {
+	c.counts.mtx.Lock()
+	defer c.counts.mtx.Unlock()
+	c.counts.bytes[c.label] += delta
+}
+
+func TestReactorRejectsVoteSetMissingOfWrongSize(t *testing.T) {
+	N := 1
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", newMockTickerFunc(true), newCounter)
+	defer cleanup()
+	reactors, _, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+
+	reactor := reactors[0]
+	height := reactor.conS.GetRoundState().Height
+	missingMsg := func(size int) []byte {
+		return MustEncode(&VoteSetMissingMessage{
+			Height:  height,
+			Round:   0,
+			Type:    tmproto.PrecommitType,
+			Missing: bits.NewBitArray(size),
+		})
+	}
+
+	peer := p2pmock.NewPeer(nil)
+	reactor.InitPeer(peer)
+	reactor.Receive(StateChannel, peer, missingMsg(N))
+	assert.True(t, peer.IsRunning())
+
+	// the peer is stopped, as its bit array doesn't match the validator set
+	reactor.Receive(StateChannel, peer, missingMsg(N+1))
+	assert.False(t, peer.IsRunning())
+}
+
 // Test we record stats about votes and block parts from other peers.
 func TestReactorRecordsVotesAndBlockParts(t *testing.T) {
 	N := 4
@@ -1009,3 +1124,69 @@ func TestVoteSetBitsMessageValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestVoteSetMissingMessageValidateBasic(t *testing.T) {
+	testCases := []struct {
+		malleateFn func(*VoteSetMissingMessage)
+		expErr     string
+	}{
+		{func(msg *VoteSetMissingMessage) {}, ""},
+		{func(msg *VoteSetMissingMessage) { msg.Height = -1 }, "negative Height"},
+		{func(msg *VoteSetMissingMessage) { msg.Round = -1 }, "negative Round"},
+		{func(msg *VoteSetMissingMessage) { msg.Type = 0x03 }, "invalid Type"},
+		{func(msg *VoteSetMissingMessage) { msg.Missing = bits.NewBitArray(types.MaxVotesCount + 1) },
+			"missing bit array is too big: 10001, max: 10000"},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			msg := &VoteSetMissingMessage{
+				Height:  1,
+				Round:   0,
+				Type:    0x02,
+				Missing: bits.NewBitArray(1),
+			}
+
+			tc.malleateFn(msg)
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
+
+func TestPeerStateApplyVoteSetMissingMessage(t *testing.T) {
+	ps := NewPeerState(nil)
+	ps.PRS.Height = 1
+	ps.PRS.Round = 0
+	ps.EnsureVoteBitArrays(1, 4)
+
+	missing := bits.NewBitArray(4)
+	missing.SetIndex(1, true)
+	missing.SetIndex(3, true)
+	ps.ApplyVoteSetMissingMessage(&VoteSetMissingMessage{
+		Height:  1,
+		Round:   0,
+		Type:    tmproto.PrecommitType,
+		Missing: missing,
+	})
+
+	precommits := ps.GetRoundState().Precommits
+	assert.True(t, precommits.GetIndex(0))
+	assert.False(t, precommits.GetIndex(1))
+	assert.True(t, precommits.GetIndex(2))
+	assert.False(t, precommits.GetIndex(3))
+
+	// a bit array of a different size is ignored
+	ps.ApplyVoteSetMissingMessage(&VoteSetMissingMessage{
+		Height:  1,
+		Round:   0,
+		Type:    tmproto.PrecommitType,
+		Missing: bits.NewBitArray(3),
+	})
+	assert.False(t, ps.GetRoundState().Precommits.GetIndex(1))
+}
@@ -225,6 +225,8 @@ type Handshaker struct {
 
 	nBlocks int // number of blocks applied to the state
 
+	deliverBatch bool // whether the app supports DeliverBatch
+
 	appHashSize int
 }
 
@@ -265,6 +267,12 @@ func (h *Handshaker) NBlocks() int {
 	return h.nBlocks
 }
 
+// DeliverBatch returns true if the app supports DeliverBatch, as reported
+// by the app during the handshake.
+func (h *Handshaker) DeliverBatch() bool {
+	return h.deliverBatch
+}
+
 // TODO: retry the handshake/replay if it fails ?
 func (h *Handshaker) Handshake(proxyApp proxy.AppConns) (uint64, error) {
 
@@ -280,6 +288,7 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) (uint64, error) {
 	}
 	appHash := res.LastBlockAppHash
 	coreChainLockedHeight := res.LastCoreChainLockedHeight
+	h.deliverBatch = res.DeliverBatch
 
 	h.logger.Info("ABCI Handshake App Info",
 		"height", blockHeight,
@@ -287,6 +296,7 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) (uint64, error) {
 		"hash", appHash,
 		"software-version", res.Version,
 		"protocol-version", res.AppVersion,
+		"deliver-batch", res.DeliverBatch,
 	)
 
 	// Only set the version if there is no existing state.
@@ -303,6 +313,10 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) (uint64, error) {
 	// Set the initial state last core chain locked block height
 	h.initialState.LastCoreChainLockedBlockHeight = coreChainLockedHeight
 
+	if err := h.resumeHalted(res.AppVersion); err != nil {
+		return 0, fmt.Errorf("error resuming the halted chain: %w", err)
+	}
+
 	h.logger.Info("Completed ABCI Handshake - Tendermint and App are synced",
 		"appHeight", blockHeight, "appHash", appHash)
 
@@ -311,6 +325,33 @@ func (h *Handshaker) Handshake(proxyApp proxy.AppConns) (uint64, error) {
 	return res.AppVersion, nil
 }
 
+// resumeHalted resumes the chain halted by the application, once the
+// application is upgraded to a higher app version, which is activated from the
+// next height.
+func (h *Handshaker) resumeHalted(appVersion uint64) error {
+	state, err := h.stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if !state.IsHalted() {
+		return nil
+	}
+
+	if appVersion <= state.Version.Consensus.App {
+		h.logger.Info("The chain is halted, waiting for the application to be upgraded",
+			"haltHeight", state.HaltHeight, "appVersion", appVersion)
+		return nil
+	}
+
+	h.logger.Info("Resuming the chain halted for an upgrade",
+		"haltHeight", state.HaltHeight, "appVersion", appVersion)
+	state.HaltHeight = 0
+	// the proposals are cleared whenever the app version changes
+	state.Version.Consensus.App = appVersion
+	state.AppVersionProposals = nil
+	return h.stateStore.Save(state)
+}
+
 // ReplayBlocks replays all blocks since appBlockHeight and ensures the result
 // matches the current state.
 // Returns the final AppHash or an error.
@@ -583,6 +624,7 @@ func (h *Handshaker) replayBlocks(
 			h.logger,
 			h.stateStore,
 			h.genDoc.InitialHeight,
+			h.deliverBatch,
 		)
 		if err != nil {
 			return nil, err
@@ -622,6 +664,7 @@ func (h *Handshaker) replayBlock(state sm.State, height int64, proxyApp proxy.Ap
 		sm.EmptyEvidencePool{},
 		nil,
 		sm.BlockExecutorWithAppHashSize(h.appHashSize),
+		sm.BlockExecutorWithDeliverBatch(h.deliverBatch),
 	)
 
 	blockExec.SetEventBus(h.eventBus)
@@ -425,7 +425,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 	// stateID := types.StateID{LastAppHash: css[0].state.AppHash}
 
-	proposal := types.NewProposal(vss[1].Height, 1, round, -1, blockID)
+	proposal := types.NewProposal(vss[1].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p := proposal.ToProto()
 	if _, err := vss[1].SignProposal(config.ChainID(), genDoc.QuorumType, genDoc.QuorumHash, p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -460,7 +460,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	propBlockParts = propBlock.MakePartSet(partSize)
 	blockID = types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 
-	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID)
+	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p = proposal.ToProto()
 	if _, err := vss[2].SignProposal(config.ChainID(), genDoc.QuorumType, genDoc.QuorumHash, p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -538,7 +538,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	blockID = types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 
 	vssProposer := findProposer(vss, css[0].Validators.Proposer.ProTxHash)
-	proposal = types.NewProposal(vss[3].Height, 1, round, -1, blockID)
+	proposal = types.NewProposal(vss[3].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p = proposal.ToProto()
 	if _, err := vssProposer.SignProposal(config.ChainID(), genDoc.QuorumType, quorumHash2, p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -605,7 +605,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	propBlockParts = propBlock.MakePartSet(partSize)
 	blockID = types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 
-	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID)
+	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p = proposal.ToProto()
 	proposerProTxHash := css[0].RoundState.Validators.GetProposer().ProTxHash
 	valIndexFnByProTxHash := func(proTxHash crypto.ProTxHash) int {
@@ -704,7 +704,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	propBlockParts = propBlock.MakePartSet(partSize)
 	blockID = types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 
-	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID)
+	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p = proposal.ToProto()
 	proposer := css[0].RoundState.Validators.GetProposer()
 	proposerProTxHash = proposer.ProTxHash
@@ -799,7 +799,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	propBlockParts = propBlock.MakePartSet(partSize)
 	blockID = types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 
-	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID)
+	proposal = types.NewProposal(vss[2].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p = proposal.ToProto()
 	proposerProTxHash = css[0].RoundState.Validators.GetProposer().ProTxHash
 	proposerIndex = valIndexFnByProTxHash(proposerProTxHash)
@@ -882,7 +882,7 @@ func TestSimulateValidatorsChange(t *testing.T) {
 	propBlockParts = propBlock.MakePartSet(partSize)
 	blockID = types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
 
-	proposal = types.NewProposal(vss[5].Height, 1, round, -1, blockID)
+	proposal = types.NewProposal(vss[5].Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p = proposal.ToProto()
 	proposer = css[0].RoundState.Validators.GetProposer()
 	proposerProTxHash = proposer.ProTxHash
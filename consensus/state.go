@@ -73,6 +73,18 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports signed proposals with invalid chain locks to the evidence pool to
+	// be processed into evidence
+	ReportInvalidChainLock(proposal *types.Proposal, block *types.Block, blockParts *types.PartSet)
+	// reports conflicting proposals of the proposer to the evidence pool to be
+	// processed into evidence
+	ReportConflictingProposals(proposalA, proposalB *types.Proposal)
+}
+
+// interface to the indexers of blocks and transactions
+type indexPruner interface {
+	// requests the indexes below the retain height to be pruned
+	Prune(retainHeight int64)
 }
 
 // State handles execution of the consensus algorithm.
@@ -99,6 +111,9 @@ type State struct {
 	// when it's detected
 	evpool evidencePool
 
+	// prune the indexes along with blocks, if set
+	indexPruner indexPruner
+
 	// internal state
 	mtx tmsync.RWMutex
 	cstypes.RoundState
@@ -148,6 +163,10 @@ type State struct {
 
 	// proposer's latest available app protocol version that goes to block header
 	proposedAppVersion uint64
+
+	// resumed is closed on resuming the paused state, nil unless paused
+	pauseMtx tmsync.Mutex
+	resumed  chan struct{}
 }
 
 // StateOption sets an optional parameter on the State.
@@ -215,6 +234,10 @@ func NewStateWithLogger(
 		option(cs)
 	}
 
+	if config.AdaptiveTimeouts {
+		cs.timeoutTicker = NewAdaptiveTimeoutTicker(config, cs.metrics)
+	}
+
 	return cs
 }
 
@@ -235,6 +258,12 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateIndexPruner sets the pruner of the block and transaction indexes,
+// which is invoked whenever blocks are pruned.
+func StateIndexPruner(pruner indexPruner) StateOption {
+	return func(cs *State) { cs.indexPruner = pruner }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -451,6 +480,39 @@ func (cs *State) OnStop() {
 	// WAL is stopped in receiveRoutine.
 }
 
+// Pause pauses processing the messages and the timeouts, e.g. while the
+// connection to the application is lost. The message being processed, if any,
+// is processed to the end. Pause is a no-op if the state is already paused.
+func (cs *State) Pause() {
+	cs.pauseMtx.Lock()
+	defer cs.pauseMtx.Unlock()
+
+	if cs.resumed == nil {
+		cs.Logger.Info("Pausing consensus")
+		cs.resumed = make(chan struct{})
+	}
+}
+
+// Resume resumes processing the messages and the timeouts, paused with Pause.
+// Resume is a no-op if the state is not paused.
+func (cs *State) Resume() {
+	cs.pauseMtx.Lock()
+	defer cs.pauseMtx.Unlock()
+
+	if cs.resumed != nil {
+		cs.Logger.Info("Resuming consensus")
+		close(cs.resumed)
+		cs.resumed = nil
+	}
+}
+
+// IsPaused returns true if the state is paused.
+func (cs *State) IsPaused() bool {
+	cs.pauseMtx.Lock()
+	defer cs.pauseMtx.Unlock()
+	return cs.resumed != nil
+}
+
 // Wait waits for the the main routine to return.
 // NOTE: be sure to Stop() the event switch and drain
 // any event channels or this may deadlock
@@ -706,6 +768,7 @@ func (cs *State) updateToState(state sm.State, commit *types.Commit, logger log.
 	}
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -734,6 +797,10 @@ func (cs *State) newStep() {
 
 	cs.nSteps++
 
+	if observer, ok := cs.timeoutTicker.(stepObserver); ok && !cs.replayMode {
+		observer.ObserveStep(cs.Height, cs.Round, cs.Step)
+	}
+
 	// newStep is called by updateToState in NewState before the eventBus is set!
 	if cs.eventBus != nil {
 		if err := cs.eventBus.PublishEventNewRoundStep(rs); err != nil {
@@ -791,6 +858,18 @@ func (cs *State) receiveRoutine(maxSteps int) {
 			}
 		}
 
+		cs.pauseMtx.Lock()
+		resumed := cs.resumed
+		cs.pauseMtx.Unlock()
+		if resumed != nil {
+			select {
+			case <-resumed:
+			case <-cs.Quit():
+				onExit(cs)
+				return
+			}
+		}
+
 		rs := cs.RoundState
 		var mi msgInfo
 
@@ -1022,6 +1101,12 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
+	// the chain halted for the application stays at the height
+	if cs.state.IsHalted() {
+		logger.Info("the chain is halted, not entering a new round", "halt_height", cs.state.HaltHeight)
+		return
+	}
+
 	if now := tmtime.Now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
@@ -1047,6 +1132,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Debug("resetting proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1189,7 +1275,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	if cs.blockExec.NextCoreChainLock != nil && cs.blockExec.NextCoreChainLock.CoreBlockHeight > proposedChainLockHeight {
 		proposedChainLockHeight = cs.blockExec.NextCoreChainLock.CoreBlockHeight
 	}
-	proposal := types.NewProposal(height, proposedChainLockHeight, round, cs.ValidRound, propBlockID)
+	proposal := types.NewProposal(height, proposedChainLockHeight, round, cs.ValidRound, propBlockID, block.Header.Time)
 	p := proposal.ToProto()
 	validatorsAtProposalHeight := cs.state.ValidatorsAtHeight(p.Height)
 
@@ -1350,6 +1436,33 @@ func (cs *State) defaultDoPrevote(height int64, round int32, allowOldBlocks bool
 	if err != nil {
 		// ProposalBlock is invalid, prevote nil.
 		logger.Error("prevote step: ProposalBlock is invalid", "err", err)
+		cs.reportInvalidChainLock(err)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// The proposal must carry the time of the proposed block
+	if cs.Proposal != nil && !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Header.Time) {
+		logger.Error("prevote step: proposal timestamp not equal to block time",
+			"proposal", cs.Proposal.Timestamp, "block", cs.ProposalBlock.Header.Time)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// A new block (one without a proof-of-lock) must be timely. Blocks with a
+	// POL were already deemed timely by +2/3 of the validators in an earlier round.
+	// The receive time is not recorded in the WAL, so the check is skipped on replay.
+	// The first block carries the genesis time rather than the time it was
+	// proposed at, so it is never timely once the chain starts after genesis:
+	// its time is checked against the genesis time by ValidateBlockTime instead.
+	if cs.Proposal != nil && cs.Proposal.POLRound == -1 && !cs.replayMode &&
+		cs.Height != cs.state.InitialHeight &&
+		!cs.Proposal.IsTimely(cs.ProposalReceiveTime, cs.state.ConsensusParams.Synchrony) {
+		logger.Error("prevote step: proposal is not timely",
+			"timestamp", cs.Proposal.Timestamp,
+			"receive_time", cs.ProposalReceiveTime,
+			"precision", cs.state.ConsensusParams.Synchrony.Precision,
+			"message_delay", cs.state.ConsensusParams.Synchrony.MessageDelay)
 		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
 		return
 	}
@@ -1359,6 +1472,7 @@ func (cs *State) defaultDoPrevote(height int64, round int32, allowOldBlocks bool
 	if err != nil {
 		// ProposalBlock is invalid, prevote nil.
 		logger.Error("enterPrevote: ProposalBlock chain lock is invalid", "err", err)
+		cs.reportInvalidChainLock(err)
 		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
 		return
 	}
@@ -1381,6 +1495,16 @@ func (cs *State) defaultDoPrevote(height int64, round int32, allowOldBlocks bool
 	cs.signAddVote(tmproto.PrevoteType, cs.ProposalBlock.Hash(), cs.ProposalBlockParts.Header())
 }
 
+// reportInvalidChainLock reports the proposal to the evidence pool, if the
+// proposal block is invalid because of its chain lock.
+func (cs *State) reportInvalidChainLock(err error) {
+	var clErr sm.ErrInvalidChainLock
+	if cs.Proposal == nil || !errors.As(err, &clErr) {
+		return
+	}
+	cs.evpool.ReportInvalidChainLock(cs.Proposal, cs.ProposalBlock, cs.ProposalBlockParts)
+}
+
 // Enter: any +2/3 prevotes at next round.
 func (cs *State) enterPrevoteWait(height int64, round int32) {
 	logger := cs.Logger.With("height", height, "round", round)
@@ -1584,6 +1708,13 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		return
 	}
 
+	// no block can be committed once the chain is halted, not even one +2/3
+	// of the validators committed after resuming the chain
+	if cs.state.IsHalted() {
+		logger.Info("the chain is halted, not entering commit step", "halt_height", cs.state.HaltHeight)
+		return
+	}
+
 	logger.Info("Entering commit step", "current", fmt.Sprintf("%v/%v/%v", cs.Height, cs.Round, cs.Step))
 
 	defer func() {
@@ -1594,6 +1725,10 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		cs.CommitTime = tmtime.Now()
 		cs.newStep()
 
+		// The threshold signatures are recovered as soon as +2/3 precommits are
+		// received, so let peers know we have the commit and don't need more shares.
+		cs.evsw.FireEvent(types.EventCommit, cs.Votes.Precommits(commitRound).MakeCommit())
+
 		// Maybe finalize immediately.
 		cs.tryFinalizeCommit(height)
 	}()
@@ -1679,6 +1814,11 @@ func (cs *State) finalizeCommit(height int64) {
 		return
 	}
 
+	if cs.state.IsHalted() {
+		logger.Info("the chain is halted, not finalizing commit", "halt_height", cs.state.HaltHeight)
+		return
+	}
+
 	blockID, ok := cs.Votes.Precommits(cs.CommitRound).TwoThirdsMajority()
 	block, blockParts := cs.ProposalBlock, cs.ProposalBlockParts
 
@@ -1787,6 +1927,14 @@ func (cs *State) verifyCommit(
 		return false, nil
 	}
 
+	// The commits of the peers, which resumed the chain, are ignored until
+	// the chain is resumed here, too
+	if cs.state.IsHalted() {
+		cs.Logger.Debug("commit ignored, the chain is halted", "commit_height", commit.Height,
+			"halt_height", cs.state.HaltHeight)
+		return false, nil
+	}
+
 	cs.Logger.Debug(
 		"verifying commit from remote",
 		"commit_height", commit.Height,
@@ -1999,6 +2147,9 @@ func (cs *State) pruneBlocks(retainHeight int64) (uint64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("failed to prune state database: %w", err)
 	}
+	if cs.indexPruner != nil {
+		cs.indexPruner.Prune(retainHeight)
+	}
 	return pruned, nil
 }
 
@@ -2059,8 +2210,8 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 
 func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	// Already have one
-	// TODO: possibly catch double proposals
 	if cs.Proposal != nil {
+		cs.checkConflictingProposal(proposal)
 		return nil
 	}
 
@@ -2079,10 +2230,6 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		return ErrInvalidProposalPOLRound
 	}
 
-	if proposal.CoreChainLockedHeight < cs.state.LastCoreChainLockedBlockHeight {
-		return ErrInvalidProposalCoreHeight
-	}
-
 	p := proposal.ToProto()
 	// Verify signature
 	proposalBlockSignID := types.ProposalBlockSignID(
@@ -2124,8 +2271,17 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		return ErrUnableToVerifyProposal
 	}
 
+	if proposal.CoreChainLockedHeight < cs.state.LastCoreChainLockedBlockHeight {
+		if proposer.PubKey != nil {
+			// the proposer signed the proposal, so it can be punished
+			cs.evpool.ReportInvalidChainLock(proposal, nil, nil)
+		}
+		return ErrInvalidProposalCoreHeight
+	}
+
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepApplyCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -2137,6 +2293,43 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	return nil
 }
 
+// checkConflictingProposal reports the proposal to the evidence pool, if it is
+// signed by the proposer of the round, but differs from the proposal of the
+// round in the block, the POL round or the timestamp.
+func (cs *State) checkConflictingProposal(proposal *types.Proposal) {
+	if proposal == nil || proposal.Height != cs.Proposal.Height || proposal.Round != cs.Proposal.Round {
+		return
+	}
+	// a proposal of the same block with another POL round or timestamp
+	// conflicts too, as it's signed with other sign bytes
+	proposalPb := proposal.ToProto()
+	if bytes.Equal(
+		types.ProposalBlockSignBytes(cs.state.ChainID, proposalPb),
+		types.ProposalBlockSignBytes(cs.state.ChainID, cs.Proposal.ToProto()),
+	) {
+		return
+	}
+
+	proposer := cs.Validators.GetProposer()
+	if proposer.PubKey == nil {
+		// we can not check the signature
+		return
+	}
+	proposalBlockSignID := types.ProposalBlockSignID(
+		cs.state.ChainID,
+		proposalPb,
+		cs.state.Validators.QuorumType,
+		cs.state.Validators.QuorumHash,
+	)
+	if !proposer.PubKey.VerifySignatureDigest(proposalBlockSignID, proposal.Signature) {
+		return
+	}
+
+	cs.Logger.Info("found conflicting proposal", "height", proposal.Height, "round", proposal.Round,
+		"proposer", proposer.ProTxHash.ShortString(), "proposal", cs.Proposal, "conflicting", proposal)
+	cs.evpool.ReportConflictingProposals(cs.Proposal, proposal)
+}
+
 // NOTE: block is not necessarily valid.
 // Asynchronously triggers either enterPrevote (before we timeout of propose) or tryFinalizeCommit,
 // once we have the full block.
@@ -2602,6 +2795,11 @@ func (cs *State) signAddVote(msgType tmproto.SignedMsgType, hash []byte, header
 		return nil
 	}
 
+	// Don't vote once the chain is halted.
+	if cs.state.IsHalted() {
+		return nil
+	}
+
 	// TODO: pass pubKey to signVote
 	vote, err := cs.signVote(msgType, hash, header)
 	if err == nil {
@@ -18,7 +18,9 @@ import (
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	p2pmock "github.com/tendermint/tendermint/p2p/mock"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 /*
@@ -147,6 +149,26 @@ func TestStateEnterProposeNoPrivValidator(t *testing.T) {
 	}
 }
 
+// a paused state should not process the timeouts until resumed
+func TestStatePause(t *testing.T) {
+	cs, _ := randState(1)
+	cs.SetPrivValidator(nil)
+	height, round := cs.Height, cs.Round
+
+	timeoutCh := subscribe(cs.eventBus, types.EventQueryTimeoutPropose)
+
+	cs.Pause()
+	assert.True(t, cs.IsPaused())
+	startTestRound(cs, height, round)
+
+	// the propose timeout fires, but is not processed
+	ensureNoNewTimeout(timeoutCh, cs.config.TimeoutPropose.Nanoseconds())
+
+	cs.Resume()
+	assert.False(t, cs.IsPaused())
+	ensureNewTimeout(timeoutCh, height, round, cs.config.TimeoutPropose.Nanoseconds())
+}
+
 // a validator should not timeout of the prevote round (TODO: unless the block is really big!)
 func TestStateEnterProposeYesPrivValidator(t *testing.T) {
 	cs, _ := randState(1)
@@ -203,7 +225,7 @@ func TestStateBadProposal(t *testing.T) {
 	propBlock.AppHash = stateHash
 	propBlockParts := propBlock.MakePartSet(partSize)
 	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
-	proposal := types.NewProposal(vs2.Height, 1, round, -1, blockID)
+	proposal := types.NewProposal(vs2.Height, 1, round, -1, blockID, propBlock.Header.Time)
 	p := proposal.ToProto()
 	if _, err := vs2.SignProposal(config.ChainID(), cs1.Validators.QuorumType, cs1.Validators.QuorumHash, p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -236,6 +258,59 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, tmproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+// conflictingProposalsPool records the conflicting proposals reported to it.
+type conflictingProposalsPool struct {
+	sm.EmptyEvidencePool
+	reported []*types.Proposal
+}
+
+func (evpool *conflictingProposalsPool) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	evpool.reported = append(evpool.reported, proposalB)
+}
+
+// A proposal signed by the proposer of the round conflicts with the proposal
+// of the round, if their sign bytes differ, even if they are for the same
+// block.
+func TestStateConflictingProposals(t *testing.T) {
+	cs1, vss := randState(1)
+	height, round := cs1.Height, cs1.Round
+	evpool := &conflictingProposalsPool{}
+	cs1.evpool = evpool
+
+	proposal, _ := decideProposal(cs1, vss[0], height, round)
+	require.NoError(t, cs1.defaultSetProposal(proposal))
+
+	valSet := cs1.state.Validators
+	resign := func(modify func(*types.Proposal)) *types.Proposal {
+		other := *proposal
+		modify(&other)
+		p := other.ToProto()
+		_, err := vss[0].SignProposal(cs1.state.ChainID, valSet.QuorumType, valSet.QuorumHash, p)
+		require.NoError(t, err)
+		other.Signature = p.Signature
+		return &other
+	}
+
+	// the same proposal doesn't conflict
+	require.NoError(t, cs1.defaultSetProposal(resign(func(*types.Proposal) {})))
+	assert.Empty(t, evpool.reported)
+
+	// neither does an unsigned one
+	unsigned := resign(func(p *types.Proposal) { p.POLRound = 0 })
+	unsigned.Signature = proposal.Signature
+	require.NoError(t, cs1.defaultSetProposal(unsigned))
+	assert.Empty(t, evpool.reported)
+
+	otherPOLRound := resign(func(p *types.Proposal) { p.POLRound = 0 })
+	require.NoError(t, cs1.defaultSetProposal(otherPOLRound))
+	otherTime := resign(func(p *types.Proposal) { p.Timestamp = p.Timestamp.Add(time.Millisecond) })
+	require.NoError(t, cs1.defaultSetProposal(otherTime))
+	otherBlock := resign(func(p *types.Proposal) { p.BlockID.Hash = tmrand.Bytes(tmhash.Size) })
+	require.NoError(t, cs1.defaultSetProposal(otherBlock))
+
+	assert.Equal(t, []*types.Proposal{otherPOLRound, otherTime, otherBlock}, evpool.reported)
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	cs1, vss := randState(2)
 	cs1.state.ConsensusParams.Block.MaxBytes = 2000
@@ -257,7 +332,7 @@ func TestStateOversizedBlock(t *testing.T) {
 
 	propBlockParts := propBlock.MakePartSet(partSize)
 	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
-	proposal := types.NewProposal(height, 1, round, -1, blockID)
+	proposal := types.NewProposal(height, 1, round, -1, blockID, propBlock.Header.Time)
 	p := proposal.ToProto()
 	if _, err := vs2.SignProposal(config.ChainID(), cs1.Validators.QuorumType, cs1.Validators.QuorumHash, p); err != nil {
 		t.Fatal("failed to sign bad proposal", err)
@@ -337,6 +412,40 @@ func TestStateFullRound1(t *testing.T) {
 	validateLastCommit(t, cs, vss[0], propBlockHash)
 }
 
+// A chain started well after its genesis time commits the first block, which
+// carries the genesis time, and the next one.
+func TestStateFullRoundAfterGenesisTime(t *testing.T) {
+	genDoc, privVals := randGenesisDoc(1, false, 10)
+	genDoc.GenesisTime = tmtime.Now().Add(-time.Hour)
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	cs := newState(state, privVals[0], counter.NewApplication(true))
+	vs := newValidatorStub(privVals[0], 0)
+	height, round := cs.Height, cs.Round
+
+	voteCh := subscribe(cs.eventBus, types.EventQueryVote)
+	propCh := subscribe(cs.eventBus, types.EventQueryCompleteProposal)
+	newBlockCh := subscribe(cs.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs, height, round)
+
+	for ; height <= state.InitialHeight+1; height++ {
+		ensureNewProposal(propCh, height, round)
+		propBlock := cs.GetRoundState().ProposalBlock
+
+		// the proposal is prevoted, although the first block is not timely
+		ensurePrevote(voteCh, height, round)
+		validatePrevote(t, cs, round, vs, propBlock.Hash())
+		ensurePrecommit(voteCh, height, round)
+
+		ensureNewBlock(newBlockCh, height)
+		if height == state.InitialHeight {
+			assert.True(t, propBlock.Time.Equal(genDoc.GenesisTime))
+		}
+	}
+}
+
 // nil is proposed, so prevote and precommit nil
 func TestStateFullRoundNil(t *testing.T) {
 	cs, vss := randState(1)
@@ -1082,7 +1191,7 @@ func TestStateLockPOLSafety2(t *testing.T) {
 
 	round++ // moving to the next round
 	// in round 2 we see the polkad block from round 0
-	newProp := types.NewProposal(height, 1, round, 0, propBlockID0)
+	newProp := types.NewProposal(height, 1, round, 0, propBlockID0, propBlock0.Header.Time)
 	p := newProp.ToProto()
 	if _, err := vs3.SignProposal(config.ChainID(), cs1.Validators.QuorumType, cs1.Validators.QuorumHash, p); err != nil {
 		t.Fatal(err)
@@ -0,0 +1,203 @@
+package consensus
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	cfg "github.com/tendermint/tendermint/config"
+	cstypes "github.com/tendermint/tendermint/consensus/types"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+const (
+	// adaptiveTimeoutMargin is applied to the observed latency percentile, so
+	// that a round which is only slightly slower than usual does not time out.
+	adaptiveTimeoutMargin = 1.5
+	// adaptiveTimeoutMinSamples is the number of samples that must be collected
+	// for a step before the configured static timeout is replaced.
+	adaptiveTimeoutMinSamples = 10
+)
+
+// stepObserver is implemented by TimeoutTickers which want to be notified
+// about every step transition of the consensus state machine.
+type stepObserver interface {
+	ObserveStep(height int64, round int32, step cstypes.RoundStepType)
+}
+
+// adaptiveTimeoutTicker is a TimeoutTicker which learns how long it takes to
+// receive a complete proposal and a quorum of prevotes and precommits, and
+// tunes timeoutPropose, timeoutPrevote and timeoutPrecommit accordingly.
+//
+// Latencies are measured between consecutive step transitions within the same
+// height and round. The chosen timeout is the configured percentile of the
+// recent samples multiplied by adaptiveTimeoutMargin, clamped to
+// [AdaptiveTimeoutsMin, AdaptiveTimeoutsMax], plus the usual per-round delta.
+// Until enough samples are collected, the static timeouts from the config are
+// used.
+type adaptiveTimeoutTicker struct {
+	*timeoutTicker
+
+	config  *cfg.ConsensusConfig
+	metrics *Metrics
+
+	mtx      tmsync.Mutex
+	lastStep stepTime
+	samples  map[cstypes.RoundStepType]*latencyWindow
+}
+
+var _ TimeoutTicker = (*adaptiveTimeoutTicker)(nil)
+var _ stepObserver = (*adaptiveTimeoutTicker)(nil)
+
+// stepTime records when the state machine entered height/round/step.
+type stepTime struct {
+	height int64
+	round  int32
+	step   cstypes.RoundStepType
+	time   time.Time
+}
+
+// NewAdaptiveTimeoutTicker returns a new TimeoutTicker, which adjusts the
+// propose, prevote and precommit timeouts based on the latencies observed
+// during recent heights.
+func NewAdaptiveTimeoutTicker(config *cfg.ConsensusConfig, metrics *Metrics) TimeoutTicker {
+	tt := &adaptiveTimeoutTicker{
+		timeoutTicker: NewTimeoutTicker().(*timeoutTicker),
+		config:        config,
+		metrics:       metrics,
+		samples:       make(map[cstypes.RoundStepType]*latencyWindow),
+	}
+	for _, step := range []cstypes.RoundStepType{
+		cstypes.RoundStepPropose,
+		cstypes.RoundStepPrevote,
+		cstypes.RoundStepPrecommit,
+	} {
+		tt.samples[step] = newLatencyWindow(config.AdaptiveTimeoutsWindow)
+	}
+	return tt
+}
+
+// ScheduleTimeout replaces the duration of propose, prevote wait and precommit
+// wait timeouts with the adaptive one and schedules the timeout.
+func (t *adaptiveTimeoutTicker) ScheduleTimeout(ti timeoutInfo) {
+	ti.Duration = t.timeout(ti)
+	t.timeoutTicker.ScheduleTimeout(ti)
+}
+
+// ObserveStep implements stepObserver. The time elapsed since the previous
+// transition is recorded as a latency sample of the previous step, as long as
+// both transitions happened within the same height and round.
+func (t *adaptiveTimeoutTicker) ObserveStep(height int64, round int32, step cstypes.RoundStepType) {
+	now := tmtime.Now()
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	last := t.lastStep
+	t.lastStep = stepTime{height: height, round: round, step: step, time: now}
+	// Entering precommit wait does not change the step, so it must not be
+	// sampled again when the next transition happens.
+	if step == cstypes.RoundStepPrecommit && last.step == step {
+		t.lastStep.step = cstypes.RoundStepPrecommitWait
+	}
+
+	if last.height != height || last.round != round {
+		return
+	}
+	window, ok := t.samples[last.step]
+	if !ok {
+		return
+	}
+	latency := now.Sub(last.time)
+	window.add(latency)
+	t.metrics.StepLatencySeconds.With("step", last.step.String()).Observe(latency.Seconds())
+}
+
+// timeout returns the duration to use for the given timeout.
+func (t *adaptiveTimeoutTicker) timeout(ti timeoutInfo) time.Duration {
+	var (
+		step  cstypes.RoundStepType
+		delta time.Duration
+	)
+	switch ti.Step {
+	case cstypes.RoundStepPropose:
+		step, delta = cstypes.RoundStepPropose, t.config.TimeoutProposeDelta
+	case cstypes.RoundStepPrevoteWait:
+		step, delta = cstypes.RoundStepPrevote, t.config.TimeoutPrevoteDelta
+	case cstypes.RoundStepPrecommitWait:
+		step, delta = cstypes.RoundStepPrecommit, t.config.TimeoutPrecommitDelta
+	default:
+		return ti.Duration
+	}
+
+	t.mtx.Lock()
+	window := t.samples[step]
+	if window.len() < adaptiveTimeoutMinSamples {
+		t.mtx.Unlock()
+		return ti.Duration
+	}
+	latency := window.percentile(t.config.AdaptiveTimeoutsPercentile)
+	t.mtx.Unlock()
+
+	timeout := time.Duration(float64(latency) * adaptiveTimeoutMargin)
+	if timeout < t.config.AdaptiveTimeoutsMin {
+		timeout = t.config.AdaptiveTimeoutsMin
+	}
+	if timeout > t.config.AdaptiveTimeoutsMax {
+		timeout = t.config.AdaptiveTimeoutsMax
+	}
+	t.metrics.StepTimeoutSeconds.With("step", ti.Step.String()).Set(timeout.Seconds())
+
+	return timeout + delta*time.Duration(ti.Round)
+}
+
+//-------------------------------------------------------------
+
+// latencyWindow keeps the most recent latency samples in a ring buffer.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+	full    bool
+}
+
+func newLatencyWindow(size int) *latencyWindow {
+	if size < 1 {
+		size = 1
+	}
+	return &latencyWindow{samples: make([]time.Duration, size)}
+}
+
+func (w *latencyWindow) add(d time.Duration) {
+	w.samples[w.next] = d
+	w.next++
+	if w.next == len(w.samples) {
+		w.next = 0
+		w.full = true
+	}
+}
+
+func (w *latencyWindow) len() int {
+	if w.full {
+		return len(w.samples)
+	}
+	return w.next
+}
+
+// percentile returns the p-th (0 < p <= 1) percentile of the samples, using
+// the nearest-rank method. The window must not be empty.
+func (w *latencyWindow) percentile(p float64) time.Duration {
+	n := w.len()
+	sorted := make([]time.Duration, n)
+	copy(sorted, w.samples[:n])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p*float64(n))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= n {
+		rank = n - 1
+	}
+	return sorted[rank]
+}
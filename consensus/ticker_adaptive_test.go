@@ -0,0 +1,80 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/tendermint/tendermint/config"
+	cstypes "github.com/tendermint/tendermint/consensus/types"
+)
+
+func TestLatencyWindowPercentile(t *testing.T) {
+	w := newLatencyWindow(10)
+	for i := 1; i <= 15; i++ {
+		w.add(time.Duration(i) * time.Millisecond)
+	}
+
+	// only the last 10 samples (6..15ms) are kept
+	require.Equal(t, 10, w.len())
+	assert.Equal(t, 6*time.Millisecond, w.percentile(0))
+	assert.Equal(t, 10*time.Millisecond, w.percentile(0.5))
+	assert.Equal(t, 15*time.Millisecond, w.percentile(0.95))
+	assert.Equal(t, 15*time.Millisecond, w.percentile(1))
+}
+
+func TestAdaptiveTimeoutTicker(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	config.AdaptiveTimeoutsWindow = 20
+	config.AdaptiveTimeoutsPercentile = 1
+	config.AdaptiveTimeoutsMin = 100 * time.Millisecond
+	config.AdaptiveTimeoutsMax = 2 * time.Second
+
+	tt := NewAdaptiveTimeoutTicker(config, NopMetrics()).(*adaptiveTimeoutTicker)
+
+	proposeTimeout := timeoutInfo{Duration: config.Propose(0), Height: 1, Round: 0, Step: cstypes.RoundStepPropose}
+	prevoteTimeout := timeoutInfo{Duration: config.Prevote(1), Height: 1, Round: 1, Step: cstypes.RoundStepPrevoteWait}
+	commitTimeout := timeoutInfo{Duration: time.Second, Height: 1, Round: 0, Step: cstypes.RoundStepNewHeight}
+
+	// not enough samples, static timeouts are used
+	assert.Equal(t, config.Propose(0), tt.timeout(proposeTimeout))
+	assert.Equal(t, config.Prevote(1), tt.timeout(prevoteTimeout))
+
+	for i := 0; i < adaptiveTimeoutMinSamples; i++ {
+		tt.samples[cstypes.RoundStepPropose].add(200 * time.Millisecond)
+		tt.samples[cstypes.RoundStepPrevote].add(time.Millisecond)
+	}
+
+	// percentile multiplied by margin
+	assert.Equal(t, 300*time.Millisecond, tt.timeout(proposeTimeout))
+	// clamped to the lower bound, with the round delta added
+	assert.Equal(t, config.AdaptiveTimeoutsMin+config.TimeoutPrevoteDelta, tt.timeout(prevoteTimeout))
+	// other timeouts are not changed
+	assert.Equal(t, time.Second, tt.timeout(commitTimeout))
+
+	tt.samples[cstypes.RoundStepPropose].add(time.Minute)
+	assert.Equal(t, config.AdaptiveTimeoutsMax, tt.timeout(proposeTimeout))
+}
+
+func TestAdaptiveTimeoutTickerObserveStep(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	tt := NewAdaptiveTimeoutTicker(config, NopMetrics()).(*adaptiveTimeoutTicker)
+
+	tt.ObserveStep(1, 0, cstypes.RoundStepNewRound)
+	tt.ObserveStep(1, 0, cstypes.RoundStepPropose)
+	tt.ObserveStep(1, 0, cstypes.RoundStepPrevote)
+	tt.ObserveStep(1, 0, cstypes.RoundStepPrevoteWait)
+	tt.ObserveStep(1, 0, cstypes.RoundStepPrecommit)
+	// precommit wait
+	tt.ObserveStep(1, 0, cstypes.RoundStepPrecommit)
+	tt.ObserveStep(1, 0, cstypes.RoundStepApplyCommit)
+	// propose of the next height is not matched with commit of the previous one
+	tt.ObserveStep(2, 0, cstypes.RoundStepPropose)
+	tt.ObserveStep(2, 1, cstypes.RoundStepPropose)
+
+	assert.Equal(t, 1, tt.samples[cstypes.RoundStepPropose].len())
+	assert.Equal(t, 1, tt.samples[cstypes.RoundStepPrevote].len())
+	assert.Equal(t, 1, tt.samples[cstypes.RoundStepPrecommit].len())
+}
@@ -0,0 +1,314 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	auto "github.com/tendermint/tendermint/libs/autofile"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/types"
+)
+
+// Kinds of WAL messages, as reported by InspectWAL and accepted by
+// WALExportFilter.
+const (
+	WALMsgProposal   = "proposal"
+	WALMsgBlockPart  = "block_part"
+	WALMsgVote       = "vote"
+	WALMsgCommit     = "commit"
+	WALMsgTimeout    = "timeout"
+	WALMsgRoundState = "round_state"
+	WALMsgEndHeight  = "end_height"
+	WALMsgOther      = "other"
+)
+
+var errWALEndHeightNotFound = errors.New("EndHeightMessage not found in WAL")
+
+// WALHeightSummary describes the messages of a single height found in the WAL.
+type WALHeightSummary struct {
+	Height int64
+	Rounds []WALRoundSummary
+	// Ended is true if the WAL contains the EndHeightMessage for this height.
+	Ended bool
+}
+
+// WALRoundSummary holds the number of messages of every kind found in the WAL
+// for a single round.
+type WALRoundSummary struct {
+	Round    int32
+	Messages map[string]int
+}
+
+// WALExportFilter selects the messages written by ExportWAL. Zero values
+// disable the corresponding filter.
+type WALExportFilter struct {
+	MinHeight int64
+	MaxHeight int64
+	Kinds     []string
+}
+
+func (f WALExportFilter) match(kind string, height int64) bool {
+	if f.MinHeight > 0 && height < f.MinHeight {
+		return false
+	}
+	if f.MaxHeight > 0 && height > f.MaxHeight {
+		return false
+	}
+	if len(f.Kinds) == 0 {
+		return true
+	}
+	for _, k := range f.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// InspectWAL reads the WAL and returns a summary of every height and round
+// found in it, in the order they were written.
+func InspectWAL(walFile string) ([]WALHeightSummary, error) {
+	var (
+		summaries []WALHeightSummary
+		index     = make(map[int64]int)
+	)
+	err := walkWAL(walFile, func(_ int, msg *TimedWALMessage) (bool, error) {
+		kind, height, round := walMessageInfo(msg.Msg)
+		i, ok := index[height]
+		if !ok {
+			i = len(summaries)
+			index[height] = i
+			summaries = append(summaries, WALHeightSummary{Height: height})
+		}
+		hs := &summaries[i]
+
+		if kind == WALMsgEndHeight {
+			hs.Ended = true
+			return false, nil
+		}
+
+		var rs *WALRoundSummary
+		for j := range hs.Rounds {
+			if hs.Rounds[j].Round == round {
+				rs = &hs.Rounds[j]
+				break
+			}
+		}
+		if rs == nil {
+			hs.Rounds = append(hs.Rounds, WALRoundSummary{Round: round, Messages: make(map[string]int)})
+			rs = &hs.Rounds[len(hs.Rounds)-1]
+		}
+		rs.Messages[kind]++
+		return false, nil
+	})
+	return summaries, err
+}
+
+// VerifyWAL reads the whole WAL and checks that every message has a valid
+// checksum and can be decoded, that EndHeightMessages are written for
+// consecutive heights and that no message precedes the EndHeightMessage of the
+// previous height. It returns the number of messages read.
+func VerifyWAL(walFile string) (int, error) {
+	var (
+		count      int
+		lastEnd    int64
+		seenEndMsg bool
+	)
+	err := walkWAL(walFile, func(i int, msg *TimedWALMessage) (bool, error) {
+		count++
+		kind, height, _ := walMessageInfo(msg.Msg)
+		if kind == WALMsgEndHeight {
+			if seenEndMsg && height != lastEnd+1 {
+				return false, fmt.Errorf("message #%d: EndHeightMessage for height %d follows the one for height %d",
+					i, height, lastEnd)
+			}
+			lastEnd, seenEndMsg = height, true
+			return false, nil
+		}
+		if seenEndMsg && height > lastEnd+1 {
+			return false, fmt.Errorf("message #%d: %s for height %d found before EndHeightMessage for height %d",
+				i, kind, height, height-1)
+		}
+		return false, nil
+	})
+	return count, err
+}
+
+// TruncateWAL removes all messages written after the EndHeightMessage for the
+// given height. The original WAL files are moved into a backup directory next
+// to the WAL, whose path is returned. The node must not be running.
+func TruncateWAL(walFile string, height int64) (string, error) {
+	tmpFile := walFile + ".truncated"
+	out, err := os.OpenFile(tmpFile, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmpFile)
+
+	var (
+		enc   = NewWALEncoder(out)
+		found bool
+	)
+	err = walkWAL(walFile, func(_ int, msg *TimedWALMessage) (bool, error) {
+		if err := enc.Encode(msg); err != nil {
+			return false, fmt.Errorf("failed to encode msg: %w", err)
+		}
+		if m, ok := msg.Msg.(EndHeightMessage); ok && m.Height == height {
+			found = true
+			return true, nil
+		}
+		return false, nil
+	})
+	if err == nil {
+		err = out.Sync()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("%w: height %d", errWALEndHeightNotFound, height)
+	}
+
+	backupDir, err := backupWAL(walFile)
+	if err != nil {
+		return backupDir, err
+	}
+	return backupDir, os.Rename(tmpFile, walFile)
+}
+
+// RollbackWAL makes the WAL consistent with a state rolled back to the given
+// height, so that the node can replay the following heights. If the WAL
+// contains the end of the height, it is truncated after it, otherwise none of
+// its messages can be replayed and the whole WAL is moved into a backup
+// directory. It returns the backup directory, or an empty string if there is
+// no WAL.
+func RollbackWAL(walFile string, height int64) (string, error) {
+	if _, err := os.Stat(walFile); os.IsNotExist(err) {
+		return "", nil
+	}
+	backupDir, err := TruncateWAL(walFile, height)
+	if errors.Is(err, errWALEndHeightNotFound) {
+		return backupWAL(walFile)
+	}
+	return backupDir, err
+}
+
+// backupWAL moves all files of the WAL group into a new backup directory next
+// to the WAL and returns its path.
+func backupWAL(walFile string) (string, error) {
+	files, err := walGroupFiles(walFile)
+	if err != nil {
+		return "", err
+	}
+	backupDir := filepath.Join(filepath.Dir(walFile), fmt.Sprintf("backup-%d", time.Now().Unix()))
+	if err := os.Mkdir(backupDir, 0700); err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if err := os.Rename(f, filepath.Join(backupDir, filepath.Base(f))); err != nil {
+			return backupDir, err
+		}
+	}
+	return backupDir, nil
+}
+
+// ExportWAL writes the WAL messages matching the filter to w as JSON, one
+// message per line.
+func ExportWAL(walFile string, w io.Writer, filter WALExportFilter) error {
+	return walkWAL(walFile, func(_ int, msg *TimedWALMessage) (bool, error) {
+		kind, height, _ := walMessageInfo(msg.Msg)
+		if !filter.match(kind, height) {
+			return false, nil
+		}
+		bz, err := tmjson.Marshal(msg)
+		if err != nil {
+			return false, fmt.Errorf("failed to marshal msg: %w", err)
+		}
+		if _, err := w.Write(append(bz, '\n')); err != nil {
+			return false, err
+		}
+		return false, nil
+	})
+}
+
+// walkWAL decodes all messages of the WAL group with the given head file and
+// calls fn for each of them, until fn returns true or an error.
+func walkWAL(walFile string, fn func(i int, msg *TimedWALMessage) (bool, error)) error {
+	if _, err := os.Stat(walFile); err != nil {
+		return err
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	dec := NewWALDecoder(gr)
+	for i := 0; ; i++ {
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("message #%d: %w", i, err)
+		}
+		stop, err := fn(i, msg)
+		if err != nil || stop {
+			return err
+		}
+	}
+}
+
+// walGroupFiles returns the paths of all files of the WAL group, including
+// the head.
+func walGroupFiles(walFile string) ([]string, error) {
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return nil, err
+	}
+	info := group.ReadGroupInfo()
+	group.Close()
+
+	files := make([]string, 0, info.MaxIndex-info.MinIndex+1)
+	for i := info.MinIndex; i < info.MaxIndex; i++ {
+		files = append(files, fmt.Sprintf("%v.%03d", walFile, i))
+	}
+	return append(files, walFile), nil
+}
+
+// walMessageInfo returns the kind, height and round of the WAL message.
+func walMessageInfo(msg WALMessage) (kind string, height int64, round int32) {
+	switch m := msg.(type) {
+	case EndHeightMessage:
+		return WALMsgEndHeight, m.Height, 0
+	case timeoutInfo:
+		return WALMsgTimeout, m.Height, m.Round
+	case types.EventDataRoundState:
+		return WALMsgRoundState, m.Height, m.Round
+	case msgInfo:
+		switch cm := m.Msg.(type) {
+		case *ProposalMessage:
+			return WALMsgProposal, cm.Proposal.Height, cm.Proposal.Round
+		case *BlockPartMessage:
+			return WALMsgBlockPart, cm.Height, cm.Round
+		case *VoteMessage:
+			return WALMsgVote, cm.Vote.Height, cm.Vote.Round
+		case *CommitMessage:
+			return WALMsgCommit, cm.Commit.Height, cm.Commit.Round
+		}
+	}
+	return WALMsgOther, 0, 0
+}
@@ -0,0 +1,154 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/tendermint/tendermint/consensus/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+// writeTestWAL writes a WAL with the given messages and returns its path.
+func writeTestWAL(t *testing.T, msgs ...WALMessage) string {
+	walDir, err := ioutil.TempDir("", "wal")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(walDir) })
+
+	walFile := filepath.Join(walDir, "wal")
+	f, err := os.Create(walFile)
+	require.NoError(t, err)
+	defer f.Close()
+
+	enc := NewWALEncoder(f)
+	for _, msg := range msgs {
+		require.NoError(t, enc.Encode(&TimedWALMessage{Time: tmtime.Now(), Msg: msg}))
+	}
+	return walFile
+}
+
+func testWALMessages(heights int64) []WALMessage {
+	msgs := []WALMessage{EndHeightMessage{0}}
+	for h := int64(1); h <= heights; h++ {
+		msgs = append(msgs,
+			tmtypes.EventDataRoundState{Height: h, Round: 0, Step: cstypes.RoundStepPropose.String()},
+			timeoutInfo{Duration: time.Second, Height: h, Round: 0, Step: cstypes.RoundStepPropose},
+			timeoutInfo{Duration: time.Second, Height: h, Round: 1, Step: cstypes.RoundStepPropose},
+			EndHeightMessage{h},
+		)
+	}
+	return msgs
+}
+
+func TestInspectWAL(t *testing.T) {
+	walFile := writeTestWAL(t, testWALMessages(2)...)
+
+	summaries, err := InspectWAL(walFile)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+
+	assert.EqualValues(t, 0, summaries[0].Height)
+	assert.True(t, summaries[0].Ended)
+	assert.Empty(t, summaries[0].Rounds)
+
+	hs := summaries[1]
+	assert.EqualValues(t, 1, hs.Height)
+	assert.True(t, hs.Ended)
+	require.Len(t, hs.Rounds, 2)
+	assert.Equal(t, map[string]int{WALMsgRoundState: 1, WALMsgTimeout: 1}, hs.Rounds[0].Messages)
+	assert.EqualValues(t, 1, hs.Rounds[1].Round)
+	assert.Equal(t, map[string]int{WALMsgTimeout: 1}, hs.Rounds[1].Messages)
+}
+
+func TestVerifyWAL(t *testing.T) {
+	count, err := VerifyWAL(writeTestWAL(t, testWALMessages(3)...))
+	require.NoError(t, err)
+	assert.Equal(t, 13, count)
+
+	// EndHeightMessage for height 2 is missing
+	_, err = VerifyWAL(writeTestWAL(t, EndHeightMessage{1}, EndHeightMessage{3}))
+	assert.Error(t, err)
+
+	// message for height 3 before EndHeightMessage for height 2
+	_, err = VerifyWAL(writeTestWAL(t,
+		EndHeightMessage{1},
+		timeoutInfo{Duration: time.Second, Height: 3, Round: 0, Step: cstypes.RoundStepPropose},
+	))
+	assert.Error(t, err)
+
+	// corrupted data
+	walFile := writeTestWAL(t, testWALMessages(1)...)
+	bz, err := ioutil.ReadFile(walFile)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(walFile, bz, 0600))
+	_, err = VerifyWAL(walFile)
+	assert.True(t, IsDataCorruptionError(errors.Unwrap(err)), err)
+}
+
+func TestTruncateWAL(t *testing.T) {
+	walFile := writeTestWAL(t, testWALMessages(3)...)
+
+	_, err := TruncateWAL(walFile, 5)
+	require.Error(t, err)
+
+	backupDir, err := TruncateWAL(walFile, 2)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(backupDir, "wal"))
+
+	summaries, err := InspectWAL(walFile)
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	assert.EqualValues(t, 2, summaries[2].Height)
+	assert.True(t, summaries[2].Ended)
+
+	count, err := VerifyWAL(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, 9, count)
+}
+
+func TestExportWAL(t *testing.T) {
+	walFile := writeTestWAL(t, testWALMessages(3)...)
+
+	buf := new(bytes.Buffer)
+	err := ExportWAL(walFile, buf, WALExportFilter{MinHeight: 2, MaxHeight: 2, Kinds: []string{WALMsgTimeout}})
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	for _, line := range lines {
+		assert.Contains(t, line, "tendermint/wal/TimeoutInfo")
+		assert.Contains(t, line, `"height":"2"`)
+	}
+}
+
+func TestRollbackWAL(t *testing.T) {
+	// the end of the height is found, the WAL is truncated
+	walFile := writeTestWAL(t, testWALMessages(3)...)
+	_, err := RollbackWAL(walFile, 2)
+	require.NoError(t, err)
+	count, err := VerifyWAL(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, 9, count)
+
+	// the end of the height is not found, the WAL is moved away
+	walFile = writeTestWAL(t, testWALMessages(3)...)
+	backupDir, err := RollbackWAL(walFile, 5)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(backupDir, "wal"))
+	assert.NoFileExists(t, walFile)
+
+	// there is no WAL
+	backupDir, err = RollbackWAL(walFile, 5)
+	require.NoError(t, err)
+	assert.Empty(t, backupDir)
+}
@@ -15,6 +15,7 @@ import (
 	clist "github.com/tendermint/tendermint/libs/clist"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proxy"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 )
@@ -22,8 +23,14 @@ import (
 const (
 	baseKeyCommitted = byte(0x00)
 	baseKeyPending   = byte(0x01)
+	baseKeyMigration = byte(0x02)
 )
 
+// keyCommittedHeightsMigrated marks that the committed evidence stored before
+// the pool recorded the heights of the blocks it was committed in has been
+// migrated.
+var keyCommittedHeightsMigrated = []byte{baseKeyMigration, 0x01}
+
 // Pool maintains a pool of valid evidence to be broadcasted and committed
 type Pool struct {
 	logger log.Logger
@@ -36,6 +43,8 @@ type Pool struct {
 	stateDB sm.Store
 	// needed to load headers and commits to verify evidence
 	blockStore BlockStore
+	// needed to verify the chain locks of InvalidChainLockEvidence
+	queryApp proxy.AppConnQuery
 
 	mtx sync.Mutex
 	// latest state
@@ -44,6 +53,10 @@ type Pool struct {
 	// before being flushed to the pool. This prevents broadcasting and proposing of
 	// evidence before the height with which the evidence happened is finished.
 	consensusBuffer []duplicateVoteSet
+	// invalid chain locks and conflicting proposals from consensus are
+	// buffered the same way
+	chainLockBuffer []invalidChainLock
+	proposalBuffer  []duplicateProposalSet
 
 	pruningHeight int64
 	pruningTime   time.Time
@@ -80,9 +93,96 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore) (*Pool,
 		pool.evidenceList.PushBack(ev)
 	}
 
+	if err := pool.migrateCommittedHeights(); err != nil {
+		return nil, fmt.Errorf("migrating committed evidence: %w", err)
+	}
+
 	return pool, nil
 }
 
+// migrateCommittedHeights replaces the values of the committed evidence, which
+// was stored with its infraction height before the pool recorded the heights
+// of the blocks it was committed in, with the heights of those blocks. It
+// walks the blocks from the lowest infraction height up once, loading only the
+// blocks with evidence. The evidence of pruned blocks keeps its infraction
+// height, so CommittedEvidence doesn't find it.
+func (evpool *Pool) migrateCommittedHeights() error {
+	migrated, err := evpool.evidenceStore.Has(keyCommittedHeightsMigrated)
+	if err != nil || migrated {
+		return err
+	}
+
+	legacy, minHeight, err := evpool.legacyCommittedEvidence()
+	if err != nil {
+		return err
+	}
+
+	batch := evpool.evidenceStore.NewBatch()
+	defer batch.Close()
+
+	emptyEvidenceHash := types.EvidenceList{}.Hash()
+	for height := minHeight + 1; len(legacy) > 0 && height <= evpool.blockStore.Height(); height++ {
+		meta := evpool.blockStore.LoadBlockMeta(height)
+		if meta == nil || bytes.Equal(meta.Header.EvidenceHash, emptyEvidenceHash) {
+			continue
+		}
+		block := evpool.blockStore.LoadBlock(height)
+		if block == nil {
+			continue
+		}
+		for _, ev := range block.Evidence.Evidence {
+			key := keyCommitted(ev)
+			if _, ok := legacy[string(key)]; !ok {
+				continue
+			}
+			h, err := proto.Marshal(&gogotypes.Int64Value{Value: height})
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(key, h); err != nil {
+				return err
+			}
+			delete(legacy, string(key))
+		}
+	}
+
+	if err := batch.Set(keyCommittedHeightsMigrated, []byte{1}); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// legacyCommittedEvidence returns the keys of the committed evidence, which is
+// stored with its infraction height, i.e. the height of its key, and the
+// lowest of these heights.
+func (evpool *Pool) legacyCommittedEvidence() (map[string]struct{}, int64, error) {
+	iter, err := dbm.IteratePrefix(evpool.evidenceStore, []byte{baseKeyCommitted})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer iter.Close()
+
+	var (
+		legacy    = make(map[string]struct{})
+		minHeight int64
+	)
+	for ; iter.Valid(); iter.Next() {
+		var h gogotypes.Int64Value
+		if err := proto.Unmarshal(iter.Value(), &h); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling committed evidence height: %w", err)
+		}
+		// evidence is always committed in a block above its infraction height
+		if !bytes.HasPrefix(iter.Key()[1:], []byte(bE(h.Value)+"/")) {
+			continue
+		}
+		if len(legacy) == 0 {
+			minHeight = h.Value
+		}
+		legacy[string(iter.Key())] = struct{}{}
+	}
+	return legacy, minHeight, iter.Error()
+}
+
 // PendingEvidence is used primarily as part of block proposal and returns up to maxNum of uncommitted evidence.
 func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	if evpool.Size() == 0 {
@@ -95,6 +195,99 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	return evidence, size
 }
 
+// CommittedEvidence is evidence, which was committed on chain.
+type CommittedEvidence struct {
+	Evidence types.Evidence
+	// height of the block the evidence was committed in
+	Height int64
+}
+
+// MaxCommittedEvidenceHeights is the maximum number of infraction heights
+// CommittedEvidence searches at once.
+const MaxCommittedEvidenceHeights = 100000
+
+// CommittedEvidence returns the committed evidence of the infraction heights
+// (the heights of the evidence, not of the blocks it was committed in) from
+// minInfractionHeight to maxInfractionHeight (inclusive), from oldest to
+// newest, which match (nil matches any evidence). It skips the first skip
+// matching evidence and returns at most limit of the rest, along with the
+// total number of matching evidence. The range can't span more than
+// MaxCommittedEvidenceHeights heights.
+//
+// The committed evidence is keyed by its infraction height, and its value is
+// the height of the block it was committed in. The evidence is loaded from
+// that block, so the evidence of pruned blocks is skipped. Without match,
+// only the evidence of the returned page is loaded.
+func (evpool *Pool) CommittedEvidence(
+	minInfractionHeight, maxInfractionHeight int64,
+	match func(types.Evidence) bool,
+	skip, limit int,
+) ([]CommittedEvidence, int, error) {
+	if maxInfractionHeight-minInfractionHeight >= MaxCommittedEvidenceHeights {
+		return nil, 0, fmt.Errorf("can't search more than %d heights at once, got %d..%d",
+			MaxCommittedEvidenceHeights, minInfractionHeight, maxInfractionHeight)
+	}
+
+	iter, err := evpool.evidenceStore.Iterator(
+		append([]byte{baseKeyCommitted}, bE(minInfractionHeight)...),
+		append([]byte{baseKeyCommitted}, bE(maxInfractionHeight+1)...),
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("database error: %v", err)
+	}
+	defer iter.Close()
+
+	var (
+		evidence []CommittedEvidence
+		total    int
+		// the last block the evidence was loaded from
+		block *types.Block
+	)
+	loadEvidence := func(height int64, key []byte) types.Evidence {
+		if block == nil || block.Height != height {
+			block = evpool.blockStore.LoadBlock(height)
+		}
+		if block == nil {
+			return nil
+		}
+		for _, ev := range block.Evidence.Evidence {
+			if bytes.Equal(keyCommitted(ev), key) {
+				return ev
+			}
+		}
+		return nil
+	}
+
+	for ; iter.Valid(); iter.Next() {
+		var h gogotypes.Int64Value
+		if err := proto.Unmarshal(iter.Value(), &h); err != nil {
+			return nil, 0, fmt.Errorf("unmarshaling committed evidence height: %w", err)
+		}
+		inPage := total >= skip && len(evidence) < limit
+
+		if match == nil && !inPage {
+			// count the evidence by its key, if its block isn't pruned
+			if evpool.blockStore.LoadBlockMeta(h.Value) != nil {
+				total++
+			}
+			continue
+		}
+
+		ev := loadEvidence(h.Value, iter.Key())
+		if ev == nil || (match != nil && !match(ev)) {
+			continue
+		}
+		if inPage {
+			evidence = append(evidence, CommittedEvidence{Evidence: ev, Height: h.Value})
+		}
+		total++
+	}
+	if err := iter.Error(); err != nil {
+		return nil, 0, err
+	}
+	return evidence, total, nil
+}
+
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
 // 1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
@@ -121,7 +314,7 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.updateState(state)
 
 	// move committed evidence out from the pending pool and into the committed pool
-	evpool.markEvidenceAsCommitted(ev)
+	evpool.markEvidenceAsCommitted(ev, state.LastBlockHeight)
 
 	// prune pending evidence when it has expired. This also updates when the next evidence will expire
 	if evpool.Size() > 0 && state.LastBlockHeight > evpool.pruningHeight &&
@@ -185,6 +378,50 @@ func (evpool *Pool) ReportConflictingVotes(voteA, voteB *types.Vote) {
 	})
 }
 
+// ReportInvalidChainLock takes a signed proposal, which either regresses the
+// core chain locked height, or is for a block with an invalid chain lock, and
+// forms invalid chain lock evidence, adding it eventually to the evidence pool.
+// The block and its parts must be given in the latter case only.
+//
+// Like with conflicting votes, the evidence is formed once consensus at the
+// height of the proposal has been reached and `Update()` with the new state
+// called.
+//
+// The proposal is not verified.
+func (evpool *Pool) ReportInvalidChainLock(proposal *types.Proposal, block *types.Block, blockParts *types.PartSet) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.chainLockBuffer = append(evpool.chainLockBuffer, invalidChainLock{
+		Proposal:   proposal,
+		Block:      block,
+		BlockParts: blockParts,
+	})
+}
+
+// ReportConflictingProposals takes two conflicting proposals, signed by the
+// proposer of their height and round, and forms duplicate proposal evidence,
+// adding it eventually to the evidence pool.
+//
+// Like with conflicting votes, the evidence is formed once consensus at the
+// height of the proposals has been reached and `Update()` with the new state
+// called.
+//
+// Proposals are not verified.
+func (evpool *Pool) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.proposalBuffer = append(evpool.proposalBuffer, duplicateProposalSet{
+		ProposalA: proposalA,
+		ProposalB: proposalB,
+	})
+}
+
+// SetProxyAppQuery sets the connection to the application, which is used to
+// verify the signatures of chain locks.
+func (evpool *Pool) SetProxyAppQuery(queryApp proxy.AppConnQuery) {
+	evpool.queryApp = queryApp
+}
+
 // CheckEvidence takes an array of evidence from a block and verifies all the evidence there.
 // If it has already verified the evidence then it jumps to the next one. It ensures that no
 // evidence has already been committed or is being proposed twice. It also adds any
@@ -325,9 +562,9 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 	}
 }
 
-// markEvidenceAsCommitted processes all the evidence in the block, marking it as
-// committed and removing it from the pending database.
-func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
+// markEvidenceAsCommitted processes all the evidence in the block at the height,
+// marking it as committed and removing it from the pending database.
+func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height int64) {
 	blockEvidenceMap := make(map[string]struct{}, len(evidence))
 	for _, ev := range evidence {
 		if evpool.isPending(ev) {
@@ -339,7 +576,7 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 		// we only need to record the height that it was saved at.
 		key := keyCommitted(ev)
 
-		h := gogotypes.Int64Value{Value: ev.Height()}
+		h := gogotypes.Int64Value{Value: height}
 		evBytes, err := proto.Marshal(&h)
 		if err != nil {
 			evpool.logger.Error("failed to marshal committed evidence", "err", err, "key(height/hash)", key)
@@ -454,8 +691,9 @@ func (evpool *Pool) updateState(state sm.State) {
 	evpool.state = state
 }
 
-// processConsensusBuffer converts all the duplicate votes witnessed from consensus
-// into DuplicateVoteEvidence. It sets the evidence timestamp to the block height
+// processConsensusBuffer converts all the duplicate votes, invalid chain locks
+// and duplicate proposals witnessed from consensus into DuplicateVoteEvidence,
+// InvalidChainLockEvidence and DuplicateProposalEvidence. It sets the evidence timestamp to the block height
 // from the most recently committed block.
 // Evidence is then added to the pool so as to be ready to be broadcasted and proposed.
 func (evpool *Pool) processConsensusBuffer(state sm.State) {
@@ -505,29 +743,105 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 			continue
 		}
 
-		// check if we already have this evidence
-		if evpool.isPending(dve) {
-			evpool.logger.Debug("evidence already pending; ignoring", "evidence", dve)
+		evpool.addConsensusEvidence(dve)
+	}
+	// reset consensus buffer
+	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+
+	for _, report := range evpool.chainLockBuffer {
+		valSet, blockTime, err := evpool.consensusEvidenceContext(state, report.Proposal.Height)
+		if err != nil {
+			evpool.logger.Error("failed to form invalid chain lock evidence", "proposal", report.Proposal, "err", err)
+			continue
+		}
+
+		icle := types.NewInvalidChainLockEvidence(report.Proposal, report.Block, report.BlockParts, blockTime, valSet)
+		if icle == nil {
+			evpool.logger.Error("failed to form invalid chain lock evidence", "proposal", report.Proposal)
+			continue
+		}
+		// unlike conflicting votes, which are verified by consensus, the proposal
+		// may be invalid for other reasons, or the app may deem a valid chain lock
+		// invalid, so the evidence is verified, like the evidence from peers
+		if err := icle.ValidateBasic(); err != nil {
+			evpool.logger.Error("formed invalid chain lock evidence is invalid", "evidence", icle, "err", err)
+			continue
+		}
+		if err := evpool.verifyWithState(icle, state); err != nil {
+			evpool.logger.Error("formed invalid chain lock evidence is invalid", "evidence", icle, "err", err)
 			continue
 		}
 
-		// check that the evidence is not already committed on chain
-		if evpool.isCommitted(dve) {
-			evpool.logger.Debug("evidence already committed; ignoring", "evidence", dve)
+		evpool.addConsensusEvidence(icle)
+	}
+	evpool.chainLockBuffer = nil
+
+	for _, proposalSet := range evpool.proposalBuffer {
+		valSet, blockTime, err := evpool.consensusEvidenceContext(state, proposalSet.ProposalA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to form duplicate proposal evidence", "proposal", proposalSet.ProposalA, "err", err)
 			continue
 		}
 
-		if err := evpool.addPendingEvidence(dve); err != nil {
-			evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
+		dpe := types.NewDuplicateProposalEvidence(proposalSet.ProposalA, proposalSet.ProposalB, blockTime, valSet)
+		if dpe == nil {
+			evpool.logger.Error("failed to form duplicate proposal evidence", "proposal", proposalSet.ProposalA)
 			continue
 		}
 
-		evpool.evidenceList.PushBack(dve)
+		evpool.addConsensusEvidence(dpe)
+	}
+	evpool.proposalBuffer = nil
+}
 
-		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+// consensusEvidenceContext returns the validator set and the block time of the
+// height of evidence from consensus, which must not be above the height of the
+// state.
+func (evpool *Pool) consensusEvidenceContext(state sm.State, height int64) (*types.ValidatorSet, time.Time, error) {
+	switch {
+	case height == state.LastBlockHeight:
+		return state.LastValidators, state.LastBlockTime, nil
+
+	case height < state.LastBlockHeight:
+		valSet, err := evpool.stateDB.LoadValidators(height)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("failed to load validator set at height %d: %w", height, err)
+		}
+		blockMeta := evpool.blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return nil, time.Time{}, fmt.Errorf("failed to load block time at height %d", height)
+		}
+		return valSet, blockMeta.Header.Time, nil
+
+	default:
+		return nil, time.Time{}, fmt.Errorf("evidence height %d is greater than the last block height %d",
+			height, state.LastBlockHeight)
 	}
-	// reset consensus buffer
-	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+}
+
+// addConsensusEvidence adds the evidence formed from consensus to the pool,
+// unless it is already pending or committed.
+func (evpool *Pool) addConsensusEvidence(ev types.Evidence) {
+	// check if we already have this evidence
+	if evpool.isPending(ev) {
+		evpool.logger.Debug("evidence already pending; ignoring", "evidence", ev)
+		return
+	}
+
+	// check that the evidence is not already committed on chain
+	if evpool.isCommitted(ev) {
+		evpool.logger.Debug("evidence already committed; ignoring", "evidence", ev)
+		return
+	}
+
+	if err := evpool.addPendingEvidence(ev); err != nil {
+		evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
+		return
+	}
+
+	evpool.evidenceList.PushBack(ev)
+
+	evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", ev)
 }
 
 type duplicateVoteSet struct {
@@ -535,6 +849,17 @@ type duplicateVoteSet struct {
 	VoteB *types.Vote
 }
 
+type invalidChainLock struct {
+	Proposal   *types.Proposal
+	Block      *types.Block
+	BlockParts *types.PartSet
+}
+
+type duplicateProposalSet struct {
+	ProposalA *types.Proposal
+	ProposalB *types.Proposal
+}
+
 func bytesToEv(evBytes []byte) (types.Evidence, error) {
 	var evpb tmproto.Evidence
 	err := evpb.Unmarshal(evBytes)
@@ -2,11 +2,15 @@
 package evidence_test
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -251,6 +255,36 @@ func TestReportConflictingVotes(t *testing.T) {
 	require.NotNil(t, next)
 }
 
+func TestReportConflictingProposals(t *testing.T) {
+	var height int64 = 10
+
+	pool, pv := defaultTestPool(height)
+
+	quorumHash, err := pv.GetFirstQuorumHash()
+	require.NoError(t, err)
+	val := pv.ExtractIntoValidator(quorumHash)
+	valSet := types.NewValidatorSet([]*types.Validator{val}, val.PubKey, btcjson.LLMQType_5_60, quorumHash, true)
+	ev := types.NewMockDuplicateProposalEvidence(height+1, defaultEvidenceTime, pv, valSet, evidenceChainID)
+
+	pool.ReportConflictingProposals(ev.ProposalA, ev.ProposalB)
+	// the same evidence is formed from the proposals in any order
+	pool.ReportConflictingProposals(ev.ProposalB, ev.ProposalA)
+
+	// evidence from consensus should not be added immediately but reside in the consensus buffer
+	evList, _ := pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Empty(t, evList)
+
+	// move to next height and update state and evidence pool
+	state := pool.State()
+	state.LastBlockHeight++
+	state.LastBlockTime = ev.Time()
+	state.LastValidators = valSet
+	pool.Update(state, []types.Evidence{})
+
+	evList, _ = pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Equal(t, []types.Evidence{ev}, evList)
+}
+
 func TestEvidencePoolUpdate(t *testing.T) {
 	height := int64(21)
 	pool, val := defaultTestPool(height)
@@ -287,6 +321,121 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	}
 }
 
+// committedVoteEvidence returns duplicate vote evidence of the infraction
+// height, which isn't verified when it is committed.
+func committedVoteEvidence(height int64, proTxHash crypto.ProTxHash) *types.DuplicateVoteEvidence {
+	return &types.DuplicateVoteEvidence{
+		VoteA: &types.Vote{Type: tmproto.PrecommitType, Height: height, ValidatorProTxHash: proTxHash,
+			BlockID: makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))},
+		VoteB: &types.Vote{Type: tmproto.PrecommitType, Height: height, ValidatorProTxHash: proTxHash,
+			BlockID: makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))},
+		Timestamp: defaultEvidenceTime,
+	}
+}
+
+func TestCommittedEvidence(t *testing.T) {
+	height := int64(21)
+	state := createState(height, nil)
+	stateStore := &smmocks.Store{}
+	stateStore.On("Load").Return(state, nil)
+	blockStore := &mocks.BlockStore{}
+	pool, err := evidence.NewPool(dbm.NewMemDB(), stateStore, blockStore)
+	require.NoError(t, err)
+
+	proTxHash := crypto.RandProTxHash()
+	ev := committedVoteEvidence(height-1, proTxHash)
+	otherEv := committedVoteEvidence(height-1, crypto.RandProTxHash())
+	block := types.MakeBlock(height+1, 0, nil, []types.Tx{}, makeCommit(height, nil, proTxHash),
+		[]types.Evidence{ev, otherEv}, 0)
+	blockStore.On("LoadBlock", height+1).Return(block)
+	blockStore.On("LoadBlockMeta", height+1).Return(&types.BlockMeta{Header: block.Header})
+
+	state.LastBlockHeight = height + 1
+	pool.Update(state, block.Evidence.Evidence)
+
+	// the evidence is listed with the height of the block it was committed in,
+	// ordered by the keys of the evidence
+	all, total, err := pool.CommittedEvidence(1, height, nil, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.ElementsMatch(t, []evidence.CommittedEvidence{
+		{Evidence: ev, Height: height + 1},
+		{Evidence: otherEv, Height: height + 1},
+	}, all)
+
+	// the evidence is paginated
+	page, total, err := pool.CommittedEvidence(1, height, nil, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, all[1:], page)
+	page, total, err = pool.CommittedEvidence(1, height, nil, 0, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, all[:1], page)
+
+	// and filtered
+	page, total, err = pool.CommittedEvidence(1, height, func(e types.Evidence) bool {
+		return bytes.Equal(e.Hash(), ev.Hash())
+	}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []evidence.CommittedEvidence{{Evidence: ev, Height: height + 1}}, page)
+
+	// the heights are the heights of the evidence
+	page, total, err = pool.CommittedEvidence(height, height+1, nil, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, page)
+
+	// the range is capped
+	_, _, err = pool.CommittedEvidence(1, evidence.MaxCommittedEvidenceHeights+1, nil, 0, 10)
+	assert.Error(t, err)
+}
+
+// The evidence committed before the pool recorded the heights of the blocks
+// is migrated, when the pool is created.
+func TestCommittedEvidenceMigration(t *testing.T) {
+	height := int64(21)
+	state := createState(height+5, nil)
+	stateStore := &smmocks.Store{}
+	stateStore.On("Load").Return(state, nil)
+
+	proTxHash := crypto.RandProTxHash()
+	ev := committedVoteEvidence(height-1, proTxHash)
+	block := types.MakeBlock(height+2, 0, nil, []types.Tx{}, makeCommit(height+1, nil, proTxHash),
+		[]types.Evidence{ev}, 0)
+	emptyBlock := types.MakeBlock(height+1, 0, nil, []types.Tx{}, makeCommit(height, nil, proTxHash), nil, 0)
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Height").Return(height + 5)
+	blockStore.On("LoadBlockMeta", height).Return(nil)
+	blockStore.On("LoadBlockMeta", height+1).Return(&types.BlockMeta{Header: emptyBlock.Header})
+	blockStore.On("LoadBlockMeta", height+2).Return(&types.BlockMeta{Header: block.Header})
+	blockStore.On("LoadBlock", height+2).Return(block)
+
+	// the entry of the evidence holds its infraction height
+	evidenceDB := dbm.NewMemDB()
+	key := append([]byte{0x00}, []byte(fmt.Sprintf("%0.16X/%X", ev.Height(), ev.Hash()))...)
+	value, err := proto.Marshal(&gogotypes.Int64Value{Value: ev.Height()})
+	require.NoError(t, err)
+	require.NoError(t, evidenceDB.Set(key, value))
+
+	pool, err := evidence.NewPool(evidenceDB, stateStore, blockStore)
+	require.NoError(t, err)
+	committed, total, err := pool.CommittedEvidence(1, height, nil, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []evidence.CommittedEvidence{{Evidence: ev, Height: height + 2}}, committed)
+	// the walk stops at the block of the last evidence, and the empty block
+	// isn't loaded
+	blockStore.AssertNotCalled(t, "LoadBlockMeta", height+3)
+	blockStore.AssertNotCalled(t, "LoadBlock", height+1)
+
+	// the migration runs once
+	_, err = evidence.NewPool(evidenceDB, stateStore, &mocks.BlockStore{})
+	require.NoError(t, err)
+}
+
 func TestVerifyPendingEvidencePasses(t *testing.T) {
 	var height int64 = 1
 	pool, val := defaultTestPool(height)
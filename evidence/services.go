@@ -7,6 +7,7 @@ import (
 //go:generate mockery --case underscore --name BlockStore
 
 type BlockStore interface {
+	LoadBlock(height int64) *types.Block
 	LoadBlockMeta(height int64) *types.BlockMeta
 	LoadBlockCommit(height int64) *types.Commit
 	Height() int64
@@ -2,8 +2,10 @@ package evidence
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
+	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -14,8 +16,12 @@ import (
 // - it is internally consistent with state
 // - it was properly signed by the alleged equivocator and meets the individual evidence verification requirements
 func (evpool *Pool) verify(evidence types.Evidence) error {
+	return evpool.verifyWithState(evidence, evpool.State())
+}
+
+// verifyWithState verifies the evidence against the given state, see verify.
+func (evpool *Pool) verifyWithState(evidence types.Evidence, state sm.State) error {
 	var (
-		state          = evpool.State()
 		height         = state.LastBlockHeight
 		evidenceParams = state.ConsensusParams.Evidence
 		ageNumBlocks   = height - evidence.Height()
@@ -52,6 +58,25 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 			return err
 		}
 		return VerifyDuplicateVote(ev, state.ChainID, valSet)
+	case *types.InvalidChainLockEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+		// the core chain locked height of the state the proposal was made in is
+		// the one of the previous block
+		prevBlockMeta := evpool.blockStore.LoadBlockMeta(evidence.Height() - 1)
+		if prevBlockMeta == nil {
+			return fmt.Errorf("don't have header #%d", evidence.Height()-1)
+		}
+		return VerifyInvalidChainLock(ev, state.ChainID, valSet, prevBlockMeta.Header.CoreChainLockedHeight,
+			evpool.verifyChainLockSignature)
+	case *types.DuplicateProposalEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+		return VerifyDuplicateProposal(ev, state.ChainID, valSet)
 	default:
 		return fmt.Errorf("unrecognized evidence type: %T", evidence)
 	}
@@ -129,6 +154,134 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	return nil
 }
 
+// VerifyDuplicateProposal verifies DuplicateProposalEvidence against the state
+// of full node. This involves the following checks:
+//      - the proposer is the proposer of the height and round of the proposals
+//      - the height and round of the proposals must be the same
+//      - the sign bytes of the proposals must be different, i.e. their block
+//        ID's, POL rounds or timestamps
+//      - The signatures must both be valid
+func VerifyDuplicateProposal(e *types.DuplicateProposalEvidence, chainID string, valSet *types.ValidatorSet) error {
+	// H/R must be the same
+	if e.ProposalA.Height != e.ProposalB.Height || e.ProposalA.Round != e.ProposalB.Round {
+		return fmt.Errorf("h/r does not match: %d/%d vs %d/%d",
+			e.ProposalA.Height, e.ProposalA.Round, e.ProposalB.Height, e.ProposalB.Round)
+	}
+
+	// Sign bytes must be different
+	if bytes.Equal(
+		types.ProposalBlockSignBytes(chainID, e.ProposalA.ToProto()),
+		types.ProposalBlockSignBytes(chainID, e.ProposalB.ToProto()),
+	) {
+		return errors.New("proposals are the same - not a real duplicate proposal")
+	}
+
+	proposer := valSet.CopyIncrementProposerPriority(e.ProposalA.Round).GetProposer()
+	if proposer == nil || !bytes.Equal(proposer.ProTxHash, e.ProposerProTxHash) {
+		return fmt.Errorf("proTxHash %X was not the proposer at height %d and round %d",
+			e.ProposerProTxHash, e.Height(), e.ProposalA.Round)
+	}
+	if proposer.PubKey == nil {
+		return fmt.Errorf("public key of the proposer %X is unknown", e.ProposerProTxHash)
+	}
+
+	// validator voting power and total voting power must match
+	if proposer.VotingPower != e.ValidatorPower {
+		return fmt.Errorf("validator power from evidence and our validator set does not match (%d != %d)",
+			e.ValidatorPower, proposer.VotingPower)
+	}
+	if valSet.TotalVotingPower() != e.TotalVotingPower {
+		return fmt.Errorf("total voting power from the evidence and our validator set does not match (%d != %d)",
+			e.TotalVotingPower, valSet.TotalVotingPower())
+	}
+
+	// Signatures must be valid
+	signIDA := types.ProposalBlockSignID(chainID, e.ProposalA.ToProto(), valSet.QuorumType, valSet.QuorumHash)
+	if !proposer.PubKey.VerifySignatureDigest(signIDA, e.ProposalA.Signature) {
+		return errors.New("verifying ProposalA: invalid proposal signature")
+	}
+	signIDB := types.ProposalBlockSignID(chainID, e.ProposalB.ToProto(), valSet.QuorumType, valSet.QuorumHash)
+	if !proposer.PubKey.VerifySignatureDigest(signIDB, e.ProposalB.Signature) {
+		return errors.New("verifying ProposalB: invalid proposal signature")
+	}
+
+	return nil
+}
+
+// VerifyInvalidChainLock verifies InvalidChainLockEvidence against the state of
+// full node. This involves the following checks:
+//      - the proposer is the proposer of the height and round of the proposal
+//      - the signature of the proposal is valid
+//      - either the proposal regresses the core chain locked height of the
+//        previous block, or the chain lock of the proposed block is at another
+//        height than the block, doesn't advance the core chain locked height,
+//        or its signature is deemed invalid by verifyChainLockSignature
+func VerifyInvalidChainLock(
+	e *types.InvalidChainLockEvidence,
+	chainID string,
+	valSet *types.ValidatorSet,
+	prevCoreChainLockedHeight uint32,
+	verifyChainLockSignature func(*types.CoreChainLock) error,
+) error {
+	proposer := valSet.CopyIncrementProposerPriority(e.Proposal.Round).GetProposer()
+	if proposer == nil || !bytes.Equal(proposer.ProTxHash, e.ProposerProTxHash) {
+		return fmt.Errorf("proTxHash %X was not the proposer at height %d and round %d",
+			e.ProposerProTxHash, e.Height(), e.Proposal.Round)
+	}
+	if proposer.PubKey == nil {
+		return fmt.Errorf("public key of the proposer %X is unknown", e.ProposerProTxHash)
+	}
+
+	// validator voting power and total voting power must match
+	if proposer.VotingPower != e.ValidatorPower {
+		return fmt.Errorf("validator power from evidence and our validator set does not match (%d != %d)",
+			e.ValidatorPower, proposer.VotingPower)
+	}
+	if valSet.TotalVotingPower() != e.TotalVotingPower {
+		return fmt.Errorf("total voting power from the evidence and our validator set does not match (%d != %d)",
+			e.TotalVotingPower, valSet.TotalVotingPower())
+	}
+
+	// Signature must be valid
+	signID := types.ProposalBlockSignID(chainID, e.Proposal.ToProto(), valSet.QuorumType, valSet.QuorumHash)
+	if !proposer.PubKey.VerifySignatureDigest(signID, e.Proposal.Signature) {
+		return errors.New("invalid proposal signature")
+	}
+
+	if e.CoreChainLock == nil {
+		if e.Proposal.CoreChainLockedHeight >= prevCoreChainLockedHeight {
+			return fmt.Errorf("proposal core chain locked height %d does not regress previous height %d",
+				e.Proposal.CoreChainLockedHeight, prevCoreChainLockedHeight)
+		}
+		return nil
+	}
+
+	// ValidateBasic ensures the chain lock is of the proposed block
+	if e.CoreChainLock.CoreBlockHeight != e.Header.CoreChainLockedHeight ||
+		e.Header.CoreChainLockedHeight <= prevCoreChainLockedHeight {
+		return nil
+	}
+	err := verifyChainLockSignature(e.CoreChainLock)
+	var clErr sm.ErrInvalidChainLock
+	switch {
+	case errors.As(err, &clErr):
+		return nil
+	case err != nil:
+		return fmt.Errorf("verifying chain lock: %w", err)
+	default:
+		return errors.New("chain lock is valid")
+	}
+}
+
+// verifyChainLockSignature verifies the signature of the chain lock by
+// querying the app.
+func (evpool *Pool) verifyChainLockSignature(chainLock *types.CoreChainLock) error {
+	if evpool.queryApp == nil {
+		return errors.New("no connection to the app to verify chain locks")
+	}
+	return sm.VerifyChainLockSignature(evpool.queryApp, chainLock)
+}
+
 /*
 func getSignedHeader(blockStore BlockStore, height int64) (*types.SignedHeader, error) {
 	blockMeta := blockStore.LoadBlockMeta(height)
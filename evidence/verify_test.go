@@ -1,6 +1,7 @@
 package evidence_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -143,6 +144,112 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestVerifyDuplicateProposalEvidence(t *testing.T) {
+	quorumHash := crypto.RandQuorumHash()
+	val := types.NewMockPVForQuorum(quorumHash)
+	val2 := types.NewMockPVForQuorum(quorumHash)
+	quorumType := btcjson.LLMQType_5_60
+	pubKey, err := val.GetPubKey(quorumHash)
+	require.NoError(t, err)
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(quorumHash)},
+		pubKey, quorumType, quorumHash, true)
+	const chainID = "mychain"
+
+	ev := types.NewMockDuplicateProposalEvidence(10, defaultEvidenceTime, val, valSet, chainID)
+	require.NoError(t, ev.ValidateBasic())
+	assert.NoError(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet))
+	// the proposals must be signed for the chain
+	assert.Error(t, evidence.VerifyDuplicateProposal(ev, "mychain2", valSet))
+
+	// proposals of the same block conflict, if they are signed with other POL
+	// rounds
+	sameBlock := *ev.ProposalA
+	sameBlock.POLRound = 0
+	p := sameBlock.ToProto()
+	_, err = val.SignProposal(chainID, quorumType, quorumHash, p)
+	require.NoError(t, err)
+	sameBlock.Signature = p.Signature
+	sameBlockEv := types.NewDuplicateProposalEvidence(ev.ProposalA, &sameBlock, defaultEvidenceTime, valSet)
+	require.NoError(t, sameBlockEv.ValidateBasic())
+	assert.NoError(t, evidence.VerifyDuplicateProposal(sameBlockEv, chainID, valSet))
+
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*types.DuplicateProposalEvidence)
+	}{
+		{"Same proposal", func(ev *types.DuplicateProposalEvidence) {
+			ev.ProposalB = ev.ProposalA
+		}},
+		{"Other round", func(ev *types.DuplicateProposalEvidence) { ev.ProposalB.Round = 1 }},
+		{"Other proposer", func(ev *types.DuplicateProposalEvidence) {
+			ev.ProposerProTxHash = crypto.RandProTxHash()
+		}},
+		{"Signed by other validator", func(ev *types.DuplicateProposalEvidence) {
+			other := types.NewMockDuplicateProposalEvidence(10, defaultEvidenceTime, val2, valSet, chainID)
+			ev.ProposalB.Signature = other.ProposalB.Signature
+		}},
+		{"Other validator power", func(ev *types.DuplicateProposalEvidence) { ev.ValidatorPower++ }},
+		{"Other total voting power", func(ev *types.DuplicateProposalEvidence) { ev.TotalVotingPower++ }},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			ev := types.NewMockDuplicateProposalEvidence(10, defaultEvidenceTime, val, valSet, chainID)
+			tc.malleateEvidence(ev)
+			assert.Error(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet))
+		})
+	}
+}
+
+func TestVerifyInvalidChainLockEvidence(t *testing.T) {
+	quorumHash := crypto.RandQuorumHash()
+	val := types.NewMockPVForQuorum(quorumHash)
+	val2 := types.NewMockPVForQuorum(quorumHash)
+	quorumType := btcjson.LLMQType_5_60
+	pubKey, err := val.GetPubKey(quorumHash)
+	require.NoError(t, err)
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(quorumHash)},
+		pubKey, quorumType, quorumHash, true)
+	const chainID = "mychain"
+
+	invalidChainLock := func(*types.CoreChainLock) error {
+		return sm.ErrInvalidChainLock{Reason: errors.New("invalid signature")}
+	}
+	validChainLock := func(*types.CoreChainLock) error { return nil }
+	unknownChainLock := func(*types.CoreChainLock) error { return errors.New("app is down") }
+
+	chainLock := types.NewMockChainLock(1000)
+	ev := types.NewMockInvalidChainLockEvidence(10, defaultEvidenceTime, &chainLock, val, valSet, chainID)
+	require.NoError(t, ev.ValidateBasic())
+
+	// the chain lock is valid evidence only if the app rejects it
+	assert.NoError(t, evidence.VerifyInvalidChainLock(ev, chainID, valSet, 999, invalidChainLock))
+	assert.Error(t, evidence.VerifyInvalidChainLock(ev, chainID, valSet, 999, validChainLock))
+	assert.Error(t, evidence.VerifyInvalidChainLock(ev, chainID, valSet, 999, unknownChainLock))
+	// a chain lock, which doesn't increase the core chain locked height, is invalid anyway
+	assert.NoError(t, evidence.VerifyInvalidChainLock(ev, chainID, valSet, 1000, validChainLock))
+	// the proposal must be signed for the chain
+	assert.Error(t, evidence.VerifyInvalidChainLock(ev, "mychain2", valSet, 999, invalidChainLock))
+
+	// by the proposer
+	badEv := types.NewMockInvalidChainLockEvidence(10, defaultEvidenceTime, &chainLock, val2, valSet, chainID)
+	badEv.ProposerProTxHash = ev.ProposerProTxHash
+	assert.Error(t, evidence.VerifyInvalidChainLock(badEv, chainID, valSet, 999, invalidChainLock))
+	badEv = types.NewMockInvalidChainLockEvidence(10, defaultEvidenceTime, &chainLock, val, valSet, chainID)
+	badEv.ProposerProTxHash = crypto.RandProTxHash()
+	assert.Error(t, evidence.VerifyInvalidChainLock(badEv, chainID, valSet, 999, invalidChainLock))
+	badEv = types.NewMockInvalidChainLockEvidence(10, defaultEvidenceTime, &chainLock, val, valSet, chainID)
+	badEv.ValidatorPower++
+	assert.Error(t, evidence.VerifyInvalidChainLock(badEv, chainID, valSet, 999, invalidChainLock))
+
+	// a proposal without a chain lock is evidence only if it regresses the
+	// core chain locked height
+	ev = types.NewMockInvalidChainLockEvidence(10, defaultEvidenceTime, nil, val, valSet, chainID)
+	require.NoError(t, ev.ValidateBasic())
+	assert.NoError(t, evidence.VerifyInvalidChainLock(ev, chainID, valSet, 1, validChainLock))
+	assert.Error(t, evidence.VerifyInvalidChainLock(ev, chainID, valSet, 0, validChainLock))
+}
+
 func makeVote(
 	t *testing.T,
 	val types.PrivValidator,
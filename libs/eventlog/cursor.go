@@ -0,0 +1,57 @@
+package eventlog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Cursor identifies an item of the log. Cursors are ordered by the time the
+// items were added, so a cursor obtained before the node restarted still
+// points before the items added afterwards.
+type Cursor struct {
+	timestamp int64  // unix time in nanoseconds
+	sequence  uint64 // disambiguates items added at the same time
+}
+
+// ParseCursor parses a cursor from its string representation. An empty
+// string is parsed as the zero cursor.
+func ParseCursor(s string) (Cursor, error) {
+	if s == "" {
+		return Cursor{}, nil
+	}
+
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return Cursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	timestamp, err := strconv.ParseInt(parts[0], 16, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor timestamp %q: %w", parts[0], err)
+	}
+	sequence, err := strconv.ParseUint(parts[1], 16, 64)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor sequence %q: %w", parts[1], err)
+	}
+	return Cursor{timestamp: timestamp, sequence: sequence}, nil
+}
+
+// IsZero returns true if the cursor is the zero cursor, which points before
+// any item.
+func (c Cursor) IsZero() bool {
+	return c == Cursor{}
+}
+
+// Before returns true if c points before o.
+func (c Cursor) Before(o Cursor) bool {
+	return c.timestamp < o.timestamp || (c.timestamp == o.timestamp && c.sequence < o.sequence)
+}
+
+// String returns the string representation of the cursor, or an empty string
+// for the zero cursor.
+func (c Cursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%016x-%04x", c.timestamp, c.sequence)
+}
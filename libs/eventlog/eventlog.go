@@ -0,0 +1,197 @@
+// Package eventlog implements an in-memory log of events, which is bounded
+// by the age and the number of its items.
+//
+// Every item is identified by a cursor. Clients read the items added after a
+// cursor and, if there are none yet, may wait for new items. Unlike pubsub
+// subscriptions, a client which lags or disconnects doesn't lose events, as
+// long as it reads them before they leave the log.
+package eventlog
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+)
+
+// Config defines the bounds of the log.
+type Config struct {
+	// Items older than WindowSize, relative to the newest item, are removed.
+	WindowSize time.Duration
+	// If positive, at most MaxItems are kept; the oldest items are removed
+	// first.
+	MaxItems int
+}
+
+// Item is an event in the log.
+type Item struct {
+	Cursor Cursor
+	// Type of the event (e.g. "NewBlock").
+	Type string
+	Data interface{}
+	// Events used to match the item against queries.
+	Events map[string][]string
+}
+
+// Info describes the items in the log.
+type Info struct {
+	Oldest Cursor // the zero cursor if the log is empty
+	Newest Cursor // the zero cursor if the log is empty
+	Size   int
+}
+
+// Log is an in-memory log of events. It is safe for concurrent use.
+type Log struct {
+	config Config
+
+	mtx   tmsync.Mutex
+	items []*Item // ordered from the oldest
+	last  Cursor
+	// closed and replaced whenever an item is added
+	ready chan struct{}
+
+	now func() time.Time
+}
+
+// New returns an empty log with the given bounds.
+func New(config Config) (*Log, error) {
+	if config.WindowSize <= 0 {
+		return nil, errors.New("window size must be positive")
+	}
+	if config.MaxItems < 0 {
+		return nil, errors.New("max items can't be negative")
+	}
+	return &Log{
+		config: config,
+		ready:  make(chan struct{}),
+		now:    time.Now,
+	}, nil
+}
+
+// Add appends an event to the log, removes the items which are out of its
+// bounds and returns the cursor of the new item.
+func (lg *Log) Add(etype string, data interface{}, events map[string][]string) Cursor {
+	lg.mtx.Lock()
+	defer lg.mtx.Unlock()
+
+	// the wall clock may go backwards, but cursors must not
+	c := Cursor{timestamp: lg.now().UnixNano()}
+	if c.timestamp <= lg.last.timestamp {
+		c = Cursor{timestamp: lg.last.timestamp, sequence: lg.last.sequence + 1}
+	}
+	lg.last = c
+	lg.items = append(lg.items, &Item{Cursor: c, Type: etype, Data: data, Events: events})
+
+	// the items are ordered by time, so the expired ones are at the front
+	expired := c.timestamp - int64(lg.config.WindowSize)
+	n := sort.Search(len(lg.items), func(i int) bool {
+		return lg.items[i].Cursor.timestamp >= expired
+	})
+	if lg.config.MaxItems > 0 && len(lg.items)-n > lg.config.MaxItems {
+		n = len(lg.items) - lg.config.MaxItems
+	}
+	if n > 0 {
+		// allow the removed items to be garbage collected
+		for i := 0; i < n; i++ {
+			lg.items[i] = nil
+		}
+		lg.items = lg.items[n:]
+	}
+
+	close(lg.ready)
+	lg.ready = make(chan struct{})
+
+	return c
+}
+
+// Info returns the cursors of the oldest and the newest items, and the number
+// of items in the log.
+func (lg *Log) Info() Info {
+	lg.mtx.Lock()
+	defer lg.mtx.Unlock()
+
+	if len(lg.items) == 0 {
+		return Info{}
+	}
+	return Info{
+		Oldest: lg.items[0].Cursor,
+		Newest: lg.items[len(lg.items)-1].Cursor,
+		Size:   len(lg.items),
+	}
+}
+
+// Read returns up to max items accepted by match, ordered from the oldest.
+// If after is the zero cursor, the newest items are returned, and more
+// reports whether older items are accepted too. Otherwise, the items right
+// after the cursor are returned, and more reports whether newer items are
+// accepted too. A nil match accepts all items.
+func (lg *Log) Read(after Cursor, max int, match func(*Item) (bool, error)) (items []*Item, more bool, err error) {
+	if match == nil {
+		match = func(*Item) (bool, error) { return true, nil }
+	}
+
+	lg.mtx.Lock()
+	defer lg.mtx.Unlock()
+
+	if after.IsZero() {
+		for i := len(lg.items) - 1; i >= 0; i-- {
+			ok, err := match(lg.items[i])
+			if err != nil {
+				return nil, false, err
+			}
+			if !ok {
+				continue
+			}
+			if len(items) == max {
+				more = true
+				break
+			}
+			items = append(items, lg.items[i])
+		}
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		return items, more, nil
+	}
+
+	start := sort.Search(len(lg.items), func(i int) bool {
+		return after.Before(lg.items[i].Cursor)
+	})
+	for _, item := range lg.items[start:] {
+		ok, err := match(item)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			continue
+		}
+		if len(items) == max {
+			more = true
+			break
+		}
+		items = append(items, item)
+	}
+	return items, more, nil
+}
+
+// WaitAfter blocks until an item newer than the cursor is added, or the
+// context is done, in which case its error is returned.
+func (lg *Log) WaitAfter(ctx context.Context, after Cursor) error {
+	for {
+		lg.mtx.Lock()
+		if after.Before(lg.last) {
+			lg.mtx.Unlock()
+			return nil
+		}
+		ready := lg.ready
+		lg.mtx.Unlock()
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
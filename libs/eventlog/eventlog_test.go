@@ -0,0 +1,145 @@
+package eventlog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLog returns a log whose clock is advanced by a second on every item.
+func newTestLog(t *testing.T, config Config) *Log {
+	lg, err := New(config)
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	lg.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return lg
+}
+
+func itemTypes(items []*Item) []string {
+	types := make([]string, 0, len(items))
+	for _, item := range items {
+		types = append(types, item.Type)
+	}
+	return types
+}
+
+func TestCursor(t *testing.T) {
+	c := Cursor{timestamp: 1626000000000000000, sequence: 2}
+	parsed, err := ParseCursor(c.String())
+	require.NoError(t, err)
+	assert.Equal(t, c, parsed)
+
+	zero, err := ParseCursor("")
+	require.NoError(t, err)
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, "", zero.String())
+
+	assert.True(t, zero.Before(c))
+	assert.True(t, c.Before(Cursor{timestamp: c.timestamp, sequence: 3}))
+	assert.False(t, c.Before(c))
+
+	for _, s := range []string{"foo", "1-2-3", "x-1", "1-y"} {
+		_, err := ParseCursor(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestLogBounds(t *testing.T) {
+	_, err := New(Config{})
+	assert.Error(t, err)
+	_, err = New(Config{WindowSize: time.Second, MaxItems: -1})
+	assert.Error(t, err)
+
+	lg := newTestLog(t, Config{WindowSize: 5 * time.Second, MaxItems: 3})
+	var cursors []Cursor
+	for i := 0; i < 5; i++ {
+		cursors = append(cursors, lg.Add(fmt.Sprint(i), nil, nil))
+	}
+
+	info := lg.Info()
+	assert.Equal(t, 3, info.Size)
+	assert.Equal(t, cursors[2], info.Oldest)
+	assert.Equal(t, cursors[4], info.Newest)
+
+	// the window removes items regardless of their number
+	lg.now = func() time.Time { return time.Unix(1000, 0).Add(20 * time.Second) }
+	lg.Add("new", nil, nil)
+	assert.Equal(t, 1, lg.Info().Size)
+}
+
+func TestLogCursorsAreOrdered(t *testing.T) {
+	lg, err := New(Config{WindowSize: time.Minute})
+	require.NoError(t, err)
+
+	// the clock doesn't move
+	lg.now = func() time.Time { return time.Unix(1000, 0) }
+	a := lg.Add("a", nil, nil)
+	b := lg.Add("b", nil, nil)
+	assert.True(t, a.Before(b))
+}
+
+func TestLogRead(t *testing.T) {
+	lg := newTestLog(t, Config{WindowSize: time.Minute})
+	var cursors []Cursor
+	for i := 0; i < 6; i++ {
+		cursors = append(cursors, lg.Add(fmt.Sprint(i), nil, nil))
+	}
+	even := func(item *Item) (bool, error) {
+		var i int
+		_, err := fmt.Sscan(item.Type, &i)
+		return i%2 == 0, err
+	}
+
+	testCases := []struct {
+		after    Cursor
+		max      int
+		match    func(*Item) (bool, error)
+		expected []string
+		more     bool
+	}{
+		{Cursor{}, 10, nil, []string{"0", "1", "2", "3", "4", "5"}, false},
+		{Cursor{}, 2, nil, []string{"4", "5"}, true},
+		{Cursor{}, 2, even, []string{"2", "4"}, true},
+		{cursors[1], 2, nil, []string{"2", "3"}, true},
+		{cursors[1], 2, even, []string{"2", "4"}, false},
+		{cursors[5], 2, nil, []string{}, false},
+	}
+	for i, tc := range testCases {
+		items, more, err := lg.Read(tc.after, tc.max, tc.match)
+		require.NoError(t, err, i)
+		assert.Equal(t, tc.expected, itemTypes(items), i)
+		assert.Equal(t, tc.more, more, i)
+	}
+}
+
+func TestLogWaitAfter(t *testing.T) {
+	lg := newTestLog(t, Config{WindowSize: time.Minute})
+	first := lg.Add("first", nil, nil)
+
+	// returns immediately if there are newer items
+	require.NoError(t, lg.WaitAfter(context.Background(), Cursor{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, lg.WaitAfter(ctx, first))
+
+	done := make(chan error)
+	go func() { done <- lg.WaitAfter(context.Background(), first) }()
+	time.Sleep(10 * time.Millisecond)
+	lg.Add("second", nil, nil)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("WaitAfter didn't return after an item was added")
+	}
+}
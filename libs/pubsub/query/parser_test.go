@@ -84,6 +84,24 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"tm.events.type='NewBlock' OR tm.events.type='Tx'", true},
+		{"tm.events.type='NewBlock' OR", false},
+		{"OR tm.events.type='NewBlock'", false},
+		{"tm.events.type='NewBlock' OR AND tx.gas > 7", false},
+		{"NOT tm.events.type='NewBlock'", true},
+		{"NOT NOT tm.events.type='NewBlock'", true},
+		{"NOT(tm.events.type='NewBlock')", true},
+		{"tm.events.type='NewBlock' NOT", false},
+		{"(tm.events.type='NewBlock')", true},
+		{"( tm.events.type='NewBlock' )", true},
+		{"((tm.events.type='NewBlock'))", true},
+		{"(tm.events.type='NewBlock'", false},
+		{"tm.events.type='NewBlock')", false},
+		{"()", false},
+		{"tx.gas > 7 AND (tx.gas < 9 OR tx.gas = 10)", true},
+		{"(tx.gas > 7 OR tx.gas < 2) AND NOT (slashing EXISTS OR tx.gas = 5)", true},
+		{"tx.gas > 7 AND(tx.gas < 9)", false},
 	}
 
 	for _, c := range cases {
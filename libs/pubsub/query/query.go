@@ -2,6 +2,11 @@
 //
 //		abci.invoice.number=22 AND abci.invoice.owner=Ivan
 //
+// Conditions can be combined with AND, OR and NOT and grouped with
+// parentheses. NOT binds tighter than AND, which binds tighter than OR:
+//
+//		tm.event='Tx' AND (transfer.sender='Ivan' OR NOT transfer.amount < 10)
+//
 // See query.peg for the grammar, which is a https://en.wikipedia.org/wiki/Parsing_expression_grammar.
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
 //
@@ -21,10 +26,12 @@ var (
 	numRegex = regexp.MustCompile(`([0-9\.]+)`)
 )
 
-// Query holds the query string and the query parser.
+// Query holds the query string, the query parser and the expression tree
+// compiled from the parsed query.
 type Query struct {
 	str    string
 	parser *QueryParser
+	expr   *Expr
 }
 
 // Condition represents a single condition within a query and consists of composite key
@@ -43,7 +50,15 @@ func New(s string) (*Query, error) {
 	if err := p.Parse(); err != nil {
 		return nil, err
 	}
-	return &Query{str: s, parser: p}, nil
+
+	// the expression is compiled once, as it is matched against every event
+	q := &Query{str: s, parser: p}
+	expr, err := q.disjunction(p.AST().up)
+	if err != nil {
+		return nil, err
+	}
+	q.expr = expr
+	return q, nil
 }
 
 // MustParse turns the given string into a query or panics; for tests or others
@@ -61,6 +76,29 @@ func (q *Query) String() string {
 	return q.str
 }
 
+// ExprOp is the operator of a node in the expression tree of a query.
+type ExprOp uint8
+
+const (
+	// a single condition
+	ExprCondition ExprOp = iota
+	// "AND"; all operands must match
+	ExprAnd
+	// "OR"; at least one operand must match
+	ExprOr
+	// "NOT"; the single operand must not match
+	ExprNot
+)
+
+// Expr is a node in the expression tree of a query. Leaf nodes hold a single
+// condition, AND and OR nodes hold two or more operands and NOT nodes hold
+// exactly one.
+type Expr struct {
+	Op        ExprOp
+	Condition Condition
+	Operands  []*Expr
+}
+
 // Operator is an operator that defines some kind of relation between composite key and
 // operand (equality, etc.).
 type Operator uint8
@@ -90,54 +128,145 @@ const (
 )
 
 // Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
+// error with the provided grammar in the Query, or if the query is not a
+// conjunction of conditions (i.e. it uses OR or NOT); use Expression for such
+// queries.
 func (q *Query) Conditions() ([]Condition, error) {
+	expr, err := q.Expression()
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]Condition, 0)
+	var collect func(e *Expr) error
+	collect = func(e *Expr) error {
+		switch e.Op {
+		case ExprCondition:
+			conditions = append(conditions, e.Condition)
+		case ExprAnd:
+			for _, operand := range e.Operands {
+				if err := collect(operand); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("query %q is not a conjunction of conditions", q.str)
+		}
+		return nil
+	}
+	if err := collect(expr); err != nil {
+		return nil, err
+	}
+
+	return conditions, nil
+}
+
+// Expression returns the expression tree of the query, which is compiled by
+// New. The error is always nil, as New fails for invalid queries.
+func (q *Query) Expression() (*Expr, error) {
+	return q.expr, nil
+}
+
+// disjunction converts the disjunction (or conjunction) node into an
+// expression. Nodes with a single operand are collapsed.
+func (q *Query) disjunction(node *node32) (*Expr, error) {
 	var (
-		eventAttr string
-		op        Operator
+		operands []*Expr
+		op       = ExprOr
 	)
+	if node.pegRule == ruleconjunction {
+		op = ExprAnd
+	}
 
-	conditions := make([]Condition, 0)
-	buffer, begin, end := q.parser.Buffer, 0, 0
+	for n := node.up; n != nil; n = n.next {
+		var (
+			e   *Expr
+			err error
+		)
+		switch n.pegRule {
+		case ruleconjunction:
+			e, err = q.disjunction(n)
+		case ruleterm:
+			e, err = q.term(n)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, e)
+	}
 
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &Expr{Op: op, Operands: operands}, nil
+}
 
+// term converts the term node, which is either a negated term, a
+// parenthesized disjunction or a condition, into an expression.
+func (q *Query) term(node *node32) (*Expr, error) {
+	for n := node.up; n != nil; n = n.next {
+		switch n.pegRule {
+		case rulenot:
+			operand, err := q.term(n.next)
+			if err != nil {
+				return nil, err
+			}
+			return &Expr{Op: ExprNot, Operands: []*Expr{operand}}, nil
+
+		case ruledisjunction:
+			return q.disjunction(n)
+
+		case rulecondition:
+			c, err := q.condition(n)
+			if err != nil {
+				return nil, err
+			}
+			return &Expr{Op: ExprCondition, Condition: c}, nil
+		}
+	}
+	return nil, fmt.Errorf("empty term in query %q (should never happen if the grammar is correct)", q.str)
+}
+
+// condition converts the condition node into a Condition. Its children must
+// be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7").
+func (q *Query) condition(node *node32) (Condition, error) {
+	var c Condition
+
+	for n := node.up; n != nil; n = n.next {
+		switch n.pegRule {
 		case ruletag:
-			eventAttr = buffer[begin:end]
+			c.CompositeKey = q.text(n)
 
 		case rulele:
-			op = OpLessEqual
+			c.Op = OpLessEqual
 
 		case rulege:
-			op = OpGreaterEqual
+			c.Op = OpGreaterEqual
 
 		case rulel:
-			op = OpLess
+			c.Op = OpLess
 
 		case ruleg:
-			op = OpGreater
+			c.Op = OpGreater
 
 		case ruleequal:
-			op = OpEqual
+			c.Op = OpEqual
 
 		case rulecontains:
-			op = OpContains
+			c.Op = OpContains
 
 		case ruleexists:
-			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
+			c.Op = OpExists
 
 		case rulevalue:
 			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
+			value := q.text(n)
+			c.Operand = value[1 : len(value)-1]
 
 		case rulenumber:
-			number := buffer[begin:end]
+			number := q.text(n)
 			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
 				value, err := strconv.ParseFloat(number, 64)
 				if err != nil {
@@ -145,10 +274,9 @@ func (q *Query) Conditions() ([]Condition, error) {
 						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
 						err, number,
 					)
-					return nil, err
+					return c, err
 				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
+				c.Operand = value
 			} else {
 				value, err := strconv.ParseInt(number, 10, 64)
 				if err != nil {
@@ -156,39 +284,45 @@ func (q *Query) Conditions() ([]Condition, error) {
 						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
 						err, number,
 					)
-					return nil, err
+					return c, err
 				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
+				c.Operand = value
 			}
 
 		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
+			// skip the "TIME " prefix
+			text := q.text(n.up)
+			value, err := time.Parse(TimeLayout, text)
 			if err != nil {
 				err = fmt.Errorf(
 					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
+					err, text,
 				)
-				return nil, err
+				return c, err
 			}
-
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			c.Operand = value
 
 		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
+			// skip the "DATE " prefix
+			text := q.text(n.up)
+			value, err := time.Parse(DateLayout, text)
 			if err != nil {
 				err = fmt.Errorf(
 					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
+					err, text,
 				)
-				return nil, err
+				return c, err
 			}
-
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			c.Operand = value
 		}
 	}
 
-	return conditions, nil
+	return c, nil
+}
+
+// text returns the part of the query covered by the node.
+func (q *Query) text(node *node32) string {
+	return string(q.parser.buffer[node.begin:node.end])
 }
 
 // Matches returns true if the query matches against any event in the given set
@@ -203,160 +337,66 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 		return false, nil
 	}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order:
-
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
-
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
-			}
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
+	return q.expr.Matches(events)
+}
 
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
+// Matches returns true if the expression matches against the given set of
+// events, false otherwise. See Query.Matches.
+func (e *Expr) Matches(events map[string][]string) (bool, error) {
+	switch e.Op {
+	case ExprCondition:
+		return e.Condition.matches(events)
+
+	case ExprAnd:
+		for _, operand := range e.Operands {
+			match, err := operand.Matches(events)
+			if err != nil || !match {
 				return false, err
 			}
+		}
+		return true, nil
 
-			if !match {
-				return false, nil
-			}
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
+	case ExprOr:
+		for _, operand := range e.Operands {
+			match, err := operand.Matches(events)
+			if err != nil || match {
+				return match, err
 			}
+		}
+		return false, nil
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+	case ExprNot:
+		match, err := e.Operands[0].Matches(events)
+		if err != nil {
+			return false, err
+		}
+		return !match, nil
+	}
 
-			if !match {
-				return false, nil
-			}
+	return false, fmt.Errorf("unknown expression operator %v", e.Op)
+}
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+// matches returns true if the condition matches against any event in the
+// given set of events.
+func (c Condition) matches(events map[string][]string) (bool, error) {
+	if c.Op != OpExists {
+		// see if the triplet (event attribute, operator, operand) matches any event
+		// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+		return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
+	}
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+	if strings.Contains(c.CompositeKey, ".") {
+		// Searching for a full "type.attribute" event.
+		_, ok := events[c.CompositeKey]
+		return ok, nil
+	}
 
-			if !match {
-				return false, nil
-			}
+	for compositeKey := range events {
+		if strings.Index(compositeKey, c.CompositeKey) == 0 {
+			return true, nil
 		}
 	}
-
-	return true, nil
+	return false, nil
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
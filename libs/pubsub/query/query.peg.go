@@ -1,11 +1,15 @@
 // nolint
 package query
 
+// Code generated by peg -inline -switch query.peg DO NOT EDIT.
+
 import (
 	"fmt"
-	"math"
+	"io"
+	"os"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 const endSymbol rune = 1114112
@@ -16,6 +20,9 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	rulee
+	ruledisjunction
+	ruleconjunction
+	ruleterm
 	rulecondition
 	ruletag
 	rulevalue
@@ -27,6 +34,8 @@ const (
 	rulemonth
 	ruleday
 	ruleand
+	ruleor
+	rulenot
 	ruleequal
 	rulecontains
 	ruleexists
@@ -35,15 +44,14 @@ const (
 	rulel
 	ruleg
 	rulePegText
-
-	rulePre
-	ruleIn
-	ruleSuf
 )
 
 var rul3s = [...]string{
 	"Unknown",
 	"e",
+	"disjunction",
+	"conjunction",
+	"term",
 	"condition",
 	"tag",
 	"value",
@@ -55,6 +63,8 @@ var rul3s = [...]string{
 	"month",
 	"day",
 	"and",
+	"or",
+	"not",
 	"equal",
 	"contains",
 	"exists",
@@ -63,10 +73,15 @@ var rul3s = [...]string{
 	"l",
 	"g",
 	"PegText",
+}
+
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
 
-	"Pre_",
-	"_In_",
-	"_Suf",
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
 }
 
 type node32 struct {
@@ -74,57 +89,43 @@ type node32 struct {
 	up, next *node32
 }
 
-func (node *node32) print(depth int, buffer string) {
-	for node != nil {
-		for c := 0; c < depth; c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[node.pegRule], strconv.Quote(string(([]rune(buffer)[node.begin:node.end]))))
-		if node.up != nil {
-			node.up.print(depth+1, buffer)
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
 		}
-		node = node.next
 	}
+	print(node, 0)
 }
 
-func (node *node32) Print(buffer string) {
-	node.print(0, buffer)
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
 }
 
-type element struct {
-	node *node32
-	down *element
-}
-
-/* ${@} bit structure for abstract syntax tree */
-type token32 struct {
-	pegRule
-	begin, end, next uint32
-}
-
-func (t *token32) isZero() bool {
-	return t.pegRule == ruleUnknown && t.begin == 0 && t.end == 0 && t.next == 0
-}
-
-func (t *token32) isParentOf(u token32) bool {
-	return t.begin <= u.begin && t.end >= u.end && t.next > u.next
-}
-
-func (t *token32) getToken32() token32 {
-	return token32{pegRule: t.pegRule, begin: uint32(t.begin), end: uint32(t.end), next: uint32(t.next)}
-}
-
-func (t *token32) String() string {
-	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v %v", rul3s[t.pegRule], t.begin, t.end, t.next)
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
 }
 
 type tokens32 struct {
-	tree    []token32
-	ordered [][]token32
+	tree []token32
 }
 
-func (t *tokens32) trim(length int) {
-	t.tree = t.tree[0:length]
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
 }
 
 func (t *tokens32) Print() {
@@ -133,51 +134,14 @@ func (t *tokens32) Print() {
 	}
 }
 
-func (t *tokens32) Order() [][]token32 {
-	if t.ordered != nil {
-		return t.ordered
-	}
-
-	depths := make([]int32, 1, math.MaxInt16)
-	for i, token := range t.tree {
-		if token.pegRule == ruleUnknown {
-			t.tree = t.tree[:i]
-			break
-		}
-		depth := int(token.next)
-		if length := len(depths); depth >= length {
-			depths = depths[:depth+1]
-		}
-		depths[depth]++
-	}
-	depths = append(depths, 0)
-
-	ordered, pool := make([][]token32, len(depths)), make([]token32, len(t.tree)+len(depths))
-	for i, depth := range depths {
-		depth++
-		ordered[i], pool, depths[i] = pool[:depth], pool[depth:], 0
-	}
-
-	for i, token := range t.tree {
-		depth := token.next
-		token.next = uint32(i)
-		ordered[depth][depths[depth]] = token
-		depths[depth]++
-	}
-	t.ordered = ordered
-	return ordered
-}
-
-type state32 struct {
-	token32
-	depths []int32
-	leaf   bool
-}
-
 func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
 	tokens := t.Tokens()
-	stack := &element{node: &node32{token32: <-tokens}}
-	for token := range tokens {
+	var stack *element
+	for _, token := range tokens {
 		if token.begin == token.end {
 			continue
 		}
@@ -189,180 +153,55 @@ func (t *tokens32) AST() *node32 {
 		}
 		stack = &element{node: node, down: stack}
 	}
-	return stack.node
-}
-
-func (t *tokens32) PreOrder() (<-chan state32, [][]token32) {
-	s, ordered := make(chan state32, 6), t.Order()
-	go func() {
-		var states [8]state32
-		for i := range states {
-			states[i].depths = make([]int32, len(ordered))
-		}
-		depths, state, depth := make([]int32, len(ordered)), 0, 1
-		write := func(t token32, leaf bool) {
-			S := states[state]
-			state, S.pegRule, S.begin, S.end, S.next, S.leaf = (state+1)%8, t.pegRule, t.begin, t.end, uint32(depth), leaf
-			copy(S.depths, depths)
-			s <- S
-		}
-
-		states[state].token32 = ordered[0][0]
-		depths[0]++
-		state++
-		a, b := ordered[depth-1][depths[depth-1]-1], ordered[depth][depths[depth]]
-	depthFirstSearch:
-		for {
-			for {
-				if i := depths[depth]; i > 0 {
-					if c, j := ordered[depth][i-1], depths[depth-1]; a.isParentOf(c) &&
-						(j < 2 || !ordered[depth-1][j-2].isParentOf(c)) {
-						if c.end != b.begin {
-							write(token32{pegRule: ruleIn, begin: c.end, end: b.begin}, true)
-						}
-						break
-					}
-				}
-
-				if a.begin < b.begin {
-					write(token32{pegRule: rulePre, begin: a.begin, end: b.begin}, true)
-				}
-				break
-			}
-
-			next := depth + 1
-			if c := ordered[next][depths[next]]; c.pegRule != ruleUnknown && b.isParentOf(c) {
-				write(b, false)
-				depths[depth]++
-				depth, a, b = next, b, c
-				continue
-			}
-
-			write(b, true)
-			depths[depth]++
-			c, parent := ordered[depth][depths[depth]], true
-			for {
-				if c.pegRule != ruleUnknown && a.isParentOf(c) {
-					b = c
-					continue depthFirstSearch
-				} else if parent && b.end != a.end {
-					write(token32{pegRule: ruleSuf, begin: b.end, end: a.end}, true)
-				}
-
-				depth--
-				if depth > 0 {
-					a, b, c = ordered[depth-1][depths[depth-1]-1], a, ordered[depth][depths[depth]]
-					parent = a.isParentOf(b)
-					continue
-				}
-
-				break depthFirstSearch
-			}
-		}
-
-		close(s)
-	}()
-	return s, ordered
-}
-
-func (t *tokens32) PrintSyntax() {
-	tokens, ordered := t.PreOrder()
-	max := -1
-	for token := range tokens {
-		if !token.leaf {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[36m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[36m%v\x1B[m\n", rul3s[token.pegRule])
-		} else if token.begin == token.end {
-			fmt.Printf("%v", token.begin)
-			for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-				fmt.Printf(" \x1B[31m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-			}
-			fmt.Printf(" \x1B[31m%v\x1B[m\n", rul3s[token.pegRule])
-		} else {
-			for c, end := token.begin, token.end; c < end; c++ {
-				if i := int(c); max+1 < i {
-					for j := max; j < i; j++ {
-						fmt.Printf("skip %v %v\n", j, token.String())
-					}
-					max = i
-				} else if i := int(c); i <= max {
-					for j := i; j <= max; j++ {
-						fmt.Printf("dupe %v %v\n", j, token.String())
-					}
-				} else {
-					max = int(c)
-				}
-				fmt.Printf("%v", c)
-				for i, leaf, depths := 0, int(token.next), token.depths; i < leaf; i++ {
-					fmt.Printf(" \x1B[34m%v\x1B[m", rul3s[ordered[i][depths[i]-1].pegRule])
-				}
-				fmt.Printf(" \x1B[34m%v\x1B[m\n", rul3s[token.pegRule])
-			}
-			fmt.Printf("\n")
-		}
+	if stack != nil {
+		return stack.node
 	}
+	return nil
 }
 
 func (t *tokens32) PrintSyntaxTree(buffer string) {
-	tokens, _ := t.PreOrder()
-	for token := range tokens {
-		for c := 0; c < int(token.next); c++ {
-			fmt.Printf(" ")
-		}
-		fmt.Printf("\x1B[34m%v\x1B[m %v\n", rul3s[token.pegRule], strconv.Quote(string(([]rune(buffer)[token.begin:token.end]))))
-	}
+	t.AST().Print(os.Stdout, buffer)
 }
 
-func (t *tokens32) Add(rule pegRule, begin, end, depth uint32, index int) {
-	t.tree[index] = token32{pegRule: rule, begin: uint32(begin), end: uint32(end), next: uint32(depth)}
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
 }
 
-func (t *tokens32) Tokens() <-chan token32 {
-	s := make(chan token32, 16)
-	go func() {
-		for _, v := range t.tree {
-			s <- v.getToken32()
-		}
-		close(s)
-	}()
-	return s
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
 }
 
-func (t *tokens32) Error() []token32 {
-	ordered := t.Order()
-	length := len(ordered)
-	tokens, length := make([]token32, length), length-1
-	for i := range tokens {
-		o := ordered[length-i]
-		if len(o) > 1 {
-			tokens[i] = o[len(o)-2].getToken32()
-		}
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
 	}
-	return tokens
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
 }
 
-func (t *tokens32) Expand(index int) {
-	tree := t.tree
-	if index >= len(tree) {
-		expanded := make([]token32, 2*len(tree))
-		copy(expanded, tree)
-		t.tree = expanded
-	}
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
 }
 
 type QueryParser struct {
 	Buffer string
 	buffer []rune
-	rules  [21]func() bool
-	Parse  func(rule ...int) error
-	Reset  func()
+	rules  [26]func() bool
+	parse  func(rule ...int) error
+	reset  func()
 	Pretty bool
 	tokens32
 }
 
+func (p *QueryParser) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *QueryParser) Reset() {
+	p.reset()
+}
+
 type textPosition struct {
 	line, symbol int
 }
@@ -400,7 +239,7 @@ type parseError struct {
 }
 
 func (e *parseError) Error() string {
-	tokens, error := []token32{e.max}, "\n"
+	tokens, err := []token32{e.max}, "\n"
 	positions, p := make([]int, 2*len(tokens)), 0
 	for _, token := range tokens {
 		positions[p], p = int(token.begin), p+1
@@ -413,35 +252,74 @@ func (e *parseError) Error() string {
 	}
 	for _, token := range tokens {
 		begin, end := int(token.begin), int(token.end)
-		error += fmt.Sprintf(format,
+		err += fmt.Sprintf(format,
 			rul3s[token.pegRule],
 			translations[begin].line, translations[begin].symbol,
 			translations[end].line, translations[end].symbol,
 			strconv.Quote(string(e.p.buffer[begin:end])))
 	}
 
-	return error
+	return err
 }
 
 func (p *QueryParser) PrintSyntaxTree() {
-	p.tokens32.PrintSyntaxTree(p.Buffer)
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *QueryParser) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
 }
 
-func (p *QueryParser) Highlighter() {
-	p.PrintSyntax()
+func (p *QueryParser) SprintSyntaxTree() string {
+	var bldr strings.Builder
+	p.WriteSyntaxTree(&bldr)
+	return bldr.String()
 }
 
-func (p *QueryParser) Init() {
-	p.buffer = []rune(p.Buffer)
-	if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
-		p.buffer = append(p.buffer, endSymbol)
+func Pretty(pretty bool) func(*QueryParser) error {
+	return func(p *QueryParser) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+func Size(size int) func(*QueryParser) error {
+	return func(p *QueryParser) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
 	}
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
 
-	tree := tokens32{tree: make([]token32, math.MaxInt16)}
-	var max token32
-	position, depth, tokenIndex, buffer, _rules := uint32(0), uint32(0), 0, p.buffer, p.rules
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer = p.buffer
+	}
+	p.reset()
 
-	p.Parse = func(rule ...int) error {
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) error {
 		r := 1
 		if len(rule) > 0 {
 			r = rule[0]
@@ -449,22 +327,17 @@ func (p *QueryParser) Init() {
 		matches := p.rules[r]()
 		p.tokens32 = tree
 		if matches {
-			p.trim(tokenIndex)
+			p.Trim(tokenIndex)
 			return nil
 		}
 		return &parseError{p, max}
 	}
 
-	p.Reset = func() {
-		position, tokenIndex, depth = 0, 0, 0
-	}
-
 	add := func(rule pegRule, begin uint32) {
-		tree.Expand(tokenIndex)
-		tree.Add(rule, begin, position, depth, tokenIndex)
+		tree.Add(rule, begin, position, tokenIndex)
 		tokenIndex++
 		if begin != position && position > max.end {
-			max = token32{rule, begin, position, depth}
+			max = token32{rule, begin, position}
 		}
 	}
 
@@ -494,1378 +367,1521 @@ func (p *QueryParser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <('"' condition (' '+ and ' '+ condition)* '"' !.)> */
+		/* 0 e <- <('"' disjunction '"' !.)> */
 		func() bool {
-			position0, tokenIndex0, depth0 := position, tokenIndex, depth
+			position0, tokenIndex0 := position, tokenIndex
 			{
 				position1 := position
-				depth++
 				if buffer[position] != rune('"') {
 					goto l0
 				}
 				position++
-				if !_rules[rulecondition]() {
+				if !_rules[ruledisjunction]() {
 					goto l0
 				}
-			l2:
+				if buffer[position] != rune('"') {
+					goto l0
+				}
+				position++
 				{
-					position3, tokenIndex3, depth3 := position, tokenIndex, depth
+					position2, tokenIndex2 := position, tokenIndex
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 disjunction <- <(conjunction (' '+ or ' '+ conjunction)*)> */
+		func() bool {
+			position3, tokenIndex3 := position, tokenIndex
+			{
+				position4 := position
+				if !_rules[ruleconjunction]() {
+					goto l3
+				}
+			l5:
+				{
+					position6, tokenIndex6 := position, tokenIndex
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l6
 					}
 					position++
-				l4:
+				l7:
 					{
-						position5, tokenIndex5, depth5 := position, tokenIndex, depth
+						position8, tokenIndex8 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l5
+							goto l8
 						}
 						position++
-						goto l4
-					l5:
-						position, tokenIndex, depth = position5, tokenIndex5, depth5
+						goto l7
+					l8:
+						position, tokenIndex = position8, tokenIndex8
 					}
 					{
-						position6 := position
-						depth++
+						position9 := position
 						{
-							position7, tokenIndex7, depth7 := position, tokenIndex, depth
+							position10, tokenIndex10 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l11
+							}
+							position++
+							goto l10
+						l11:
+							position, tokenIndex = position10, tokenIndex10
+							if buffer[position] != rune('O') {
+								goto l6
+							}
+							position++
+						}
+					l10:
+						{
+							position12, tokenIndex12 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l13
+							}
+							position++
+							goto l12
+						l13:
+							position, tokenIndex = position12, tokenIndex12
+							if buffer[position] != rune('R') {
+								goto l6
+							}
+							position++
+						}
+					l12:
+						add(ruleor, position9)
+					}
+					if buffer[position] != rune(' ') {
+						goto l6
+					}
+					position++
+				l14:
+					{
+						position15, tokenIndex15 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l15
+						}
+						position++
+						goto l14
+					l15:
+						position, tokenIndex = position15, tokenIndex15
+					}
+					if !_rules[ruleconjunction]() {
+						goto l6
+					}
+					goto l5
+				l6:
+					position, tokenIndex = position6, tokenIndex6
+				}
+				add(ruledisjunction, position4)
+			}
+			return true
+		l3:
+			position, tokenIndex = position3, tokenIndex3
+			return false
+		},
+		/* 2 conjunction <- <(term (' '+ and ' '+ term)*)> */
+		func() bool {
+			position16, tokenIndex16 := position, tokenIndex
+			{
+				position17 := position
+				if !_rules[ruleterm]() {
+					goto l16
+				}
+			l18:
+				{
+					position19, tokenIndex19 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l19
+					}
+					position++
+				l20:
+					{
+						position21, tokenIndex21 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l21
+						}
+						position++
+						goto l20
+					l21:
+						position, tokenIndex = position21, tokenIndex21
+					}
+					{
+						position22 := position
+						{
+							position23, tokenIndex23 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l8
+								goto l24
 							}
 							position++
-							goto l7
-						l8:
-							position, tokenIndex, depth = position7, tokenIndex7, depth7
+							goto l23
+						l24:
+							position, tokenIndex = position23, tokenIndex23
 							if buffer[position] != rune('A') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l7:
+					l23:
 						{
-							position9, tokenIndex9, depth9 := position, tokenIndex, depth
+							position25, tokenIndex25 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l10
+								goto l26
 							}
 							position++
-							goto l9
-						l10:
-							position, tokenIndex, depth = position9, tokenIndex9, depth9
+							goto l25
+						l26:
+							position, tokenIndex = position25, tokenIndex25
 							if buffer[position] != rune('N') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l9:
+					l25:
 						{
-							position11, tokenIndex11, depth11 := position, tokenIndex, depth
+							position27, tokenIndex27 := position, tokenIndex
 							if buffer[position] != rune('d') {
-								goto l12
+								goto l28
 							}
 							position++
-							goto l11
-						l12:
-							position, tokenIndex, depth = position11, tokenIndex11, depth11
+							goto l27
+						l28:
+							position, tokenIndex = position27, tokenIndex27
 							if buffer[position] != rune('D') {
-								goto l3
+								goto l19
 							}
 							position++
 						}
-					l11:
-						depth--
-						add(ruleand, position6)
+					l27:
+						add(ruleand, position22)
 					}
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l19
 					}
 					position++
-				l13:
+				l29:
 					{
-						position14, tokenIndex14, depth14 := position, tokenIndex, depth
+						position30, tokenIndex30 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l14
+							goto l30
 						}
 						position++
-						goto l13
-					l14:
-						position, tokenIndex, depth = position14, tokenIndex14, depth14
+						goto l29
+					l30:
+						position, tokenIndex = position30, tokenIndex30
 					}
-					if !_rules[rulecondition]() {
-						goto l3
+					if !_rules[ruleterm]() {
+						goto l19
 					}
-					goto l2
-				l3:
-					position, tokenIndex, depth = position3, tokenIndex3, depth3
+					goto l18
+				l19:
+					position, tokenIndex = position19, tokenIndex19
 				}
-				if buffer[position] != rune('"') {
-					goto l0
-				}
-				position++
-				{
-					position15, tokenIndex15, depth15 := position, tokenIndex, depth
-					if !matchDot() {
-						goto l15
-					}
-					goto l0
-				l15:
-					position, tokenIndex, depth = position15, tokenIndex15, depth15
-				}
-				depth--
-				add(rulee, position1)
+				add(ruleconjunction, position17)
 			}
 			return true
-		l0:
-			position, tokenIndex, depth = position0, tokenIndex0, depth0
+		l16:
+			position, tokenIndex = position16, tokenIndex16
 			return false
 		},
-		/* 1 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		/* 3 term <- <((not (' '+ / &'(') term) / ('(' ' '* disjunction ' '* ')') / condition)> */
 		func() bool {
-			position16, tokenIndex16, depth16 := position, tokenIndex, depth
+			position31, tokenIndex31 := position, tokenIndex
 			{
-				position17 := position
-				depth++
+				position32 := position
 				{
-					position18 := position
-					depth++
+					position33, tokenIndex33 := position, tokenIndex
 					{
-						position19 := position
-						depth++
+						position35 := position
 						{
-							position22, tokenIndex22, depth22 := position, tokenIndex, depth
-							{
-								switch buffer[position] {
-								case '<':
-									if buffer[position] != rune('<') {
-										goto l22
-									}
-									position++
-									break
-								case '>':
-									if buffer[position] != rune('>') {
-										goto l22
-									}
-									position++
-									break
-								case '=':
-									if buffer[position] != rune('=') {
-										goto l22
-									}
-									position++
-									break
-								case '\'':
-									if buffer[position] != rune('\'') {
-										goto l22
-									}
-									position++
-									break
-								case '"':
-									if buffer[position] != rune('"') {
-										goto l22
-									}
-									position++
-									break
-								case ')':
-									if buffer[position] != rune(')') {
-										goto l22
-									}
-									position++
-									break
-								case '(':
-									if buffer[position] != rune('(') {
-										goto l22
-									}
-									position++
-									break
-								case '\\':
-									if buffer[position] != rune('\\') {
-										goto l22
-									}
-									position++
-									break
-								case '\r':
-									if buffer[position] != rune('\r') {
-										goto l22
-									}
-									position++
-									break
-								case '\n':
-									if buffer[position] != rune('\n') {
-										goto l22
-									}
-									position++
-									break
-								case '\t':
-									if buffer[position] != rune('\t') {
-										goto l22
-									}
-									position++
-									break
-								default:
-									if buffer[position] != rune(' ') {
-										goto l22
-									}
-									position++
-									break
-								}
+							position36, tokenIndex36 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l37
 							}
-
-							goto l16
-						l22:
-							position, tokenIndex, depth = position22, tokenIndex22, depth22
-						}
-						if !matchDot() {
-							goto l16
+							position++
+							goto l36
+						l37:
+							position, tokenIndex = position36, tokenIndex36
+							if buffer[position] != rune('N') {
+								goto l34
+							}
+							position++
 						}
-					l20:
+					l36:
 						{
-							position21, tokenIndex21, depth21 := position, tokenIndex, depth
-							{
-								position24, tokenIndex24, depth24 := position, tokenIndex, depth
-								{
-									switch buffer[position] {
-									case '<':
-										if buffer[position] != rune('<') {
-											goto l24
-										}
-										position++
-										break
-									case '>':
-										if buffer[position] != rune('>') {
-											goto l24
-										}
-										position++
-										break
-									case '=':
-										if buffer[position] != rune('=') {
-											goto l24
-										}
-										position++
-										break
-									case '\'':
-										if buffer[position] != rune('\'') {
-											goto l24
-										}
-										position++
-										break
-									case '"':
-										if buffer[position] != rune('"') {
-											goto l24
-										}
-										position++
-										break
-									case ')':
-										if buffer[position] != rune(')') {
-											goto l24
-										}
-										position++
-										break
-									case '(':
-										if buffer[position] != rune('(') {
-											goto l24
-										}
-										position++
-										break
-									case '\\':
-										if buffer[position] != rune('\\') {
-											goto l24
-										}
-										position++
-										break
-									case '\r':
-										if buffer[position] != rune('\r') {
-											goto l24
-										}
-										position++
-										break
-									case '\n':
-										if buffer[position] != rune('\n') {
-											goto l24
-										}
-										position++
-										break
-									case '\t':
-										if buffer[position] != rune('\t') {
-											goto l24
-										}
-										position++
-										break
-									default:
-										if buffer[position] != rune(' ') {
-											goto l24
-										}
-										position++
-										break
-									}
-								}
-
-								goto l21
-							l24:
-								position, tokenIndex, depth = position24, tokenIndex24, depth24
+							position38, tokenIndex38 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l39
 							}
-							if !matchDot() {
-								goto l21
+							position++
+							goto l38
+						l39:
+							position, tokenIndex = position38, tokenIndex38
+							if buffer[position] != rune('O') {
+								goto l34
 							}
-							goto l20
-						l21:
-							position, tokenIndex, depth = position21, tokenIndex21, depth21
-						}
-						depth--
-						add(rulePegText, position19)
-					}
-					depth--
-					add(ruletag, position18)
-				}
-			l26:
-				{
-					position27, tokenIndex27, depth27 := position, tokenIndex, depth
-					if buffer[position] != rune(' ') {
-						goto l27
-					}
-					position++
-					goto l26
-				l27:
-					position, tokenIndex, depth = position27, tokenIndex27, depth27
-				}
-				{
-					position28, tokenIndex28, depth28 := position, tokenIndex, depth
-					{
-						position30 := position
-						depth++
-						if buffer[position] != rune('<') {
-							goto l29
+							position++
 						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l29
+					l38:
+						{
+							position40, tokenIndex40 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l41
+							}
+							position++
+							goto l40
+						l41:
+							position, tokenIndex = position40, tokenIndex40
+							if buffer[position] != rune('T') {
+								goto l34
+							}
+							position++
 						}
-						position++
-						depth--
-						add(rulele, position30)
+					l40:
+						add(rulenot, position35)
 					}
-				l31:
 					{
-						position32, tokenIndex32, depth32 := position, tokenIndex, depth
+						position42, tokenIndex42 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l32
+							goto l43
 						}
 						position++
-						goto l31
-					l32:
-						position, tokenIndex, depth = position32, tokenIndex32, depth32
-					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l29
-							}
-							break
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l29
+					l44:
+						{
+							position45, tokenIndex45 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l45
 							}
-							break
-						default:
-							if !_rules[rulenumber]() {
-								goto l29
+							position++
+							goto l44
+						l45:
+							position, tokenIndex = position45, tokenIndex45
+						}
+						goto l42
+					l43:
+						position, tokenIndex = position42, tokenIndex42
+						{
+							position46, tokenIndex46 := position, tokenIndex
+							if buffer[position] != rune('(') {
+								goto l34
 							}
-							break
+							position++
+							position, tokenIndex = position46, tokenIndex46
 						}
 					}
-
-					goto l28
-				l29:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+				l42:
+					if !_rules[ruleterm]() {
+						goto l34
+					}
+					goto l33
+				l34:
+					position, tokenIndex = position33, tokenIndex33
+					if buffer[position] != rune('(') {
+						goto l47
+					}
+					position++
+				l48:
 					{
-						position35 := position
-						depth++
-						if buffer[position] != rune('>') {
-							goto l34
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l34
+						position49, tokenIndex49 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l49
 						}
 						position++
-						depth--
-						add(rulege, position35)
+						goto l48
+					l49:
+						position, tokenIndex = position49, tokenIndex49
 					}
-				l36:
+					if !_rules[ruledisjunction]() {
+						goto l47
+					}
+				l50:
 					{
-						position37, tokenIndex37, depth37 := position, tokenIndex, depth
+						position51, tokenIndex51 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l37
+							goto l51
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						goto l50
+					l51:
+						position, tokenIndex = position51, tokenIndex51
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l34
-							}
-							break
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l34
-							}
-							break
-						default:
-							if !_rules[rulenumber]() {
-								goto l34
-							}
-							break
-						}
+					if buffer[position] != rune(')') {
+						goto l47
 					}
-
-					goto l28
-				l34:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					position++
+					goto l33
+				l47:
+					position, tokenIndex = position33, tokenIndex33
 					{
-						switch buffer[position] {
-						case 'E', 'e':
+						position52 := position
+						{
+							position53 := position
 							{
-								position40 := position
-								depth++
-								{
-									position41, tokenIndex41, depth41 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l42
-									}
-									position++
-									goto l41
-								l42:
-									position, tokenIndex, depth = position41, tokenIndex41, depth41
-									if buffer[position] != rune('E') {
-										goto l16
-									}
-									position++
-								}
-							l41:
-								{
-									position43, tokenIndex43, depth43 := position, tokenIndex, depth
-									if buffer[position] != rune('x') {
-										goto l44
-									}
-									position++
-									goto l43
-								l44:
-									position, tokenIndex, depth = position43, tokenIndex43, depth43
-									if buffer[position] != rune('X') {
-										goto l16
-									}
-									position++
-								}
-							l43:
+								position54 := position
 								{
-									position45, tokenIndex45, depth45 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l46
-									}
-									position++
-									goto l45
-								l46:
-									position, tokenIndex, depth = position45, tokenIndex45, depth45
-									if buffer[position] != rune('I') {
-										goto l16
+									position57, tokenIndex57 := position, tokenIndex
+									{
+										switch buffer[position] {
+										case '<':
+											if buffer[position] != rune('<') {
+												goto l57
+											}
+											position++
+										case '>':
+											if buffer[position] != rune('>') {
+												goto l57
+											}
+											position++
+										case '=':
+											if buffer[position] != rune('=') {
+												goto l57
+											}
+											position++
+										case '\'':
+											if buffer[position] != rune('\'') {
+												goto l57
+											}
+											position++
+										case '"':
+											if buffer[position] != rune('"') {
+												goto l57
+											}
+											position++
+										case ')':
+											if buffer[position] != rune(')') {
+												goto l57
+											}
+											position++
+										case '(':
+											if buffer[position] != rune('(') {
+												goto l57
+											}
+											position++
+										case '\\':
+											if buffer[position] != rune('\\') {
+												goto l57
+											}
+											position++
+										case '\r':
+											if buffer[position] != rune('\r') {
+												goto l57
+											}
+											position++
+										case '\n':
+											if buffer[position] != rune('\n') {
+												goto l57
+											}
+											position++
+										case '\t':
+											if buffer[position] != rune('\t') {
+												goto l57
+											}
+											position++
+										default:
+											if buffer[position] != rune(' ') {
+												goto l57
+											}
+											position++
+										}
 									}
-									position++
+
+									goto l31
+								l57:
+									position, tokenIndex = position57, tokenIndex57
 								}
-							l45:
-								{
-									position47, tokenIndex47, depth47 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l48
-									}
-									position++
-									goto l47
-								l48:
-									position, tokenIndex, depth = position47, tokenIndex47, depth47
-									if buffer[position] != rune('S') {
-										goto l16
-									}
-									position++
+								if !matchDot() {
+									goto l31
 								}
-							l47:
-								{
-									position49, tokenIndex49, depth49 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l50
-									}
-									position++
-									goto l49
-								l50:
-									position, tokenIndex, depth = position49, tokenIndex49, depth49
-									if buffer[position] != rune('T') {
-										goto l16
-									}
-									position++
-								}
-							l49:
+							l55:
 								{
-									position51, tokenIndex51, depth51 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l52
+									position56, tokenIndex56 := position, tokenIndex
+									{
+										position59, tokenIndex59 := position, tokenIndex
+										{
+											switch buffer[position] {
+											case '<':
+												if buffer[position] != rune('<') {
+													goto l59
+												}
+												position++
+											case '>':
+												if buffer[position] != rune('>') {
+													goto l59
+												}
+												position++
+											case '=':
+												if buffer[position] != rune('=') {
+													goto l59
+												}
+												position++
+											case '\'':
+												if buffer[position] != rune('\'') {
+													goto l59
+												}
+												position++
+											case '"':
+												if buffer[position] != rune('"') {
+													goto l59
+												}
+												position++
+											case ')':
+												if buffer[position] != rune(')') {
+													goto l59
+												}
+												position++
+											case '(':
+												if buffer[position] != rune('(') {
+													goto l59
+												}
+												position++
+											case '\\':
+												if buffer[position] != rune('\\') {
+													goto l59
+												}
+												position++
+											case '\r':
+												if buffer[position] != rune('\r') {
+													goto l59
+												}
+												position++
+											case '\n':
+												if buffer[position] != rune('\n') {
+													goto l59
+												}
+												position++
+											case '\t':
+												if buffer[position] != rune('\t') {
+													goto l59
+												}
+												position++
+											default:
+												if buffer[position] != rune(' ') {
+													goto l59
+												}
+												position++
+											}
+										}
+
+										goto l56
+									l59:
+										position, tokenIndex = position59, tokenIndex59
 									}
-									position++
-									goto l51
-								l52:
-									position, tokenIndex, depth = position51, tokenIndex51, depth51
-									if buffer[position] != rune('S') {
-										goto l16
+									if !matchDot() {
+										goto l56
 									}
-									position++
+									goto l55
+								l56:
+									position, tokenIndex = position56, tokenIndex56
 								}
-							l51:
-								depth--
-								add(ruleexists, position40)
+								add(rulePegText, position54)
 							}
-							break
-						case '=':
+							add(ruletag, position53)
+						}
+					l61:
+						{
+							position62, tokenIndex62 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l62
+							}
+							position++
+							goto l61
+						l62:
+							position, tokenIndex = position62, tokenIndex62
+						}
+						{
+							position63, tokenIndex63 := position, tokenIndex
 							{
-								position53 := position
-								depth++
+								position65 := position
+								if buffer[position] != rune('<') {
+									goto l64
+								}
+								position++
 								if buffer[position] != rune('=') {
-									goto l16
+									goto l64
 								}
 								position++
-								depth--
-								add(ruleequal, position53)
+								add(rulele, position65)
 							}
-						l54:
+						l66:
 							{
-								position55, tokenIndex55, depth55 := position, tokenIndex, depth
+								position67, tokenIndex67 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l55
+									goto l67
 								}
 								position++
-								goto l54
-							l55:
-								position, tokenIndex, depth = position55, tokenIndex55, depth55
+								goto l66
+							l67:
+								position, tokenIndex = position67, tokenIndex67
 							}
 							{
 								switch buffer[position] {
-								case '\'':
-									if !_rules[rulevalue]() {
-										goto l16
-									}
-									break
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l64
 									}
-									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l64
 									}
-									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l64
 									}
-									break
 								}
 							}
 
-							break
-						case '>':
+							goto l63
+						l64:
+							position, tokenIndex = position63, tokenIndex63
 							{
-								position57 := position
-								depth++
+								position70 := position
 								if buffer[position] != rune('>') {
-									goto l16
+									goto l69
 								}
 								position++
-								depth--
-								add(ruleg, position57)
-							}
-						l58:
-							{
-								position59, tokenIndex59, depth59 := position, tokenIndex, depth
-								if buffer[position] != rune(' ') {
-									goto l59
-								}
-								position++
-								goto l58
-							l59:
-								position, tokenIndex, depth = position59, tokenIndex59, depth59
-							}
-							{
-								switch buffer[position] {
-								case 'D', 'd':
-									if !_rules[ruledate]() {
-										goto l16
-									}
-									break
-								case 'T', 't':
-									if !_rules[ruletime]() {
-										goto l16
-									}
-									break
-								default:
-									if !_rules[rulenumber]() {
-										goto l16
-									}
-									break
-								}
-							}
-
-							break
-						case '<':
-							{
-								position61 := position
-								depth++
-								if buffer[position] != rune('<') {
-									goto l16
+								if buffer[position] != rune('=') {
+									goto l69
 								}
 								position++
-								depth--
-								add(rulel, position61)
+								add(rulege, position70)
 							}
-						l62:
+						l71:
 							{
-								position63, tokenIndex63, depth63 := position, tokenIndex, depth
+								position72, tokenIndex72 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l63
+									goto l72
 								}
 								position++
-								goto l62
-							l63:
-								position, tokenIndex, depth = position63, tokenIndex63, depth63
+								goto l71
+							l72:
+								position, tokenIndex = position72, tokenIndex72
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l69
 									}
-									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l69
 									}
-									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l69
 									}
-									break
 								}
 							}
 
-							break
-						default:
+							goto l63
+						l69:
+							position, tokenIndex = position63, tokenIndex63
 							{
-								position65 := position
-								depth++
-								{
-									position66, tokenIndex66, depth66 := position, tokenIndex, depth
-									if buffer[position] != rune('c') {
-										goto l67
-									}
-									position++
-									goto l66
-								l67:
-									position, tokenIndex, depth = position66, tokenIndex66, depth66
-									if buffer[position] != rune('C') {
-										goto l16
-									}
-									position++
-								}
-							l66:
-								{
-									position68, tokenIndex68, depth68 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l69
-									}
-									position++
-									goto l68
-								l69:
-									position, tokenIndex, depth = position68, tokenIndex68, depth68
-									if buffer[position] != rune('O') {
-										goto l16
+								switch buffer[position] {
+								case 'E', 'e':
+									{
+										position75 := position
+										{
+											position76, tokenIndex76 := position, tokenIndex
+											if buffer[position] != rune('e') {
+												goto l77
+											}
+											position++
+											goto l76
+										l77:
+											position, tokenIndex = position76, tokenIndex76
+											if buffer[position] != rune('E') {
+												goto l31
+											}
+											position++
+										}
+									l76:
+										{
+											position78, tokenIndex78 := position, tokenIndex
+											if buffer[position] != rune('x') {
+												goto l79
+											}
+											position++
+											goto l78
+										l79:
+											position, tokenIndex = position78, tokenIndex78
+											if buffer[position] != rune('X') {
+												goto l31
+											}
+											position++
+										}
+									l78:
+										{
+											position80, tokenIndex80 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l81
+											}
+											position++
+											goto l80
+										l81:
+											position, tokenIndex = position80, tokenIndex80
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l80:
+										{
+											position82, tokenIndex82 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l83
+											}
+											position++
+											goto l82
+										l83:
+											position, tokenIndex = position82, tokenIndex82
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l82:
+										{
+											position84, tokenIndex84 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l85
+											}
+											position++
+											goto l84
+										l85:
+											position, tokenIndex = position84, tokenIndex84
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l84:
+										{
+											position86, tokenIndex86 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l87
+											}
+											position++
+											goto l86
+										l87:
+											position, tokenIndex = position86, tokenIndex86
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l86:
+										add(ruleexists, position75)
 									}
-									position++
-								}
-							l68:
-								{
-									position70, tokenIndex70, depth70 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l71
+								case '=':
+									{
+										position88 := position
+										if buffer[position] != rune('=') {
+											goto l31
+										}
+										position++
+										add(ruleequal, position88)
 									}
-									position++
-									goto l70
-								l71:
-									position, tokenIndex, depth = position70, tokenIndex70, depth70
-									if buffer[position] != rune('N') {
-										goto l16
+								l89:
+									{
+										position90, tokenIndex90 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l90
+										}
+										position++
+										goto l89
+									l90:
+										position, tokenIndex = position90, tokenIndex90
 									}
-									position++
-								}
-							l70:
-								{
-									position72, tokenIndex72, depth72 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l73
+									{
+										switch buffer[position] {
+										case '\'':
+											if !_rules[rulevalue]() {
+												goto l31
+											}
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-									goto l72
-								l73:
-									position, tokenIndex, depth = position72, tokenIndex72, depth72
-									if buffer[position] != rune('T') {
-										goto l16
+
+								case '>':
+									{
+										position92 := position
+										if buffer[position] != rune('>') {
+											goto l31
+										}
+										position++
+										add(ruleg, position92)
 									}
-									position++
-								}
-							l72:
-								{
-									position74, tokenIndex74, depth74 := position, tokenIndex, depth
-									if buffer[position] != rune('a') {
-										goto l75
+								l93:
+									{
+										position94, tokenIndex94 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l94
+										}
+										position++
+										goto l93
+									l94:
+										position, tokenIndex = position94, tokenIndex94
 									}
-									position++
-									goto l74
-								l75:
-									position, tokenIndex, depth = position74, tokenIndex74, depth74
-									if buffer[position] != rune('A') {
-										goto l16
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-								}
-							l74:
-								{
-									position76, tokenIndex76, depth76 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l77
+
+								case '<':
+									{
+										position96 := position
+										if buffer[position] != rune('<') {
+											goto l31
+										}
+										position++
+										add(rulel, position96)
 									}
-									position++
-									goto l76
-								l77:
-									position, tokenIndex, depth = position76, tokenIndex76, depth76
-									if buffer[position] != rune('I') {
-										goto l16
+								l97:
+									{
+										position98, tokenIndex98 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l98
+										}
+										position++
+										goto l97
+									l98:
+										position, tokenIndex = position98, tokenIndex98
 									}
-									position++
-								}
-							l76:
-								{
-									position78, tokenIndex78, depth78 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l79
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l31
+											}
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l31
+											}
+										default:
+											if !_rules[rulenumber]() {
+												goto l31
+											}
+										}
 									}
-									position++
-									goto l78
-								l79:
-									position, tokenIndex, depth = position78, tokenIndex78, depth78
-									if buffer[position] != rune('N') {
-										goto l16
+
+								default:
+									{
+										position100 := position
+										{
+											position101, tokenIndex101 := position, tokenIndex
+											if buffer[position] != rune('c') {
+												goto l102
+											}
+											position++
+											goto l101
+										l102:
+											position, tokenIndex = position101, tokenIndex101
+											if buffer[position] != rune('C') {
+												goto l31
+											}
+											position++
+										}
+									l101:
+										{
+											position103, tokenIndex103 := position, tokenIndex
+											if buffer[position] != rune('o') {
+												goto l104
+											}
+											position++
+											goto l103
+										l104:
+											position, tokenIndex = position103, tokenIndex103
+											if buffer[position] != rune('O') {
+												goto l31
+											}
+											position++
+										}
+									l103:
+										{
+											position105, tokenIndex105 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l106
+											}
+											position++
+											goto l105
+										l106:
+											position, tokenIndex = position105, tokenIndex105
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l105:
+										{
+											position107, tokenIndex107 := position, tokenIndex
+											if buffer[position] != rune('t') {
+												goto l108
+											}
+											position++
+											goto l107
+										l108:
+											position, tokenIndex = position107, tokenIndex107
+											if buffer[position] != rune('T') {
+												goto l31
+											}
+											position++
+										}
+									l107:
+										{
+											position109, tokenIndex109 := position, tokenIndex
+											if buffer[position] != rune('a') {
+												goto l110
+											}
+											position++
+											goto l109
+										l110:
+											position, tokenIndex = position109, tokenIndex109
+											if buffer[position] != rune('A') {
+												goto l31
+											}
+											position++
+										}
+									l109:
+										{
+											position111, tokenIndex111 := position, tokenIndex
+											if buffer[position] != rune('i') {
+												goto l112
+											}
+											position++
+											goto l111
+										l112:
+											position, tokenIndex = position111, tokenIndex111
+											if buffer[position] != rune('I') {
+												goto l31
+											}
+											position++
+										}
+									l111:
+										{
+											position113, tokenIndex113 := position, tokenIndex
+											if buffer[position] != rune('n') {
+												goto l114
+											}
+											position++
+											goto l113
+										l114:
+											position, tokenIndex = position113, tokenIndex113
+											if buffer[position] != rune('N') {
+												goto l31
+											}
+											position++
+										}
+									l113:
+										{
+											position115, tokenIndex115 := position, tokenIndex
+											if buffer[position] != rune('s') {
+												goto l116
+											}
+											position++
+											goto l115
+										l116:
+											position, tokenIndex = position115, tokenIndex115
+											if buffer[position] != rune('S') {
+												goto l31
+											}
+											position++
+										}
+									l115:
+										add(rulecontains, position100)
 									}
-									position++
-								}
-							l78:
-								{
-									position80, tokenIndex80, depth80 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l81
+								l117:
+									{
+										position118, tokenIndex118 := position, tokenIndex
+										if buffer[position] != rune(' ') {
+											goto l118
+										}
+										position++
+										goto l117
+									l118:
+										position, tokenIndex = position118, tokenIndex118
 									}
-									position++
-									goto l80
-								l81:
-									position, tokenIndex, depth = position80, tokenIndex80, depth80
-									if buffer[position] != rune('S') {
-										goto l16
+									if !_rules[rulevalue]() {
+										goto l31
 									}
-									position++
 								}
-							l80:
-								depth--
-								add(rulecontains, position65)
 							}
-						l82:
-							{
-								position83, tokenIndex83, depth83 := position, tokenIndex, depth
-								if buffer[position] != rune(' ') {
-									goto l83
-								}
-								position++
-								goto l82
-							l83:
-								position, tokenIndex, depth = position83, tokenIndex83, depth83
-							}
-							if !_rules[rulevalue]() {
-								goto l16
-							}
-							break
+
 						}
+					l63:
+						add(rulecondition, position52)
 					}
-
 				}
-			l28:
-				depth--
-				add(rulecondition, position17)
+			l33:
+				add(ruleterm, position32)
 			}
 			return true
-		l16:
-			position, tokenIndex, depth = position16, tokenIndex16, depth16
+		l31:
+			position, tokenIndex = position31, tokenIndex31
 			return false
 		},
-		/* 2 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		/* 4 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		nil,
+		/* 5 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
 		nil,
-		/* 3 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
+		/* 6 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
 		func() bool {
-			position85, tokenIndex85, depth85 := position, tokenIndex, depth
+			position121, tokenIndex121 := position, tokenIndex
 			{
-				position86 := position
-				depth++
+				position122 := position
 				{
-					position87 := position
-					depth++
+					position123 := position
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l121
 					}
 					position++
-				l88:
+				l124:
 					{
-						position89, tokenIndex89, depth89 := position, tokenIndex, depth
+						position125, tokenIndex125 := position, tokenIndex
 						{
-							position90, tokenIndex90, depth90 := position, tokenIndex, depth
+							position126, tokenIndex126 := position, tokenIndex
 							{
-								position91, tokenIndex91, depth91 := position, tokenIndex, depth
+								position127, tokenIndex127 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l92
+									goto l128
 								}
 								position++
-								goto l91
-							l92:
-								position, tokenIndex, depth = position91, tokenIndex91, depth91
+								goto l127
+							l128:
+								position, tokenIndex = position127, tokenIndex127
 								if buffer[position] != rune('\'') {
-									goto l90
+									goto l126
 								}
 								position++
 							}
-						l91:
-							goto l89
-						l90:
-							position, tokenIndex, depth = position90, tokenIndex90, depth90
+						l127:
+							goto l125
+						l126:
+							position, tokenIndex = position126, tokenIndex126
 						}
 						if !matchDot() {
-							goto l89
+							goto l125
 						}
-						goto l88
-					l89:
-						position, tokenIndex, depth = position89, tokenIndex89, depth89
+						goto l124
+					l125:
+						position, tokenIndex = position125, tokenIndex125
 					}
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l121
 					}
 					position++
-					depth--
-					add(rulePegText, position87)
+					add(rulePegText, position123)
 				}
-				depth--
-				add(rulevalue, position86)
+				add(rulevalue, position122)
 			}
 			return true
-		l85:
-			position, tokenIndex, depth = position85, tokenIndex85, depth85
+		l121:
+			position, tokenIndex = position121, tokenIndex121
 			return false
 		},
-		/* 4 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
+		/* 7 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
 		func() bool {
-			position93, tokenIndex93, depth93 := position, tokenIndex, depth
+			position129, tokenIndex129 := position, tokenIndex
 			{
-				position94 := position
-				depth++
+				position130 := position
 				{
-					position95 := position
-					depth++
+					position131 := position
 					{
-						position96, tokenIndex96, depth96 := position, tokenIndex, depth
+						position132, tokenIndex132 := position, tokenIndex
 						if buffer[position] != rune('0') {
-							goto l97
+							goto l133
 						}
 						position++
-						goto l96
-					l97:
-						position, tokenIndex, depth = position96, tokenIndex96, depth96
+						goto l132
+					l133:
+						position, tokenIndex = position132, tokenIndex132
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l93
+							goto l129
 						}
 						position++
-					l98:
+					l134:
 						{
-							position99, tokenIndex99, depth99 := position, tokenIndex, depth
+							position135, tokenIndex135 := position, tokenIndex
 							if !_rules[ruledigit]() {
-								goto l99
+								goto l135
 							}
-							goto l98
-						l99:
-							position, tokenIndex, depth = position99, tokenIndex99, depth99
+							goto l134
+						l135:
+							position, tokenIndex = position135, tokenIndex135
 						}
 						{
-							position100, tokenIndex100, depth100 := position, tokenIndex, depth
+							position136, tokenIndex136 := position, tokenIndex
 							if buffer[position] != rune('.') {
-								goto l100
+								goto l136
 							}
 							position++
-						l102:
+						l138:
 							{
-								position103, tokenIndex103, depth103 := position, tokenIndex, depth
+								position139, tokenIndex139 := position, tokenIndex
 								if !_rules[ruledigit]() {
-									goto l103
+									goto l139
 								}
-								goto l102
-							l103:
-								position, tokenIndex, depth = position103, tokenIndex103, depth103
+								goto l138
+							l139:
+								position, tokenIndex = position139, tokenIndex139
 							}
-							goto l101
-						l100:
-							position, tokenIndex, depth = position100, tokenIndex100, depth100
+							goto l137
+						l136:
+							position, tokenIndex = position136, tokenIndex136
 						}
-					l101:
+					l137:
 					}
-				l96:
-					depth--
-					add(rulePegText, position95)
+				l132:
+					add(rulePegText, position131)
 				}
-				depth--
-				add(rulenumber, position94)
+				add(rulenumber, position130)
 			}
 			return true
-		l93:
-			position, tokenIndex, depth = position93, tokenIndex93, depth93
+		l129:
+			position, tokenIndex = position129, tokenIndex129
 			return false
 		},
-		/* 5 digit <- <[0-9]> */
+		/* 8 digit <- <[0-9]> */
 		func() bool {
-			position104, tokenIndex104, depth104 := position, tokenIndex, depth
+			position140, tokenIndex140 := position, tokenIndex
 			{
-				position105 := position
-				depth++
+				position141 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l104
+					goto l140
 				}
 				position++
-				depth--
-				add(ruledigit, position105)
+				add(ruledigit, position141)
 			}
 			return true
-		l104:
-			position, tokenIndex, depth = position104, tokenIndex104, depth104
+		l140:
+			position, tokenIndex = position140, tokenIndex140
 			return false
 		},
-		/* 6 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
+		/* 9 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
 		func() bool {
-			position106, tokenIndex106, depth106 := position, tokenIndex, depth
+			position142, tokenIndex142 := position, tokenIndex
 			{
-				position107 := position
-				depth++
+				position143 := position
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position144, tokenIndex144 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l109
+						goto l145
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l144
+				l145:
+					position, tokenIndex = position144, tokenIndex144
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l142
 					}
 					position++
 				}
-			l108:
+			l144:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position146, tokenIndex146 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l111
+						goto l147
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l146
+				l147:
+					position, tokenIndex = position146, tokenIndex146
 					if buffer[position] != rune('I') {
-						goto l106
+						goto l142
 					}
 					position++
 				}
-			l110:
+			l146:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position148, tokenIndex148 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l113
+						goto l149
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l148
+				l149:
+					position, tokenIndex = position148, tokenIndex148
 					if buffer[position] != rune('M') {
-						goto l106
+						goto l142
 					}
 					position++
 				}
-			l112:
+			l148:
 				{
-					position114, tokenIndex114, depth114 := position, tokenIndex, depth
+					position150, tokenIndex150 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l151
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex, depth = position114, tokenIndex114, depth114
+					goto l150
+				l151:
+					position, tokenIndex = position150, tokenIndex150
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l142
 					}
 					position++
 				}
-			l114:
+			l150:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l142
 				}
 				position++
 				{
-					position116 := position
-					depth++
+					position152 := position
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l142
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l142
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l142
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l142
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l142
 					}
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l142
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l142
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l142
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l142
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l142
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l142
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l142
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l142
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l142
 					}
 					{
-						position117, tokenIndex117, depth117 := position, tokenIndex, depth
+						position153, tokenIndex153 := position, tokenIndex
 						{
-							position119, tokenIndex119, depth119 := position, tokenIndex, depth
+							position155, tokenIndex155 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l120
+								goto l156
 							}
 							position++
-							goto l119
-						l120:
-							position, tokenIndex, depth = position119, tokenIndex119, depth119
+							goto l155
+						l156:
+							position, tokenIndex = position155, tokenIndex155
 							if buffer[position] != rune('+') {
-								goto l118
+								goto l154
 							}
 							position++
 						}
-					l119:
+					l155:
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l154
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l154
 						}
 						if buffer[position] != rune(':') {
-							goto l118
+							goto l154
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l154
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l154
 						}
-						goto l117
-					l118:
-						position, tokenIndex, depth = position117, tokenIndex117, depth117
+						goto l153
+					l154:
+						position, tokenIndex = position153, tokenIndex153
 						if buffer[position] != rune('Z') {
-							goto l106
+							goto l142
 						}
 						position++
 					}
-				l117:
-					depth--
-					add(rulePegText, position116)
+				l153:
+					add(rulePegText, position152)
 				}
-				depth--
-				add(ruletime, position107)
+				add(ruletime, position143)
 			}
 			return true
-		l106:
-			position, tokenIndex, depth = position106, tokenIndex106, depth106
+		l142:
+			position, tokenIndex = position142, tokenIndex142
 			return false
 		},
-		/* 7 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
+		/* 10 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
 		func() bool {
-			position121, tokenIndex121, depth121 := position, tokenIndex, depth
+			position157, tokenIndex157 := position, tokenIndex
 			{
-				position122 := position
-				depth++
+				position158 := position
 				{
-					position123, tokenIndex123, depth123 := position, tokenIndex, depth
+					position159, tokenIndex159 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l124
+						goto l160
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex, depth = position123, tokenIndex123, depth123
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
 					if buffer[position] != rune('D') {
-						goto l121
+						goto l157
 					}
 					position++
 				}
-			l123:
+			l159:
 				{
-					position125, tokenIndex125, depth125 := position, tokenIndex, depth
+					position161, tokenIndex161 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l126
+						goto l162
 					}
 					position++
-					goto l125
-				l126:
-					position, tokenIndex, depth = position125, tokenIndex125, depth125
+					goto l161
+				l162:
+					position, tokenIndex = position161, tokenIndex161
 					if buffer[position] != rune('A') {
-						goto l121
+						goto l157
 					}
 					position++
 				}
-			l125:
+			l161:
 				{
-					position127, tokenIndex127, depth127 := position, tokenIndex, depth
+					position163, tokenIndex163 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l128
+						goto l164
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex, depth = position127, tokenIndex127, depth127
+					goto l163
+				l164:
+					position, tokenIndex = position163, tokenIndex163
 					if buffer[position] != rune('T') {
-						goto l121
+						goto l157
 					}
 					position++
 				}
-			l127:
+			l163:
 				{
-					position129, tokenIndex129, depth129 := position, tokenIndex, depth
+					position165, tokenIndex165 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l130
+						goto l166
 					}
 					position++
-					goto l129
-				l130:
-					position, tokenIndex, depth = position129, tokenIndex129, depth129
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
 					if buffer[position] != rune('E') {
-						goto l121
+						goto l157
 					}
 					position++
 				}
-			l129:
+			l165:
 				if buffer[position] != rune(' ') {
-					goto l121
+					goto l157
 				}
 				position++
 				{
-					position131 := position
-					depth++
+					position167 := position
 					if !_rules[ruleyear]() {
-						goto l121
+						goto l157
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l157
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l121
+						goto l157
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l157
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l121
+						goto l157
 					}
-					depth--
-					add(rulePegText, position131)
+					add(rulePegText, position167)
 				}
-				depth--
-				add(ruledate, position122)
+				add(ruledate, position158)
 			}
 			return true
-		l121:
-			position, tokenIndex, depth = position121, tokenIndex121, depth121
+		l157:
+			position, tokenIndex = position157, tokenIndex157
 			return false
 		},
-		/* 8 year <- <(('1' / '2') digit digit digit)> */
+		/* 11 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position132, tokenIndex132, depth132 := position, tokenIndex, depth
+			position168, tokenIndex168 := position, tokenIndex
 			{
-				position133 := position
-				depth++
+				position169 := position
 				{
-					position134, tokenIndex134, depth134 := position, tokenIndex, depth
+					position170, tokenIndex170 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l135
+						goto l171
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex, depth = position134, tokenIndex134, depth134
+					goto l170
+				l171:
+					position, tokenIndex = position170, tokenIndex170
 					if buffer[position] != rune('2') {
-						goto l132
+						goto l168
 					}
 					position++
 				}
-			l134:
+			l170:
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l168
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l168
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l168
 				}
-				depth--
-				add(ruleyear, position133)
+				add(ruleyear, position169)
 			}
 			return true
-		l132:
-			position, tokenIndex, depth = position132, tokenIndex132, depth132
+		l168:
+			position, tokenIndex = position168, tokenIndex168
 			return false
 		},
-		/* 9 month <- <(('0' / '1') digit)> */
+		/* 12 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position136, tokenIndex136, depth136 := position, tokenIndex, depth
+			position172, tokenIndex172 := position, tokenIndex
 			{
-				position137 := position
-				depth++
+				position173 := position
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
+					position174, tokenIndex174 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l139
+						goto l175
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l174
+				l175:
+					position, tokenIndex = position174, tokenIndex174
 					if buffer[position] != rune('1') {
-						goto l136
+						goto l172
 					}
 					position++
 				}
-			l138:
+			l174:
 				if !_rules[ruledigit]() {
-					goto l136
+					goto l172
 				}
-				depth--
-				add(rulemonth, position137)
+				add(rulemonth, position173)
 			}
 			return true
-		l136:
-			position, tokenIndex, depth = position136, tokenIndex136, depth136
+		l172:
+			position, tokenIndex = position172, tokenIndex172
 			return false
 		},
-		/* 10 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
+		/* 13 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
 		func() bool {
-			position140, tokenIndex140, depth140 := position, tokenIndex, depth
+			position176, tokenIndex176 := position, tokenIndex
 			{
-				position141 := position
-				depth++
+				position177 := position
 				{
 					switch buffer[position] {
 					case '3':
 						if buffer[position] != rune('3') {
-							goto l140
+							goto l176
 						}
 						position++
-						break
 					case '2':
 						if buffer[position] != rune('2') {
-							goto l140
+							goto l176
 						}
 						position++
-						break
 					case '1':
 						if buffer[position] != rune('1') {
-							goto l140
+							goto l176
 						}
 						position++
-						break
 					default:
 						if buffer[position] != rune('0') {
-							goto l140
+							goto l176
 						}
 						position++
-						break
 					}
 				}
 
 				if !_rules[ruledigit]() {
-					goto l140
+					goto l176
 				}
-				depth--
-				add(ruleday, position141)
+				add(ruleday, position177)
 			}
 			return true
-		l140:
-			position, tokenIndex, depth = position140, tokenIndex140, depth140
+		l176:
+			position, tokenIndex = position176, tokenIndex176
 			return false
 		},
-		/* 11 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		/* 14 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		nil,
+		/* 15 or <- <(('o' / 'O') ('r' / 'R'))> */
+		nil,
+		/* 16 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T'))> */
 		nil,
-		/* 12 equal <- <'='> */
+		/* 17 equal <- <'='> */
 		nil,
-		/* 13 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
+		/* 18 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
 		nil,
-		/* 14 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
+		/* 19 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
 		nil,
-		/* 15 le <- <('<' '=')> */
+		/* 20 le <- <('<' '=')> */
 		nil,
-		/* 16 ge <- <('>' '=')> */
+		/* 21 ge <- <('>' '=')> */
 		nil,
-		/* 17 l <- <'<'> */
+		/* 22 l <- <'<'> */
 		nil,
-		/* 18 g <- <'>'> */
+		/* 23 g <- <'>'> */
 		nil,
 		nil,
 	}
 	p.rules = _rules
+	return nil
 }
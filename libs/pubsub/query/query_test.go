@@ -150,6 +150,47 @@ func TestMatches(t *testing.T) {
 			false,
 			false,
 		},
+		{"tx.gas < 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"tx.gas < 7 OR tx.gas > 9", map[string][]string{"tx.gas": {"10"}}, false, true, false},
+		{"NOT tx.gas = 8", map[string][]string{"tx.gas": {"8"}}, false, false, false},
+		{"NOT tx.gas = 8", map[string][]string{"tx.gas": {"7"}}, false, true, false},
+		{"NOT slash EXISTS", map[string][]string{"tx.gas": {"7"}}, false, true, false},
+		{
+			"tm.event='Tx' AND (transfer.sender='Ivan' OR transfer.recipient='Ivan')",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.sender": {"Igor"}, "transfer.recipient": {"Ivan"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"tm.event='Tx' AND (transfer.sender='Ivan' OR transfer.recipient='Ivan')",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.sender": {"Igor"}, "transfer.recipient": {"Eric"}},
+			false,
+			false,
+			false,
+		},
+		{
+			// AND binds tighter than OR
+			"tm.event='NewBlock' AND tx.gas > 100 OR transfer.sender='Ivan'",
+			map[string][]string{"tm.event": {"Tx"}, "transfer.sender": {"Ivan"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"NOT (tx.gas > 7 AND tx.gas < 9)",
+			map[string][]string{"tx.gas": {"8"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"NOT tx.date > DATE 2017-01-01",
+			map[string][]string{"tx.date": {"invalid"}},
+			false,
+			false,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -220,3 +261,39 @@ func TestConditions(t *testing.T) {
 		assert.Equal(t, tc.conditions, c)
 	}
 }
+
+func TestExpression(t *testing.T) {
+	q := query.MustParse("tm.event='Tx' AND (tx.gas > 7 OR NOT slashing EXISTS)")
+
+	expr, err := q.Expression()
+	require.NoError(t, err)
+	assert.Equal(t, &query.Expr{
+		Op: query.ExprAnd,
+		Operands: []*query.Expr{
+			{Op: query.ExprCondition, Condition: query.Condition{CompositeKey: "tm.event", Op: query.OpEqual, Operand: "Tx"}},
+			{Op: query.ExprOr, Operands: []*query.Expr{
+				{Op: query.ExprCondition, Condition: query.Condition{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)}},
+				{Op: query.ExprNot, Operands: []*query.Expr{
+					{Op: query.ExprCondition, Condition: query.Condition{CompositeKey: "slashing", Op: query.OpExists}},
+				}},
+			}},
+		},
+	}, expr)
+
+	// the expression is compiled once
+	again, err := q.Expression()
+	require.NoError(t, err)
+	assert.Same(t, expr, again)
+
+	// only conjunctions can be flattened into conditions
+	_, err = q.Conditions()
+	assert.Error(t, err)
+
+	c, err := query.MustParse("tx.gas > 7 AND (tx.gas < 9 AND slashing EXISTS)").Conditions()
+	require.NoError(t, err)
+	assert.Equal(t, []query.Condition{
+		{CompositeKey: "tx.gas", Op: query.OpGreater, Operand: int64(7)},
+		{CompositeKey: "tx.gas", Op: query.OpLess, Operand: int64(9)},
+		{CompositeKey: "slashing", Op: query.OpExists},
+	}, c)
+}
@@ -1,6 +1,8 @@
 package proxy
 
 import (
+	"time"
+
 	"github.com/tendermint/tendermint/libs/bytes"
 	lrpc "github.com/tendermint/tendermint/light/rpc"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -10,45 +12,65 @@ import (
 	"github.com/tendermint/tendermint/types"
 )
 
+// RPCRoutes returns the routes of the light client proxy, which mirror the
+// routes of rpc/core. The results of the routes, which can't be verified
+// against light blocks, are marked as unverified.
 func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
+	unverified := rpcserver.Unverified()
 	return map[string]*rpcserver.RPCFunc{
 		// Subscribe/unsubscribe are reserved for websocket events.
-		"subscribe":       rpcserver.NewWSRPCFunc(c.SubscribeWS, "query"),
+		"subscribe":       rpcserver.NewWSRPCFunc(c.SubscribeWS, "query", unverified),
 		"unsubscribe":     rpcserver.NewWSRPCFunc(c.UnsubscribeWS, "query"),
 		"unsubscribe_all": rpcserver.NewWSRPCFunc(c.UnsubscribeAllWS, ""),
+		"events":          rpcserver.NewRPCFunc(makeEventsFunc(c), "filter,maxItems,after,waitTime", unverified),
 
 		// info API
-		"health":        rpcserver.NewRPCFunc(makeHealthFunc(c), ""),
-		"status":        rpcserver.NewRPCFunc(makeStatusFunc(c), ""),
-		"net_info":      rpcserver.NewRPCFunc(makeNetInfoFunc(c), ""),
+		"health":        rpcserver.NewRPCFunc(makeHealthFunc(c), "", unverified),
+		"status":        rpcserver.NewRPCFunc(makeStatusFunc(c), "", unverified),
+		"net_info":      rpcserver.NewRPCFunc(makeNetInfoFunc(c), "", unverified),
 		"blockchain":    rpcserver.NewRPCFunc(makeBlockchainInfoFunc(c), "minHeight,maxHeight"),
-		"genesis":       rpcserver.NewRPCFunc(makeGenesisFunc(c), ""),
+		"genesis":       rpcserver.NewRPCFunc(makeGenesisFunc(c), "", unverified),
 		"block":         rpcserver.NewRPCFunc(makeBlockFunc(c), "height"),
 		"block_by_hash": rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash"),
 		"block_results": rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height"),
 		"commit":        rpcserver.NewRPCFunc(makeCommitFunc(c), "height"),
+		"check_tx":      rpcserver.NewRPCFunc(makeCheckTxFunc(c), "tx", unverified),
 		"tx":            rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove"),
 		"tx_search":     rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
+		"block_search":  rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
 		"validators": rpcserver.NewRPCFunc(makeValidatorsFunc(c),
 			"height,page,per_page,request_threshold_public_key"),
 
-		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
-		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
+		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), "", unverified),
+		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), "", unverified),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height"),
-		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
-		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
+		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit", unverified),
+		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), "", unverified),
 
 		// tx broadcast API
-		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
-		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx"),
-		"broadcast_tx_async":  rpcserver.NewRPCFunc(makeBroadcastTxAsyncFunc(c), "tx"),
+		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx", unverified),
+		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx", unverified),
+		"broadcast_tx_async":  rpcserver.NewRPCFunc(makeBroadcastTxAsyncFunc(c), "tx", unverified),
 
 		// abci API
 		"abci_query": rpcserver.NewRPCFunc(makeABCIQueryFunc(c), "path,data,height,prove"),
-		"abci_info":  rpcserver.NewRPCFunc(makeABCIInfoFunc(c), ""),
+		"abci_info":  rpcserver.NewRPCFunc(makeABCIInfoFunc(c), "", unverified),
 
 		// evidence API
-		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence", unverified),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), "page,per_page", unverified),
+		"evidence_search": rpcserver.NewRPCFunc(makeEvidenceSearchFunc(c),
+			"min_height,max_height,type,pro_tx_hash,page,per_page"),
+	}
+}
+
+type rpcEventsFunc func(ctx *rpctypes.Context, filter string, maxItems int, after string,
+	waitTime time.Duration) (*ctypes.ResultEvents, error)
+
+func makeEventsFunc(c *lrpc.Client) rpcEventsFunc {
+	return func(ctx *rpctypes.Context, filter string, maxItems int, after string,
+		waitTime time.Duration) (*ctypes.ResultEvents, error) {
+		return c.Events(ctx.Context(), filter, maxItems, after, waitTime)
 	}
 }
 
@@ -69,10 +91,10 @@ func makeStatusFunc(c *lrpc.Client) rpcStatusFunc {
 	}
 }
 
-type rpcNetInfoFunc func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error)
+type rpcNetInfoFunc func(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error)
 
 func makeNetInfoFunc(c *lrpc.Client) rpcNetInfoFunc {
-	return func(ctx *rpctypes.Context, minHeight, maxHeight int64) (*ctypes.ResultNetInfo, error) {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultNetInfo, error) {
 		return c.NetInfo(ctx.Context())
 	}
 }
@@ -125,6 +147,14 @@ func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
 	}
 }
 
+type rpcCheckTxFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
+
+func makeCheckTxFunc(c *lrpc.Client) rpcCheckTxFunc {
+	return func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
+		return c.CheckTx(ctx.Context(), tx)
+	}
+}
+
 type rpcTxFunc func(ctx *rpctypes.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
 
 func makeTxFunc(c *lrpc.Client) rpcTxFunc {
@@ -153,28 +183,23 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 	}
 }
 
-/*
 type rpcBlockSearchFunc func(
 	ctx *rpctypes.Context,
 	query string,
-	prove bool,
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error)
-*/
 
-/*
 func makeBlockSearchFunc(c *lrpc.Client) rpcBlockSearchFunc {
 	return func(
 		ctx *rpctypes.Context,
 		query string,
-		prove bool,
 		page, perPage *int,
 		orderBy string,
 	) (*ctypes.ResultBlockSearch, error) {
 		return c.BlockSearch(ctx.Context(), query, page, perPage, orderBy)
 	}
-}*/
+}
 
 type rpcValidatorsFunc func(ctx *rpctypes.Context, height *int64,
 	page, perPage *int, requestThresholdPublicKey *bool) (*ctypes.ResultValidators, error)
@@ -280,3 +305,31 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context, page, perPage *int) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context, page, perPage *int) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(ctx.Context(), page, perPage)
+	}
+}
+
+type rpcEvidenceSearchFunc func(
+	ctx *rpctypes.Context,
+	minHeight, maxHeight int64,
+	evType string,
+	proTxHash bytes.HexBytes,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error)
+
+func makeEvidenceSearchFunc(c *lrpc.Client) rpcEvidenceSearchFunc {
+	return func(
+		ctx *rpctypes.Context,
+		minInfractionHeight, maxInfractionHeight int64,
+		evType string,
+		proTxHash bytes.HexBytes,
+		page, perPage *int,
+	) (*ctypes.ResultEvidenceSearch, error) {
+		return c.EvidenceSearch(ctx.Context(), minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage)
+	}
+}
@@ -0,0 +1,21 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	lrpc "github.com/tendermint/tendermint/light/rpc"
+	rpcmock "github.com/tendermint/tendermint/rpc/client/mocks"
+	"github.com/tendermint/tendermint/rpc/core"
+)
+
+func TestRPCRoutesCoverCoreRoutes(t *testing.T) {
+	routes := RPCRoutes(lrpc.NewClient(&rpcmock.Client{}, nil))
+	for name := range core.Routes {
+		assert.Contains(t, routes, name)
+	}
+	for name := range routes {
+		assert.Contains(t, core.Routes, name)
+	}
+}
@@ -25,6 +25,10 @@ import (
 
 var errNegOrZeroHeight = errors.New("negative or zero height")
 
+// ErrInvalidProof is returned by ABCIQuery and ABCIQueryWithOptions if the
+// response has no proof, or its proof doesn't verify.
+var ErrInvalidProof = errors.New("invalid proof")
+
 // KeyPathFunc builds a merkle path out of the given path and key.
 type KeyPathFunc func(path string, key []byte) (merkle.KeyPath, error)
 
@@ -40,8 +44,9 @@ type LightClient interface {
 var _ rpcclient.Client = (*Client)(nil)
 
 // Client is an RPC client, which uses light#Client to verify data (if it can
-// be proved). Note, merkle.DefaultProofRuntime is used to verify values
-// returned by ABCI#Query.
+// be proved). Note, the registered proof operators (see RegisterProofOp) are
+// used to verify values returned by ABCI#Query, unless the ProofRuntime option
+// is given.
 type Client struct {
 	service.BaseService
 
@@ -67,6 +72,25 @@ func KeyPathFn(fn KeyPathFunc) Option {
 	}
 }
 
+// ProofRuntime option sets the proof runtime used to verify values returned
+// by ABCIQuery. See NewProofRuntime.
+func ProofRuntime(prt *merkle.ProofRuntime) Option {
+	return func(c *Client) {
+		c.prt = prt
+	}
+}
+
+// KeyOnlyKeyPathFn creates a function used to generate merkle key paths,
+// which consist of the queried key only, regardless of the path. It suits
+// applications with a single state tree, whose root is the app hash.
+func KeyOnlyKeyPathFn() KeyPathFunc {
+	return func(_ string, key []byte) (merkle.KeyPath, error) {
+		kp := merkle.KeyPath{}
+		kp = kp.AppendKey(key, merkle.KeyEncodingURL)
+		return kp, nil
+	}
+}
+
 // DefaultMerkleKeyPathFn creates a function used to generate merkle key paths
 // from a path string and a key. This is the default used by the cosmos SDK.
 // This merkle key paths are required when verifying /abci_query calls
@@ -90,10 +114,14 @@ func DefaultMerkleKeyPathFn() KeyPathFunc {
 
 // NewClient returns a new client.
 func NewClient(next rpcclient.Client, lc LightClient, opts ...Option) *Client {
+	prt, err := NewProofRuntime()
+	if err != nil {
+		panic(err) // all the registered proof operators are known
+	}
 	c := &Client{
 		next: next,
 		lc:   lc,
-		prt:  merkle.DefaultProofRuntime(),
+		prt:  prt,
 	}
 	c.BaseService = *service.NewBaseService(nil, "Client", c)
 	for _, o := range opts {
@@ -130,7 +158,9 @@ func (c *Client) ABCIQuery(ctx context.Context, path string, data tmbytes.HexByt
 	return c.ABCIQueryWithOptions(ctx, path, data, rpcclient.DefaultABCIQueryOptions)
 }
 
-// ABCIQueryWithOptions returns an error if opts.Prove is false.
+// ABCIQueryWithOptions always requests a proof, and returns an error wrapping
+// ErrInvalidProof, instead of the response, if the proof doesn't verify the
+// value (or its absence) against the app hash of the light block.
 func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes,
 	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
 
@@ -151,7 +181,7 @@ func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data tmb
 		return nil, errors.New("empty key")
 	}
 	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
-		return nil, errors.New("no proof ops")
+		return nil, fmt.Errorf("%w: no proof ops", ErrInvalidProof)
 	}
 	if resp.Height <= 0 {
 		return nil, errNegOrZeroHeight
@@ -180,12 +210,12 @@ func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data tmb
 		// 2) verify value
 		err = c.prt.VerifyValue(resp.ProofOps, l.AppHash, kp.String(), resp.Value)
 		if err != nil {
-			return nil, fmt.Errorf("verify value proof: %w", err)
+			return nil, fmt.Errorf("%w: verify value proof: %v", ErrInvalidProof, err)
 		}
 	} else { // OR validate the absence proof against the trusted header.
 		err = c.prt.VerifyAbsence(resp.ProofOps, l.AppHash, string(resp.Key))
 		if err != nil {
-			return nil, fmt.Errorf("verify absence proof: %w", err)
+			return nil, fmt.Errorf("%w: verify absence proof: %v", ErrInvalidProof, err)
 		}
 	}
 
@@ -279,19 +309,13 @@ func (c *Client) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64)
 		}
 	}
 
-	// Update the light client if we're behind.
-	if len(res.BlockMetas) > 0 {
-		lastHeight := res.BlockMetas[len(res.BlockMetas)-1].Header.Height
-		if _, err := c.updateLightClientIfNeededTo(ctx, &lastHeight); err != nil {
-			return nil, err
-		}
-	}
-
-	// Verify each of the BlockMetas.
+	// Verify each of the BlockMetas, updating the light client if we're
+	// behind. The metas are in descending order, so the light client verifies
+	// the highest header first and the rest of them backwards.
 	for _, meta := range res.BlockMetas {
-		h, err := c.lc.TrustedLightBlock(meta.Header.Height)
+		h, err := c.updateLightClientIfNeededTo(ctx, &meta.Header.Height)
 		if err != nil {
-			return nil, fmt.Errorf("trusted header %d: %w", meta.Header.Height, err)
+			return nil, err
 		}
 		if bmH, tH := meta.Header.Hash(), h.Hash(); !bytes.Equal(bmH, tH) {
 			return nil, fmt.Errorf("block meta header %X does not match with trusted header %X",
@@ -312,31 +336,9 @@ func (c *Client) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock,
 	if err != nil {
 		return nil, err
 	}
-
-	// Validate res.
-	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
-	}
-	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
-	}
-	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, fmt.Errorf("blockID %X does not match with block %X",
-			bmH, bH)
-	}
-
-	// Update the light client if we're behind.
-	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
-	if err != nil {
+	if err := c.verifyBlock(ctx, res); err != nil {
 		return nil, err
 	}
-
-	// Verify block.
-	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, fmt.Errorf("block header %X does not match with trusted header %X",
-			bH, tH)
-	}
-
 	return res, nil
 }
 
@@ -346,32 +348,45 @@ func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBl
 	if err != nil {
 		return nil, err
 	}
+	if err := c.verifyBlock(ctx, res); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(res.BlockID.Hash, hash) {
+		return nil, fmt.Errorf("block %X does not match with requested hash %X", res.BlockID.Hash, hash)
+	}
+	return res, nil
+}
 
+// verifyBlock verifies the block against the trusted header at its height.
+func (c *Client) verifyBlock(ctx context.Context, res *ctypes.ResultBlock) error {
 	// Validate res.
+	if res.Block == nil {
+		return errors.New("nil block")
+	}
 	if err := res.BlockID.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if err := res.Block.ValidateBasic(); err != nil {
-		return nil, err
+		return err
 	}
 	if bmH, bH := res.BlockID.Hash, res.Block.Hash(); !bytes.Equal(bmH, bH) {
-		return nil, fmt.Errorf("blockID %X does not match with block %X",
+		return fmt.Errorf("blockID %X does not match with block %X",
 			bmH, bH)
 	}
 
 	// Update the light client if we're behind.
 	l, err := c.updateLightClientIfNeededTo(ctx, &res.Block.Height)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Verify block.
 	if bH, tH := res.Block.Hash(), l.Hash(); !bytes.Equal(bH, tH) {
-		return nil, fmt.Errorf("block header %X does not match with trusted header %X",
+		return fmt.Errorf("block header %X does not match with trusted header %X",
 			bH, tH)
 	}
 
-	return res, nil
+	return nil
 }
 
 // BlockResults returns the block results for the given height. If no height is
@@ -452,29 +467,31 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
-// Tx calls rpcclient#Tx method and then verifies the proof if such was
-// requested.
+// Tx calls rpcclient#Tx method, always requesting the proof, and then verifies
+// the tx against the trusted header and its result against the trusted block
+// results. The proof is removed from the result, unless it was requested.
 func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
-	res, err := c.next.Tx(ctx, hash, prove)
-	if err != nil || !prove {
-		return res, err
-	}
-
-	// Validate res.
-	if res.Height <= 0 {
-		return nil, errNegOrZeroHeight
+	res, err := c.next.Tx(ctx, hash, true)
+	if err != nil {
+		return nil, err
 	}
 
-	// Update the light client if we're behind.
-	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
-	if err != nil {
+	if err := c.verifyTx(ctx, res, make(map[int64]types.ABCIResults)); err != nil {
 		return nil, err
 	}
+	if !bytes.Equal(res.Hash, hash) {
+		return nil, fmt.Errorf("tx %X does not match with requested hash %X", res.Hash, hash)
+	}
 
-	// Validate the proof.
-	return res, res.Proof.Validate(l.DataHash)
+	if !prove {
+		res.Proof = types.TxProof{}
+	}
+	return res, nil
 }
 
+// TxSearch calls rpcclient#TxSearch, always requesting the proofs, and then
+// verifies every tx like Tx does. Note, the primary may still omit some of
+// the txs matching the query, which can't be detected.
 func (c *Client) TxSearch(
 	ctx context.Context,
 	query string,
@@ -482,16 +499,109 @@ func (c *Client) TxSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultTxSearch, error) {
-	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	res, err := c.next.TxSearch(ctx, query, true, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// the txs are often included in the same blocks
+	results := make(map[int64]types.ABCIResults)
+	for i, tx := range res.Txs {
+		if tx == nil {
+			return nil, fmt.Errorf("nil tx %d", i)
+		}
+		if err := c.verifyTx(ctx, tx, results); err != nil {
+			return nil, fmt.Errorf("invalid tx %d: %w", i, err)
+		}
+		if !prove {
+			tx.Proof = types.TxProof{}
+		}
+	}
+
+	return res, nil
 }
 
+// BlockSearch calls rpcclient#BlockSearch and then verifies every block like
+// Block does. Note, the primary may still omit some of the blocks matching the
+// query, which can't be detected.
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	res, err := c.next.BlockSearch(ctx, query, page, perPage, orderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, block := range res.Blocks {
+		if block == nil {
+			return nil, fmt.Errorf("nil block %d", i)
+		}
+		if err := c.verifyBlock(ctx, block); err != nil {
+			return nil, fmt.Errorf("invalid block %d: %w", i, err)
+		}
+	}
+
+	return res, nil
+}
+
+// verifyTx verifies the proof of the tx against the trusted header at its
+// height, and the deterministic fields of its result against the trusted
+// results of the block, which are fetched once for every height in results.
+func (c *Client) verifyTx(ctx context.Context, res *ctypes.ResultTx, results map[int64]types.ABCIResults) error {
+	// Validate res.
+	if res.Height <= 0 {
+		return errNegOrZeroHeight
+	}
+	if tH := res.Tx.Hash(); !bytes.Equal(tH, res.Hash) {
+		return fmt.Errorf("tx hash %X does not match with hash %X", tH, res.Hash)
+	}
+	if !bytes.Equal(res.Proof.Data, res.Tx) {
+		return errors.New("proof is for a different tx")
+	}
+	if res.Proof.Proof.Index != int64(res.Index) {
+		return fmt.Errorf("proof index %d does not match with tx index %d", res.Proof.Proof.Index, res.Index)
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return err
+	}
+
+	// Validate the proof.
+	if err := res.Proof.Validate(l.DataHash); err != nil {
+		return err
+	}
+
+	// Verify the result.
+	blockResults, ok := results[res.Height]
+	if !ok {
+		br, err := c.BlockResults(ctx, &res.Height)
+		if err != nil {
+			return fmt.Errorf("block results %d: %w", res.Height, err)
+		}
+		blockResults = types.NewResults(br.TxsResults)
+		results[res.Height] = blockResults
+	}
+	if int(res.Index) >= len(blockResults) {
+		return fmt.Errorf("tx index %d is out of the block results of %d txs", res.Index, len(blockResults))
+	}
+	rBz, err := types.NewResults([]*abci.ResponseDeliverTx{&res.TxResult})[0].Marshal()
+	if err != nil {
+		return err
+	}
+	tBz, err := blockResults[res.Index].Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(rBz, tBz) {
+		return errors.New("tx result does not match with trusted block results")
+	}
+
+	return nil
 }
 
 // Validators fetches and verifies validators.
@@ -531,11 +641,68 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+// PendingEvidence returns the pending evidence of the primary, which is not
+// verified.
+func (c *Client) PendingEvidence(ctx context.Context, page, perPage *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx, page, perPage)
+}
+
+// EvidenceSearch calls rpcclient#EvidenceSearch and verifies that every
+// evidence was committed in the block at its committed height. The blocks are
+// fetched and verified once for every height.
+func (c *Client) EvidenceSearch(
+	ctx context.Context,
+	minInfractionHeight, maxInfractionHeight int64,
+	evType string,
+	proTxHash tmbytes.HexBytes,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	res, err := c.next.EvidenceSearch(ctx, minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[int64]*types.Block)
+	for i, ev := range res.Evidence {
+		if ev == nil || ev.Evidence == nil {
+			return nil, fmt.Errorf("nil evidence %d", i)
+		}
+		if eH := ev.Evidence.Hash(); !bytes.Equal(eH, ev.Hash) {
+			return nil, fmt.Errorf("evidence %d hash %X does not match with hash %X", i, eH, ev.Hash)
+		}
+		if ev.CommittedHeight <= 0 {
+			return nil, fmt.Errorf("evidence %d: %w", i, errNegOrZeroHeight)
+		}
+
+		block, ok := blocks[ev.CommittedHeight]
+		if !ok {
+			resBlock, err := c.Block(ctx, &ev.CommittedHeight)
+			if err != nil {
+				return nil, err
+			}
+			block = resBlock.Block
+			blocks[ev.CommittedHeight] = block
+		}
+		if !block.Evidence.Evidence.Has(ev.Evidence) {
+			return nil, fmt.Errorf("evidence %d %X was not committed at height %d", i, ev.Hash, ev.CommittedHeight)
+		}
+	}
+
+	return res, nil
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
 	return c.next.Subscribe(ctx, subscriber, query, outCapacity...)
 }
 
+// Events returns events from the event log of the primary. Like events of
+// subscriptions, they are not verified.
+func (c *Client) Events(ctx context.Context, filter string, maxItems int, after string,
+	waitTime time.Duration) (*ctypes.ResultEvents, error) {
+	return c.next.Events(ctx, filter, maxItems, after, waitTime)
+}
+
 func (c *Client) Unsubscribe(ctx context.Context, subscriber, query string) error {
 	return c.next.Unsubscribe(ctx, subscriber, query)
 }
@@ -565,7 +732,8 @@ func (c *Client) RegisterOpDecoder(typ string, dec merkle.OpDecoder) {
 }
 
 // SubscribeWS subscribes for events using the given query and remote address as
-// a subscriber, but does not verify responses (UNSAFE)!
+// a subscriber, but does not verify responses (UNSAFE)! The events are marked
+// as unverified.
 // TODO: verify data
 func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
 	out, err := c.next.Subscribe(context.Background(), ctx.RemoteAddr(), query)
@@ -579,11 +747,12 @@ func (c *Client) SubscribeWS(ctx *rpctypes.Context, query string) (*ctypes.Resul
 			case resultEvent := <-out:
 				// We should have a switch here that performs a validation
 				// depending on the event's type.
-				ctx.WSConn.TryWriteRPCResponse(
-					rpctypes.NewRPCSuccessResponse(
-						rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
-						resultEvent,
-					))
+				res := rpctypes.NewRPCSuccessResponse(
+					rpctypes.JSONRPCStringID(fmt.Sprintf("%v#event", ctx.JSONReq.ID)),
+					resultEvent,
+				)
+				res.Unverified = true
+				ctx.WSConn.TryWriteRPCResponse(res)
 			case <-c.Quit():
 				return
 			}
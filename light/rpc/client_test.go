@@ -1,130 +1,351 @@
 package rpc
 
-//
-// // TestABCIQuery tests ABCIQuery requests and verifies proofs. HAPPY PATH 😀
-// func TestABCIQuery(t *testing.T) {
-//	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100)
-//	require.NoError(t, err)
-//
-//	var (
-//		key   = []byte("foo")
-//		value = []byte("bar")
-//	)
-//	tree.Set(key, value)
-//
-//	commitmentProof, err := tree.GetMembershipProof(key)
-//	require.NoError(t, err)
-//
-//	op := &testOp{
-//		Spec:  ics23.IavlSpec,
-//		Key:   key,
-//		Proof: commitmentProof,
-//	}
-//
-//	next := &rpcmock.Client{}
-//	next.On(
-//		"ABCIQueryWithOptions",
-//		context.Background(),
-//		mock.AnythingOfType("string"),
-//		bytes.HexBytes(key),
-//		mock.AnythingOfType("client.ABCIQueryOptions"),
-//	).Return(&ctypes.ResultABCIQuery{
-//		Response: abci.ResponseQuery{
-//			Code:   0,
-//			Key:    key,
-//			Value:  value,
-//			Height: 1,
-//			ProofOps: &tmcrypto.ProofOps{
-//				Ops: []tmcrypto.ProofOp{op.ProofOp()},
-//			},
-//		},
-//	}, nil)
-//
-//	lc := &lcmock.LightClient{}
-//	appHash, _ := hex.DecodeString("5EFD44055350B5CC34DBD26085347A9DBBE44EA192B9286A9FC107F40EA1FAC5")
-//	lc.On("VerifyLightBlockAtHeight", context.Background(), int64(2), mock.AnythingOfType("time.Time")).Return(
-//		&types.LightBlock{
-//			SignedHeader: &types.SignedHeader{
-//				Header: &types.Header{AppHash: appHash},
-//			},
-//		},
-//		nil,
-//	)
-//
-//	c := NewClient(next, lc,
-//		KeyPathFn(func(_ string, key []byte) (merkle.KeyPath, error) {
-//			kp := merkle.KeyPath{}
-//			kp = kp.AppendKey(key, merkle.KeyEncodingURL)
-//			return kp, nil
-//		}))
-//	c.RegisterOpDecoder("ics23:iavl", testOpDecoder)
-//	res, err := c.ABCIQuery(context.Background(), "/store/accounts/key", key)
-//	require.NoError(t, err)
-//
-//	assert.NotNil(t, res)
-// }
-//
-// type testOp struct {
-//	Spec  *ics23.ProofSpec
-//	Key   []byte
-//	Proof *ics23.CommitmentProof
-// }
-//
-// var _ merkle.ProofOperator = testOp{}
-//
-// func (op testOp) GetKey() []byte {
-//	return op.Key
-// }
-//
-// func (op testOp) ProofOp() tmcrypto.ProofOp {
-//	bz, err := op.Proof.Marshal()
-//	if err != nil {
-//		panic(err.Error())
-//	}
-//	return tmcrypto.ProofOp{
-//		Type: "ics23:iavl",
-//		Key:  op.Key,
-//		Data: bz,
-//	}
-// }
-//
-// func (op testOp) Run(args [][]byte) ([][]byte, error) {
-//	// calculate root from proof
-//	root, err := op.Proof.Calculate()
-//	if err != nil {
-//		return nil, fmt.Errorf("could not calculate root for proof: %v", err)
-//	}
-//	// Only support an existence proof or nonexistence proof (batch proofs currently unsupported)
-//	switch len(args) {
-//	case 0:
-//		// Args are nil, so we verify the absence of the key.
-//		absent := ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key)
-//		if !absent {
-//			return nil, fmt.Errorf("proof did not verify absence of key: %s", string(op.Key))
-//		}
-//	case 1:
-//		// Args is length 1, verify existence of key with value args[0]
-//		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
-//			return nil, fmt.Errorf("proof did not verify existence of key %s with given value %x", op.Key, args[0])
-//		}
-//	default:
-//		return nil, fmt.Errorf("args must be length 0 or 1, got: %d", len(args))
-//	}
-//
-//	return [][]byte{root}, nil
-// }
-//
-// func testOpDecoder(pop tmcrypto.ProofOp) (merkle.ProofOperator, error) {
-//	proof := &ics23.CommitmentProof{}
-//	err := proof.Unmarshal(pop.Data)
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	op := testOp{
-//		Key:   pop.Key,
-//		Spec:  ics23.IavlSpec,
-//		Proof: proof,
-//	}
-//	return op, nil
-// }
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/bytes"
+	lcmock "github.com/tendermint/tendermint/light/rpc/mocks"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	rpcmock "github.com/tendermint/tendermint/rpc/client/mocks"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+// testCommitmentProof returns a proof of the key with the value in a tree of
+// two leaves.
+func testCommitmentProof(key, value []byte) *ics23.CommitmentProof {
+	sibling := sha256.Sum256([]byte("sibling"))
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{
+			Exist: &ics23.ExistenceProof{
+				Key:   key,
+				Value: value,
+				Leaf:  ics23.TendermintSpec.LeafSpec,
+				Path: []*ics23.InnerOp{{
+					Hash:   ics23.HashOp_SHA256,
+					Prefix: []byte{1},
+					Suffix: sibling[:],
+				}},
+			},
+		},
+	}
+}
+
+// TestABCIQuery tests ABCIQuery requests and verifies proofs.
+func TestABCIQuery(t *testing.T) {
+	var (
+		key   = []byte("foo")
+		value = []byte("bar")
+	)
+
+	op, err := NewCommitmentOp(ProofOpICS23Simple, key, testCommitmentProof(key, value))
+	require.NoError(t, err)
+	appHash, err := op.Proof.Calculate()
+	require.NoError(t, err)
+
+	newClient := func(respValue []byte, opts ...Option) *Client {
+		next := &rpcmock.Client{}
+		next.On(
+			"ABCIQueryWithOptions",
+			context.Background(),
+			mock.AnythingOfType("string"),
+			bytes.HexBytes(key),
+			mock.AnythingOfType("client.ABCIQueryOptions"),
+		).Return(&ctypes.ResultABCIQuery{
+			Response: abci.ResponseQuery{
+				Code:   0,
+				Key:    key,
+				Value:  respValue,
+				Height: 1,
+				ProofOps: &tmcrypto.ProofOps{
+					Ops: []tmcrypto.ProofOp{op.ProofOp()},
+				},
+			},
+		}, nil)
+
+		lc := &lcmock.LightClient{}
+		lc.On("VerifyLightBlockAtHeight", context.Background(), int64(2), mock.AnythingOfType("time.Time")).Return(
+			&types.LightBlock{
+				SignedHeader: &types.SignedHeader{
+					Header: &types.Header{AppHash: bytes.HexBytes(appHash)},
+				},
+			},
+			nil,
+		)
+
+		return NewClient(next, lc, append([]Option{KeyPathFn(KeyOnlyKeyPathFn())}, opts...)...)
+	}
+
+	res, err := newClient(value).ABCIQuery(context.Background(), "/key", key)
+	require.NoError(t, err)
+	assert.Equal(t, value, res.Response.Value)
+
+	// a value, which isn't proved, is not returned
+	_, err = newClient([]byte("baz")).ABCIQuery(context.Background(), "/key", key)
+	assert.True(t, errors.Is(err, ErrInvalidProof), err)
+
+	// neither is a value proved by an operator, which isn't enabled
+	prt, err := NewProofRuntime(merkle.ProofOpValue)
+	require.NoError(t, err)
+	_, err = newClient(value, ProofRuntime(prt)).ABCIQuery(context.Background(), "/key", key)
+	assert.True(t, errors.Is(err, ErrInvalidProof), err)
+}
+
+func TestProofOpsRegistry(t *testing.T) {
+	assert.Equal(t,
+		[]string{ProofOpICS23IAVL, ProofOpICS23Simple, ProofOpMerk, merkle.ProofOpValue},
+		RegisteredProofOps())
+
+	_, err := NewProofRuntime("unknown")
+	assert.Error(t, err)
+
+	assert.Panics(t, func() { RegisterProofOp(merkle.ProofOpValue, merkle.ValueOpDecoder) })
+}
+
+func TestCommitmentOp(t *testing.T) {
+	var (
+		key   = []byte("foo")
+		value = []byte("bar")
+	)
+
+	_, err := NewCommitmentOp("ics23:unknown", key, testCommitmentProof(key, value))
+	assert.Error(t, err)
+
+	op, err := NewCommitmentOp(ProofOpICS23Simple, key, testCommitmentProof(key, value))
+	require.NoError(t, err)
+
+	// the op survives encoding
+	decoded, err := CommitmentOpDecoder(op.ProofOp())
+	require.NoError(t, err)
+	assert.Equal(t, op, decoded)
+
+	root, err := op.Proof.Calculate()
+	require.NoError(t, err)
+	res, err := op.Run([][]byte{value})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{root}, res)
+
+	_, err = op.Run([][]byte{[]byte("baz")})
+	assert.Error(t, err)
+	// an existence proof doesn't prove absence
+	_, err = op.Run(nil)
+	assert.Error(t, err)
+	_, err = op.Run([][]byte{value, value})
+	assert.Error(t, err)
+
+	// the IAVL spec rejects the leaves of simple trees
+	op.Spec = ics23.IavlSpec
+	_, err = op.Run([][]byte{value})
+	assert.Error(t, err)
+}
+
+// merkKV returns the op pushing a node with the key and the value onto the
+// stack of a merk proof.
+func merkKV(key, value string) []byte {
+	op := append([]byte{merkOpPushKV, byte(len(key))}, key...)
+	op = append(op, byte(len(value)>>8), byte(len(value)))
+	return append(op, value...)
+}
+
+// merkProof concatenates the ops of a merk proof.
+func merkProof(ops ...[]byte) []byte {
+	var bz []byte
+	for _, op := range ops {
+		bz = append(bz, op...)
+	}
+	return bz
+}
+
+func TestMerkOp(t *testing.T) {
+	// b
+	// ├─ a
+	// └─ c
+	var (
+		nullHash = make([]byte, merkHashSize)
+		hashA    = merkNodeHash(merkKVHash([]byte("a"), []byte("1")), nullHash, nullHash)
+		hashC    = merkNodeHash(merkKVHash([]byte("c"), []byte("3")), nullHash, nullHash)
+		kvHashB  = merkKVHash([]byte("b"), []byte("2"))
+		root     = merkNodeHash(kvHashB, hashA, hashC)
+
+		parent = []byte{merkOpParent}
+		child  = []byte{merkOpChild}
+	)
+	pruned := func(hash []byte) []byte { return append([]byte{merkOpPushHash}, hash...) }
+	hidden := func(kvHash []byte) []byte { return append([]byte{merkOpPushKVHash}, kvHash...) }
+
+	run := func(key string, proof []byte, args ...[]byte) ([][]byte, error) {
+		pop := MerkOp{Key: []byte(key), Proof: proof}.ProofOp()
+		op, err := MerkOpDecoder(pop)
+		if err != nil {
+			return nil, err
+		}
+		return op.Run(args)
+	}
+
+	full := merkProof(merkKV("a", "1"), merkKV("b", "2"), parent, merkKV("c", "3"), child)
+	prunedC := merkProof(merkKV("a", "1"), merkKV("b", "2"), parent, pruned(hashC), child)
+	prunedA := merkProof(pruned(hashA), merkKV("b", "2"), parent, merkKV("c", "3"), child)
+	hiddenB := merkProof(merkKV("a", "1"), hidden(kvHashB), parent, pruned(hashC), child)
+
+	testCases := []struct {
+		name    string
+		key     string
+		proof   []byte
+		args    [][]byte
+		wantErr bool
+	}{
+		{"value of the root", "b", full, [][]byte{[]byte("2")}, false},
+		{"value of a leaf", "c", full, [][]byte{[]byte("3")}, false},
+		{"value next to pruned subtree", "a", prunedC, [][]byte{[]byte("1")}, false},
+		{"value next to hidden key", "a", hiddenB, [][]byte{[]byte("1")}, false},
+		{"wrong value", "b", full, [][]byte{[]byte("3")}, true},
+		{"missing key", "bb", full, [][]byte{[]byte("2")}, true},
+		{"pruned key", "c", prunedC, [][]byte{[]byte("3")}, true},
+		{"too many args", "b", full, [][]byte{[]byte("2"), []byte("2")}, true},
+
+		{"absence between keys", "bb", prunedA, nil, false},
+		{"absence before the least key", "0", prunedC, nil, false},
+		{"absence after the greatest key", "d", prunedA, nil, false},
+		{"absence of present key", "b", full, nil, true},
+		{"absence next to pruned subtree", "bb", prunedC, nil, true},
+		{"absence before pruned subtree", "0", prunedA, nil, true},
+		{"absence after pruned subtree", "d", prunedC, nil, true},
+		{"absence next to hidden key", "aa", hiddenB, nil, true},
+
+		{"unordered keys", "b", merkProof(merkKV("c", "3"), merkKV("b", "2"), parent), [][]byte{[]byte("2")}, true},
+		{"truncated hash", "b", pruned(hashA)[:10], nil, true},
+		{"truncated value", "b", merkKV("b", "2")[:4], [][]byte{[]byte("2")}, true},
+		{"unknown op", "b", merkProof(merkKV("b", "2"), []byte{0x20}), [][]byte{[]byte("2")}, true},
+		{"missing child", "b", merkProof(merkKV("b", "2"), parent), [][]byte{[]byte("2")}, true},
+		{"unattached nodes", "b", merkProof(merkKV("a", "1"), merkKV("b", "2")), [][]byte{[]byte("2")}, true},
+		{"child of pruned subtree", "a", merkProof(merkKV("a", "1"), pruned(hashC), parent), [][]byte{[]byte("1")}, true},
+		{"second right child", "b", merkProof(full, merkKV("d", "4"), child), [][]byte{[]byte("2")}, true},
+		{"empty proof", "b", nil, nil, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := run(tc.key, tc.proof, tc.args...)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, [][]byte{root}, res)
+		})
+	}
+
+	// proofs of other types aren't decoded
+	_, err := MerkOpDecoder(tmcrypto.ProofOp{Type: ProofOpICS23IAVL, Key: []byte("b"), Data: full})
+	assert.Error(t, err)
+}
+
+// TestTxSearch tests TxSearch requests and verifies txs with their results.
+func TestTxSearch(t *testing.T) {
+	txs := types.Txs{types.Tx("foo"), types.Tx("bar")}
+	txResults := []*abci.ResponseDeliverTx{{Code: 0, Data: []byte("ok")}, {Code: 1, Log: "not deterministic"}}
+
+	bbeBytes, err := proto.Marshal(&abci.ResponseBeginBlock{})
+	require.NoError(t, err)
+	ebeBytes, err := proto.Marshal(&abci.ResponseEndBlock{})
+	require.NoError(t, err)
+	lastResultsHash := merkle.HashFromByteSlices([][]byte{bbeBytes, types.NewResults(txResults).Hash(), ebeBytes})
+
+	newResultTx := func(i int) *ctypes.ResultTx {
+		return &ctypes.ResultTx{
+			Hash:     txs[i].Hash(),
+			Height:   1,
+			Index:    uint32(i),
+			TxResult: *txResults[i],
+			Tx:       txs[i],
+			Proof:    txs.Proof(i),
+		}
+	}
+
+	newClient := func(res *ctypes.ResultTxSearch) *Client {
+		next := &rpcmock.Client{}
+		next.On("TxSearch", context.Background(), "tx.height=1", true, mock.Anything, mock.Anything, "").
+			Return(res, nil)
+		next.On("BlockResults", context.Background(), mock.Anything).Return(&ctypes.ResultBlockResults{
+			Height:     1,
+			TxsResults: txResults,
+		}, nil).Once()
+
+		lc := &lcmock.LightClient{}
+		lc.On("VerifyLightBlockAtHeight", context.Background(), int64(1), mock.AnythingOfType("time.Time")).Return(
+			&types.LightBlock{SignedHeader: &types.SignedHeader{Header: &types.Header{DataHash: txs.Hash()}}},
+			nil,
+		)
+		lc.On("VerifyLightBlockAtHeight", context.Background(), int64(2), mock.AnythingOfType("time.Time")).Return(
+			&types.LightBlock{SignedHeader: &types.SignedHeader{Header: &types.Header{LastResultsHash: lastResultsHash}}},
+			nil,
+		)
+
+		return NewClient(next, lc)
+	}
+
+	// the block results are fetched once for both txs
+	res, err := newClient(&ctypes.ResultTxSearch{
+		Txs:        []*ctypes.ResultTx{newResultTx(0), newResultTx(1)},
+		TotalCount: 2,
+	}).TxSearch(context.Background(), "tx.height=1", false, nil, nil, "")
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+	// the proofs weren't requested
+	assert.Empty(t, res.Txs[0].Proof.Data)
+
+	// a tx with a different result
+	tx := newResultTx(0)
+	tx.TxResult.Code = 1
+	_, err = newClient(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx}, TotalCount: 1}).
+		TxSearch(context.Background(), "tx.height=1", false, nil, nil, "")
+	assert.Error(t, err)
+
+	// a tx, which isn't in the block
+	tx = newResultTx(0)
+	tx.Tx = types.Tx("baz")
+	tx.Hash = tx.Tx.Hash()
+	_, err = newClient(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{tx}, TotalCount: 1}).
+		TxSearch(context.Background(), "tx.height=1", false, nil, nil, "")
+	assert.Error(t, err)
+}
+
+// TestEvidenceSearch tests that EvidenceSearch rejects evidence, which doesn't
+// match its hash or has no committed height, before fetching any blocks.
+func TestEvidenceSearch(t *testing.T) {
+	vote := func(hash string) *types.Vote {
+		return &types.Vote{
+			Height:  1,
+			BlockID: types.BlockID{Hash: tmhash.Sum([]byte(hash))},
+		}
+	}
+	ev := &types.DuplicateVoteEvidence{VoteA: vote("a"), VoteB: vote("b")}
+
+	newClient := func(res *ctypes.ResultEvidence) *Client {
+		next := &rpcmock.Client{}
+		next.On("EvidenceSearch", context.Background(), int64(0), int64(0), "", bytes.HexBytes(nil),
+			mock.Anything, mock.Anything).
+			Return(&ctypes.ResultEvidenceSearch{Evidence: []*ctypes.ResultEvidence{res}, TotalCount: 1}, nil)
+		return NewClient(next, &lcmock.LightClient{})
+	}
+
+	_, err := newClient(&ctypes.ResultEvidence{Evidence: ev, Hash: tmhash.Sum([]byte("other")), CommittedHeight: 2}).
+		EvidenceSearch(context.Background(), 0, 0, "", nil, nil, nil)
+	assert.Error(t, err)
+
+	_, err = newClient(&ctypes.ResultEvidence{Evidence: ev, Hash: ev.Hash()}).
+		EvidenceSearch(context.Background(), 0, 0, "", nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,422 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/confio/ics23/go"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+const (
+	// ProofOpICS23IAVL is the type of ICS23 proofs of IAVL trees.
+	ProofOpICS23IAVL = "ics23:iavl"
+	// ProofOpICS23Simple is the type of ICS23 proofs of simple Merkle trees,
+	// like the ones combining the roots of several IAVL trees into the app
+	// hash.
+	ProofOpICS23Simple = "ics23:simple"
+	// ProofOpMerk is the type of proofs of the merk trees of Dash Platform.
+	ProofOpMerk = "merk"
+)
+
+var (
+	proofOpsMtx tmsync.Mutex
+	// decoders of the proof operators Client may verify ABCI query proofs
+	// with, keyed by their types.
+	proofOpDecoders = map[string]merkle.OpDecoder{
+		merkle.ProofOpValue: merkle.ValueOpDecoder,
+		ProofOpICS23IAVL:    CommitmentOpDecoder,
+		ProofOpICS23Simple:  CommitmentOpDecoder,
+		ProofOpMerk:         MerkOpDecoder,
+	}
+)
+
+// RegisterProofOp registers a decoder of proof operators of the given type.
+// Applications with their own state trees register their proof operators
+// (e.g. in an init function), so that the light client proxy can verify the
+// proofs of their ABCI queries. It panics if the type is already registered.
+func RegisterProofOp(typ string, dec merkle.OpDecoder) {
+	proofOpsMtx.Lock()
+	defer proofOpsMtx.Unlock()
+
+	if _, ok := proofOpDecoders[typ]; ok {
+		panic("proof operator already registered for type " + typ)
+	}
+	proofOpDecoders[typ] = dec
+}
+
+// RegisteredProofOps returns the types of the registered proof operators in
+// alphabetical order.
+func RegisteredProofOps() []string {
+	proofOpsMtx.Lock()
+	defer proofOpsMtx.Unlock()
+
+	types := make([]string, 0, len(proofOpDecoders))
+	for typ := range proofOpDecoders {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// NewProofRuntime returns a proof runtime with the registered proof
+// operators of the given types, or all of them if no types are given.
+func NewProofRuntime(types ...string) (*merkle.ProofRuntime, error) {
+	if len(types) == 0 {
+		types = RegisteredProofOps()
+	}
+
+	proofOpsMtx.Lock()
+	defer proofOpsMtx.Unlock()
+
+	prt := merkle.NewProofRuntime()
+	for _, typ := range types {
+		dec, ok := proofOpDecoders[typ]
+		if !ok {
+			return nil, fmt.Errorf("unknown proof operator %q", typ)
+		}
+		prt.RegisterOpDecoder(typ, dec)
+	}
+	return prt, nil
+}
+
+//----------------------------------------
+
+// CommitmentOp verifies ICS23 commitment proofs, which are used e.g. by IAVL
+// trees. It takes one value for existence proofs, or none for absence proofs,
+// and returns the root hash the proof was made for.
+type CommitmentOp struct {
+	Type  string
+	Spec  *ics23.ProofSpec
+	Key   []byte
+	Proof *ics23.CommitmentProof
+}
+
+var _ merkle.ProofOperator = CommitmentOp{}
+
+// NewCommitmentOp returns a CommitmentOp of the given type, which must be
+// either ProofOpICS23IAVL or ProofOpICS23Simple.
+func NewCommitmentOp(typ string, key []byte, proof *ics23.CommitmentProof) (CommitmentOp, error) {
+	var spec *ics23.ProofSpec
+	switch typ {
+	case ProofOpICS23IAVL:
+		spec = ics23.IavlSpec
+	case ProofOpICS23Simple:
+		spec = ics23.TendermintSpec
+	default:
+		return CommitmentOp{}, fmt.Errorf("unexpected ICS23 proof type %q", typ)
+	}
+	return CommitmentOp{Type: typ, Spec: spec, Key: key, Proof: proof}, nil
+}
+
+// CommitmentOpDecoder decodes a CommitmentOp.
+func CommitmentOpDecoder(pop tmcrypto.ProofOp) (merkle.ProofOperator, error) {
+	proof := &ics23.CommitmentProof{}
+	if err := proof.Unmarshal(pop.Data); err != nil {
+		return nil, fmt.Errorf("decoding ICS23 proof: %w", err)
+	}
+	return NewCommitmentOp(pop.Type, pop.Key, proof)
+}
+
+// GetKey implements merkle.ProofOperator.
+func (op CommitmentOp) GetKey() []byte {
+	return op.Key
+}
+
+// ProofOp implements merkle.ProofOperator.
+func (op CommitmentOp) ProofOp() tmcrypto.ProofOp {
+	bz, err := op.Proof.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmcrypto.ProofOp{
+		Type: op.Type,
+		Key:  op.Key,
+		Data: bz,
+	}
+}
+
+// Run implements merkle.ProofOperator.
+func (op CommitmentOp) Run(args [][]byte) ([][]byte, error) {
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, fmt.Errorf("calculating the root of the ICS23 proof: %w", err)
+	}
+
+	// batch proofs aren't supported
+	switch len(args) {
+	case 0:
+		if !ics23.VerifyNonMembership(op.Spec, root, op.Proof, op.Key) {
+			return nil, fmt.Errorf("proof doesn't verify the absence of key %X", op.Key)
+		}
+	case 1:
+		if !ics23.VerifyMembership(op.Spec, root, op.Proof, op.Key, args[0]) {
+			return nil, fmt.Errorf("proof doesn't verify key %X with value %X", op.Key, args[0])
+		}
+	default:
+		return nil, fmt.Errorf("expected 0 or 1 args, got %d", len(args))
+	}
+
+	return [][]byte{root}, nil
+}
+
+//----------------------------------------
+
+// The proofs of merk trees are programs of a stack machine, which rebuild
+// the part of the tree between the root and the proved keys. Every op is a
+// byte followed by its operands.
+const (
+	// hash of a pruned subtree
+	merkOpPushHash = 0x01
+	// kv hash of a node, which key isn't proved
+	merkOpPushKVHash = 0x02
+	// key length (1 byte), key, value length (2 bytes, big-endian), value
+	merkOpPushKV = 0x03
+	// pops a parent and a child below it, and attaches the child as the left one
+	merkOpParent = 0x10
+	// pops a child and a parent below it, and attaches the child as the right one
+	merkOpChild = 0x11
+)
+
+// merkHashSize is the size of the node hashes of merk trees.
+const merkHashSize = blake2b.Size256
+
+// MerkOp verifies the proofs of the merk trees of Dash Platform. It takes
+// one value for existence proofs, or none for absence proofs, and returns the
+// root hash the proof was made for.
+//
+// The hash of a node is Blake2b-256 of its kv hash and the hashes of its left
+// and right children (zeros for missing children), and the kv hash is
+// Blake2b-256 of the key and the value, both prefixed with their lengths as in
+// the proof.
+type MerkOp struct {
+	Key   []byte
+	Proof []byte
+}
+
+var _ merkle.ProofOperator = MerkOp{}
+
+// MerkOpDecoder decodes a MerkOp.
+func MerkOpDecoder(pop tmcrypto.ProofOp) (merkle.ProofOperator, error) {
+	if pop.Type != ProofOpMerk {
+		return nil, fmt.Errorf("unexpected merk proof type %q", pop.Type)
+	}
+	if _, err := decodeMerkProof(pop.Data); err != nil {
+		return nil, fmt.Errorf("decoding merk proof: %w", err)
+	}
+	return MerkOp{Key: pop.Key, Proof: pop.Data}, nil
+}
+
+// GetKey implements merkle.ProofOperator.
+func (op MerkOp) GetKey() []byte {
+	return op.Key
+}
+
+// ProofOp implements merkle.ProofOperator.
+func (op MerkOp) ProofOp() tmcrypto.ProofOp {
+	return tmcrypto.ProofOp{
+		Type: ProofOpMerk,
+		Key:  op.Key,
+		Data: op.Proof,
+	}
+}
+
+// Run implements merkle.ProofOperator.
+func (op MerkOp) Run(args [][]byte) ([][]byte, error) {
+	root, err := decodeMerkProof(op.Proof)
+	if err != nil {
+		return nil, fmt.Errorf("decoding merk proof: %w", err)
+	}
+
+	nodes := root.inOrder(nil)
+	var prevKey []byte
+	for _, n := range nodes {
+		if n.key == nil {
+			continue
+		}
+		if prevKey != nil && bytes.Compare(prevKey, n.key) >= 0 {
+			return nil, errors.New("keys of merk proof aren't in ascending order")
+		}
+		prevKey = n.key
+	}
+
+	switch len(args) {
+	case 0:
+		if !merkProvesAbsence(nodes, op.Key) {
+			return nil, fmt.Errorf("proof doesn't verify the absence of key %X", op.Key)
+		}
+	case 1:
+		if !merkProvesValue(nodes, op.Key, args[0]) {
+			return nil, fmt.Errorf("proof doesn't verify key %X with value %X", op.Key, args[0])
+		}
+	default:
+		return nil, fmt.Errorf("expected 0 or 1 args, got %d", len(args))
+	}
+
+	return [][]byte{root.subtreeHash()}, nil
+}
+
+// merkProvesValue returns whether one of the nodes, in the order of their
+// keys, has the key with the value.
+func merkProvesValue(nodes []*merkNode, key, value []byte) bool {
+	for _, n := range nodes {
+		if n.key != nil && bytes.Equal(n.key, key) {
+			return bytes.Equal(n.value, value)
+		}
+	}
+	return false
+}
+
+// merkProvesAbsence returns whether the nodes, in the order of their keys,
+// prove that there is no key between the greatest key less than the given
+// one and the least key greater than it, i.e. that there is no pruned
+// subtree or hidden key between them.
+func merkProvesAbsence(nodes []*merkNode, key []byte) bool {
+	i := 0
+	for ; i < len(nodes); i++ {
+		if nodes[i].key == nil {
+			continue
+		}
+		cmp := bytes.Compare(nodes[i].key, key)
+		if cmp == 0 {
+			return false
+		}
+		if cmp > 0 {
+			break
+		}
+	}
+	// the node before the least greater key, or the last node if there is
+	// no greater key, must have a key too; the first node has nothing before
+	return i == 0 || nodes[i-1].key != nil
+}
+
+// merkNode is a node of a merk tree rebuilt from a proof. It's either a
+// pruned subtree (hash), a node with a hidden key (kvHash) or a node with a
+// proved key (key and value).
+type merkNode struct {
+	hash        []byte
+	kvHash      []byte
+	key, value  []byte
+	left, right *merkNode
+}
+
+// decodeMerkProof runs the ops of a merk proof and returns the root of the
+// rebuilt tree.
+func decodeMerkProof(bz []byte) (*merkNode, error) {
+	var stack []*merkNode
+	for len(bz) > 0 {
+		op := bz[0]
+		bz = bz[1:]
+
+		switch op {
+		case merkOpPushHash, merkOpPushKVHash:
+			if len(bz) < merkHashSize {
+				return nil, errors.New("truncated hash")
+			}
+			n := &merkNode{}
+			if op == merkOpPushHash {
+				n.hash = bz[:merkHashSize]
+			} else {
+				n.kvHash = bz[:merkHashSize]
+			}
+			bz = bz[merkHashSize:]
+			stack = append(stack, n)
+
+		case merkOpPushKV:
+			if len(bz) < 1 || len(bz) < 1+int(bz[0])+2 {
+				return nil, errors.New("truncated key")
+			}
+			keyLen := int(bz[0])
+			key := bz[1 : 1+keyLen]
+			bz = bz[1+keyLen:]
+			valueLen := int(binary.BigEndian.Uint16(bz))
+			bz = bz[2:]
+			if len(bz) < valueLen {
+				return nil, errors.New("truncated value")
+			}
+			// the value of a present key is never nil, even if it's empty
+			stack = append(stack, &merkNode{key: key, value: append([]byte{}, bz[:valueLen]...)})
+			bz = bz[valueLen:]
+
+		case merkOpParent, merkOpChild:
+			if len(stack) < 2 {
+				return nil, fmt.Errorf("op %#x needs 2 nodes on the stack, got %d", op, len(stack))
+			}
+			top, below := stack[len(stack)-1], stack[len(stack)-2]
+			stack = stack[:len(stack)-2]
+
+			parent, child, childp := top, below, &top.left
+			if op == merkOpChild {
+				parent, child, childp = below, top, &below.right
+			}
+			if parent.hash != nil {
+				return nil, errors.New("pruned subtree can't have children")
+			}
+			if *childp != nil {
+				return nil, errors.New("node already has the child")
+			}
+			*childp = child
+			stack = append(stack, parent)
+
+		default:
+			return nil, fmt.Errorf("unknown op %#x", op)
+		}
+	}
+
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("expected 1 node on the stack at the end, got %d", len(stack))
+	}
+	return stack[0], nil
+}
+
+// subtreeHash returns the hash of the subtree rooted at the node.
+func (n *merkNode) subtreeHash() []byte {
+	if n == nil {
+		return make([]byte, merkHashSize)
+	}
+	if n.hash != nil {
+		return n.hash
+	}
+	kvHash := n.kvHash
+	if kvHash == nil {
+		kvHash = merkKVHash(n.key, n.value)
+	}
+	return merkNodeHash(kvHash, n.left.subtreeHash(), n.right.subtreeHash())
+}
+
+// inOrder appends the nodes of the subtree rooted at the node to nodes, in the
+// order of their keys. Pruned subtrees are single nodes.
+func (n *merkNode) inOrder(nodes []*merkNode) []*merkNode {
+	if n == nil {
+		return nodes
+	}
+	nodes = n.left.inOrder(nodes)
+	nodes = append(nodes, n)
+	return n.right.inOrder(nodes)
+}
+
+func merkKVHash(key, value []byte) []byte {
+	h, _ := blake2b.New256(nil)
+	h.Write([]byte{byte(len(key))})
+	h.Write(key)
+	var valueLen [2]byte
+	binary.BigEndian.PutUint16(valueLen[:], uint16(len(value)))
+	h.Write(valueLen[:])
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+func merkNodeHash(kvHash, left, right []byte) []byte {
+	h, _ := blake2b.New256(nil)
+	h.Write(kvHash)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
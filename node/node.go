@@ -3,8 +3,10 @@ package node
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	_ "net/http/pprof" // nolint: gosec // securely exposed on separate, optional port
@@ -19,21 +21,23 @@ import (
 	"github.com/rs/cors"
 	dbm "github.com/tendermint/tm-db"
 
+	abcicli "github.com/tendermint/tendermint/abci/client"
 	abci "github.com/tendermint/tendermint/abci/types"
-	bcv0 "github.com/tendermint/tendermint/blockchain/v0"
-	bcv1 "github.com/tendermint/tendermint/blockchain/v1"
-	bcv2 "github.com/tendermint/tendermint/blockchain/v2"
+	bc "github.com/tendermint/tendermint/blockchain"
 	cfg "github.com/tendermint/tendermint/config"
 	cs "github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/evidence"
+	"github.com/tendermint/tendermint/libs/eventlog"
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	"github.com/tendermint/tendermint/libs/service"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/trust"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
@@ -43,6 +47,7 @@ import (
 	"github.com/tendermint/tendermint/state/indexer"
 	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
 	blockidxnull "github.com/tendermint/tendermint/state/indexer/block/null"
+	"github.com/tendermint/tendermint/state/indexer/sqlsink"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
 	"github.com/tendermint/tendermint/state/txindex/null"
@@ -96,10 +101,17 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 		return nil, fmt.Errorf("failed to load or gen node key %s: %w", config.NodeKeyFile(), err)
 	}
 
+	// the genesis is loaded ahead to label the metrics of the ABCI clients
+	genesisDocProvider := DefaultGenesisDocProviderFunc(config)
+	genDoc, err := genesisDocProvider()
+	if err != nil {
+		return nil, err
+	}
+
 	return NewNode(config,
 		nodeKey,
-		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
-		DefaultGenesisDocProviderFunc(config),
+		DefaultClientCreator(config, genDoc.ChainID),
+		genesisDocProvider,
 		DefaultDBProvider,
 		DefaultMetricsProvider(config.Instrumentation),
 		nil,
@@ -107,23 +119,54 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, state and indexer Metrics.
+type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics,
+	*txindex.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *txindex.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				txindex.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), txindex.NopMetrics()
 	}
 }
 
+// DefaultClientCreator returns the ClientCreator of the ABCI application set
+// in the config, which records the traffic with the application if enabled.
+// The metrics of the clients are labeled with the chain ID.
+func DefaultClientCreator(config *cfg.Config, chainID string) proxy.ClientCreator {
+	clientCreator := proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir(),
+		DefaultSocketClientOptions(config, chainID)...)
+	if recordPath := config.ABCIRecordPath(); recordPath != "" {
+		clientCreator = proxy.NewRecordingClientCreator(clientCreator, recordPath)
+	}
+	return clientCreator
+}
+
+// DefaultSocketClientOptions returns the options of the socket clients
+// connecting to the ABCI application, set from the config.
+func DefaultSocketClientOptions(config *cfg.Config, chainID string) []abcicli.SocketClientOption {
+	var options []abcicli.SocketClientOption
+	if config.ABCIReconnect {
+		options = append(options, abcicli.SocketClientWithReconnect(abcicli.DefaultMaxReconnectBackoff))
+	}
+	if config.ABCIMultiplex {
+		options = append(options, abcicli.SocketClientWithMultiplexing())
+	}
+	if config.Instrumentation.Prometheus {
+		options = append(options, abcicli.SocketClientWithMetrics(
+			abcicli.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)))
+	}
+	return options
+}
+
 // DefaultDashCoreRPCClient returns RPC client for the Dash Core node
 func DefaultDashCoreRPCClient(config *cfg.Config) (dashcore.Client, error) {
 	return dashcore.NewRPCClient(
@@ -213,6 +256,7 @@ type Node struct {
 
 	// services
 	eventBus          *types.EventBus // pub/sub for services
+	eventLog          *eventlog.Log   // events served by /events
 	stateStore        sm.Store
 	blockStore        *store.BlockStore // store the blockchain to disk
 	bcReactor         p2p.Reactor       // for fast-syncing
@@ -231,6 +275,7 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	trustMetricStore  *trust.MetricStore
 	prometheusSrv     *http.Server
 
 	dashCoreRPCClient dashcore.Client
@@ -276,20 +321,72 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
+// createEventLog returns the event log served by the /events RPC, which is fed
+// with every event published on the event bus, or nil if it's disabled.
+func createEventLog(config *cfg.Config, eventBus *types.EventBus) (*eventlog.Log, error) {
+	if config.RPC.EventLogWindowSize == 0 {
+		return nil, nil
+	}
+
+	lg, err := eventlog.New(eventlog.Config{
+		WindowSize: config.RPC.EventLogWindowSize,
+		MaxItems:   config.RPC.EventLogMaxItems,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := eventBus.SubscribeUnbuffered(context.Background(), "EventLog", tmquery.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for {
+			select {
+			case msg := <-sub.Out():
+				var etype string
+				if values := msg.Events()[types.EventTypeKey]; len(values) > 0 {
+					etype = values[0]
+				}
+				lg.Add(etype, msg.Data(), msg.Events())
+			case <-sub.Cancelled():
+				return
+			}
+		}
+	}()
+
+	return lg, nil
+}
+
+// indexerSinkConfig returns the configuration of an event sink of the indexer
+// service.
+func indexerSinkConfig(sink cfg.IndexerSinkConfig) txindex.SinkConfig {
+	return txindex.SinkConfig{
+		BufferSize:    sink.BufferSize,
+		MaxRetries:    sink.MaxRetries,
+		RetryInterval: sink.RetryInterval,
+		Block:         sink.OnFullBuffer == cfg.OnFullBufferBlock,
+	}
+}
+
 func createAndStartIndexerService(
 	config *cfg.Config,
+	chainID string,
 	dbProvider DBProvider,
 	eventBus *types.EventBus,
+	metrics *txindex.Metrics,
 	logger log.Logger,
 ) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
 
 	var (
-		txIndexer    txindex.TxIndexer
-		blockIndexer indexer.BlockIndexer
+		txIndexer    txindex.TxIndexer    = &null.TxIndex{}
+		blockIndexer indexer.BlockIndexer = &blockidxnull.BlockerIndexer{}
+		options      []txindex.IndexerServiceOption
 	)
 
-	switch config.TxIndex.Indexer {
-	case "kv":
+	// the kv indexer is the only one which supports searching through the RPC,
+	// so it's the primary sink of the indexer service, which never drops blocks
+	if config.TxIndex.IsEnabled("kv") {
 		store, err := dbProvider(&DBContext{"tx_index", config})
 		if err != nil {
 			return nil, nil, nil, err
@@ -297,12 +394,31 @@ func createAndStartIndexerService(
 
 		txIndexer = kv.NewTxIndex(store)
 		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
-	default:
-		txIndexer = &null.TxIndex{}
-		blockIndexer = &blockidxnull.BlockerIndexer{}
+		options = append(options, txindex.IndexerServiceWithPrimarySinkConfig(
+			indexerSinkConfig(config.TxIndex.SinkConfig("kv"))))
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
+	if config.TxIndex.IsEnabled("sql") {
+		sink, err := sqlsink.Open(config.TxIndex.SQLDriver, config.TxIndex.SQLConn, chainID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to open sql event sink: %w", err)
+		}
+		sinkConfig := indexerSinkConfig(config.TxIndex.SinkConfig("sql"))
+		options = append(options, txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name:         "sql",
+			TxIndexer:    sink.TxIndexer(),
+			BlockIndexer: sink.BlockIndexer(),
+			Config:       &sinkConfig,
+			Closer:       sink,
+		}))
+	}
+
+	options = append(options,
+		txindex.IndexerServiceWithRetainBlocks(config.TxIndex.RetainBlocks),
+		txindex.IndexerServiceWithMetrics(metrics),
+	)
+
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, options...)
 	indexerService.SetLogger(logger.With("module", "txindex"))
 
 	if err := indexerService.Start(); err != nil {
@@ -382,6 +498,7 @@ func createEvidenceReactor(
 	dbProvider DBProvider,
 	stateDB dbm.DB,
 	blockStore *store.BlockStore,
+	proxyApp proxy.AppConns,
 	logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
 
@@ -394,48 +511,57 @@ func createEvidenceReactor(
 	if err != nil {
 		return nil, nil, err
 	}
+	evidencePool.SetProxyAppQuery(proxyApp.Query())
 	evidenceReactor := evidence.NewReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
 	return evidenceReactor, evidencePool, nil
 }
 
+func createAndStartTrustMetricStore(
+	config *cfg.Config,
+	dbProvider DBProvider,
+	logger log.Logger,
+) (*trust.MetricStore, error) {
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(logger.With("module", "trust"))
+	if err := trustMetricStore.Start(); err != nil {
+		return nil, err
+	}
+	return trustMetricStore, nil
+}
+
 func createBlockchainReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore *store.BlockStore,
 	nodeProTxHash *crypto.ProTxHash,
+	trustMetricStore *trust.MetricStore,
 	fastSync bool,
 	logger log.Logger) (bcReactor p2p.Reactor, err error) {
 
-	switch config.FastSync.Version {
-	case "v0":
-		bcReactor = bcv0.NewBlockchainReactor(
-			state.Copy(),
-			blockExec,
-			blockStore,
-			nodeProTxHash,
-			fastSync,
-		)
-	case "v1":
-		bcReactor = bcv1.NewBlockchainReactor(
-			state.Copy(),
-			blockExec,
-			blockStore,
-			nodeProTxHash,
-			fastSync,
-		)
-	case "v2":
-		bcReactor = bcv2.NewBlockchainReactor(
-			state.Copy(),
-			blockExec,
-			blockStore,
-			nodeProTxHash,
-			fastSync,
-		)
-	default:
-		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
+	options := []bc.ReactorOption{
+		bc.ReactorWithCommitVerifier(bc.CommitVerifierWithWorkers(config.FastSync.VerificationWorkers)),
+		bc.ReactorWithPeerScorer(bc.NewTrustMetricScorer(trustMetricStore)),
+	}
+	if config.Instrumentation.Prometheus {
+		options = append(options, bc.ReactorWithMetrics(
+			bc.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", state.ChainID)))
 	}
 
+	bcReactor = bc.NewBlockchainReactor(
+		state.Copy(),
+		blockExec,
+		blockStore,
+		nodeProTxHash,
+		fastSync,
+		options...,
+	)
+
 	bcReactor.SetLogger(logger.With("module", "blockchain"))
 	return bcReactor, nil
 }
@@ -453,6 +579,7 @@ func createConsensusReactor(
 	eventBus *types.EventBus,
 	consensusLogger log.Logger,
 	proposedAppVersion uint64,
+	indexerService *txindex.IndexerService,
 ) (*cs.Reactor, *cs.State) {
 
 	consensusState := cs.NewStateWithLogger(
@@ -465,6 +592,7 @@ func createConsensusReactor(
 		consensusLogger,
 		proposedAppVersion,
 		cs.StateMetrics(csMetrics),
+		cs.StateIndexPruner(indexerService),
 	)
 
 	if privValidator != nil {
@@ -738,16 +866,25 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, indexerMetrics := metricsProvider(genDoc.ChainID)
+
 	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(
 		config,
+		genDoc.ChainID,
 		dbProvider,
 		eventBus,
+		indexerMetrics,
 		logger,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	eventLog, err := createEventLog(config, eventBus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event log: %w", err)
+	}
+
 	var weAreOnlyValidator bool
 	var proTxHashP *crypto.ProTxHash
 	var privValidator types.PrivValidator
@@ -852,6 +989,7 @@ func NewNode(config *cfg.Config,
 	// and replays any blocks as necessary to sync tenderdash with the app.
 	consensusLogger := logger.With("module", "consensus")
 	proposedAppVersion := uint64(0)
+	deliverBatch := false
 	if !stateSync {
 		handshaker := cs.NewHandshaker(
 			stateStore,
@@ -866,6 +1004,7 @@ func NewNode(config *cfg.Config,
 		if proposedAppVersion, err = handshaker.Handshake(proxyApp); err != nil {
 			return nil, fmt.Errorf("error during handshake: %v", err)
 		}
+		deliverBatch = handshaker.DeliverBatch()
 
 		// Reload the state. It will have the Version.Consensus.App set by the
 		// Handshake, and may have other modifications as well (ie. depending on
@@ -874,6 +1013,14 @@ func NewNode(config *cfg.Config,
 		if err != nil {
 			return nil, fmt.Errorf("cannot load state for new node: %w", err)
 		}
+	} else {
+		// There is no handshake before state sync, so ask the app whether it
+		// supports DeliverBatch.
+		res, err := proxyApp.Query().InfoSync(proxy.RequestInfo)
+		if err != nil {
+			return nil, fmt.Errorf("error calling Info: %v", err)
+		}
+		deliverBatch = res.DeliverBatch
 	}
 
 	// Determine whether we should do fast sync. This must happen after the handshake, since the
@@ -882,8 +1029,6 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, proTxHashP, logger, consensusLogger)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
-
 	// Make Mempool Reactor
 	mempoolReactor, mempool := createMempoolAndMempoolReactor(
 		config,
@@ -899,6 +1044,7 @@ func NewNode(config *cfg.Config,
 		dbProvider,
 		stateDB,
 		blockStore,
+		proxyApp,
 		logger,
 	)
 	if err != nil {
@@ -921,8 +1067,15 @@ func NewNode(config *cfg.Config,
 		nextCoreChainLock,
 		sm.BlockExecutorWithMetrics(smMetrics),
 		sm.BlockExecutorWithAppHashSize(config.Consensus.AppHashSize),
+		sm.BlockExecutorWithDeliverBatch(deliverBatch),
 	)
 
+	// Keep track of the trust metrics of the peers, used to score them during fast sync.
+	trustMetricStore, err := createAndStartTrustMetricStore(config, dbProvider, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
 	bcReactor, err := createBlockchainReactor(
 		config,
@@ -930,6 +1083,7 @@ func NewNode(config *cfg.Config,
 		blockExec,
 		blockStore,
 		proTxHashP,
+		trustMetricStore,
 		fastSync && !stateSync,
 		logger,
 	)
@@ -957,8 +1111,18 @@ func NewNode(config *cfg.Config,
 		eventBus,
 		consensusLogger,
 		proposedAppVersion,
+		indexerService,
 	)
 
+	// Pause consensus while any connection to the application is lost.
+	proxyApp.SetDisconnectedCallback(func(disconnected []string) {
+		if len(disconnected) > 0 {
+			consensusState.Pause()
+		} else {
+			consensusState.Resume()
+		}
+	})
+
 	// Set up state sync reactor, and schedule a sync if requested.
 	// FIXME The way we do phased startups (e.g. replay -> fast sync -> consensus) is very messy,
 	// we should clean this whole thing up. See:
@@ -1055,8 +1219,10 @@ func NewNode(config *cfg.Config,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		trustMetricStore: trustMetricStore,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
+		eventLog:         eventLog,
 
 		dashCoreRPCClient: dashCoreRPCClient,
 	}
@@ -1174,6 +1340,11 @@ func (n *Node) OnStop() {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
 
+	// the fast sync reactor scores the peers until it is stopped
+	if err := n.trustMetricStore.Stop(); err != nil {
+		n.Logger.Error("Error closing trustMetricStore", "err", err)
+	}
+
 	// stop mempool WAL
 	if n.config.Mempool.WalEnabled() {
 		n.mempool.CloseWAL()
@@ -1212,6 +1383,7 @@ func (n *Node) ConfigureRPC() error {
 	env := rpccore.Environment{
 		ProxyAppQuery:   n.proxyApp.Query(),
 		ProxyAppMempool: n.proxyApp.Mempool(),
+		ProxyAppConns:   n.proxyApp,
 
 		StateStore:     n.stateStore,
 		BlockStore:     n.blockStore,
@@ -1225,6 +1397,7 @@ func (n *Node) ConfigureRPC() error {
 		BlockIndexer:     n.blockIndexer,
 		ConsensusReactor: n.consensusReactor,
 		EventBus:         n.eventBus,
+		EventLog:         n.eventLog,
 		Mempool:          n.mempool,
 
 		Logger: n.Logger.With("module", "rpc"),
@@ -1244,6 +1417,78 @@ func (n *Node) ConfigureRPC() error {
 	return nil
 }
 
+// ConfigureRPCAccess sets up the access control of an RPC server, i.e.
+// authentication, allowed routes and rate limits, according to rpcConfig.
+func ConfigureRPCAccess(rpcConfig *cfg.RPCConfig, serverConfig *rpcserver.Config) error {
+	clientRoutes, err := rpcConfig.ClientRoutesMap()
+	if err != nil {
+		return err
+	}
+	accessConfig := rpcserver.AccessConfig{
+		AnonymousRoutes: rpcConfig.AnonymousRoutes,
+		ClientRoutes:    clientRoutes,
+		RateLimit:       rpcConfig.RateLimit,
+		AuthRateLimit:   rpcConfig.AuthRateLimit,
+		RateLimitBurst:  rpcConfig.RateLimitBurst,
+	}
+
+	if rpcConfig.AuthTokensFile != "" {
+		tokens, err := loadRPCAuthTokens(rpcConfig.TokensFile())
+		if err != nil {
+			return err
+		}
+		accessConfig.Authenticators = append(accessConfig.Authenticators, rpcserver.NewTokenAuthenticator(tokens))
+	}
+
+	if rpcConfig.TLSClientCAFile != "" {
+		pem, err := ioutil.ReadFile(rpcConfig.ClientCAFile())
+		if err != nil {
+			return fmt.Errorf("failed to read tls_client_ca_file: %w", err)
+		}
+		serverConfig.ClientCAs = x509.NewCertPool()
+		if !serverConfig.ClientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates found in %s", rpcConfig.ClientCAFile())
+		}
+		accessConfig.Authenticators = append(accessConfig.Authenticators, rpcserver.ClientCertAuthenticator{})
+	}
+
+	// every request is allowed, so there is nothing to control
+	if len(accessConfig.Authenticators) == 0 &&
+		len(accessConfig.AnonymousRoutes) == 1 && accessConfig.AnonymousRoutes[0] == "*" &&
+		accessConfig.RateLimit == 0 {
+		return nil
+	}
+
+	serverConfig.AccessControl = rpcserver.NewAccessControl(accessConfig)
+	return nil
+}
+
+// loadRPCAuthTokens reads bearer tokens, keyed by client names, from a file
+// with a "<client name> <token>" line per client.
+func loadRPCAuthTokens(path string) (map[string]string, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth_tokens_file: %w", err)
+	}
+
+	tokens := make(map[string]string)
+	for i, line := range strings.Split(string(bz), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected <client name> <token>", path, i+1)
+		}
+		if _, ok := tokens[fields[0]]; ok {
+			return nil, fmt.Errorf("%s:%d: duplicate client %q", path, i+1, fields[0])
+		}
+		tokens[fields[0]] = fields[1]
+	}
+	return tokens, nil
+}
+
 func (n *Node) startRPC() ([]net.Listener, error) {
 	err := n.ConfigureRPC()
 	if err != nil {
@@ -1260,6 +1505,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	config.MaxBodyBytes = n.config.RPC.MaxBodyBytes
 	config.MaxHeaderBytes = n.config.RPC.MaxHeaderBytes
 	config.MaxOpenConnections = n.config.RPC.MaxOpenConnections
+	if err := ConfigureRPCAccess(n.config.RPC, config); err != nil {
+		return nil, err
+	}
 	// If necessary adjust global WriteTimeout to ensure it's greater than
 	// TimeoutBroadcastTxCommit.
 	// See https://github.com/tendermint/tendermint/issues/3435
@@ -1337,7 +1585,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		listeners[i] = listener
 	}
 
-	// we expose a simplified api over grpc for convenience to app devs
+	// we expose a subset of the rpc api over grpc for convenience to app devs
 	grpcListenAddr := n.config.RPC.GRPCListenAddress
 	if grpcListenAddr != "" {
 		config := rpcserver.DefaultConfig()
@@ -1491,21 +1739,6 @@ func makeNodeInfo(
 		txIndexerStatus = "off"
 	}
 
-	var bcChannel byte
-	switch config.FastSync.Version {
-	case "v0":
-		bcChannel = bcv0.BlockchainChannel
-	case "v1":
-		bcChannel = bcv1.BlockchainChannel
-	case "v2":
-		bcChannel = bcv2.BlockchainChannel
-	default:
-		return p2p.DefaultNodeInfo{}, fmt.Errorf(
-			"unknown fastsync version %s",
-			config.FastSync.Version,
-		)
-	}
-
 	nodeInfo := p2p.DefaultNodeInfo{
 		ProTxHash: nodeProTxHash,
 		ProtocolVersion: p2p.NewProtocolVersion(
@@ -1517,7 +1750,7 @@ func makeNodeInfo(
 		Network:       genDoc.ChainID,
 		Version:       version.TMCoreSemVer,
 		Channels: []byte{
-			bcChannel,
+			bc.BlockchainChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
@@ -3,8 +3,10 @@ package node
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
 	"syscall"
 	"testing"
 	"time"
@@ -28,6 +30,7 @@ import (
 	p2pmock "github.com/tendermint/tendermint/p2p/mock"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/store"
 	"github.com/tendermint/tendermint/types"
@@ -96,6 +99,36 @@ func TestSplitAndTrimEmpty(t *testing.T) {
 	}
 }
 
+func TestConfigureRPCAccess(t *testing.T) {
+	config := cfg.ResetTestRoot("node_rpc_access_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// the default config allows everything
+	serverConfig := rpcserver.DefaultConfig()
+	require.NoError(t, ConfigureRPCAccess(config.RPC, serverConfig))
+	assert.Nil(t, serverConfig.AccessControl)
+
+	tokensFile := filepath.Join(config.RootDir, "tokens")
+	require.NoError(t, ioutil.WriteFile(tokensFile, []byte("# clients\nalice s3cret\n\nbob t0ken\n"), 0600))
+	config.RPC.AuthTokensFile = tokensFile
+	config.RPC.AnonymousRoutes = []string{"status"}
+	require.NoError(t, ConfigureRPCAccess(config.RPC, serverConfig))
+	assert.NotNil(t, serverConfig.AccessControl)
+
+	tokens, err := loadRPCAuthTokens(tokensFile)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"alice": "s3cret", "bob": "t0ken"}, tokens)
+
+	for _, invalid := range []string{"alice\n", "alice s3cret\nalice t0ken\n"} {
+		require.NoError(t, ioutil.WriteFile(tokensFile, []byte(invalid), 0600))
+		assert.Error(t, ConfigureRPCAccess(config.RPC, rpcserver.DefaultConfig()), invalid)
+	}
+
+	config.RPC.AuthTokensFile = ""
+	config.RPC.TLSClientCAFile = tokensFile
+	assert.Error(t, ConfigureRPCAccess(config.RPC, rpcserver.DefaultConfig()), "no certificates")
+}
+
 func TestNodeDelayedStart(t *testing.T) {
 	config := cfg.ResetTestRoot("node_delayed_start_test")
 	defer os.RemoveAll(config.RootDir)
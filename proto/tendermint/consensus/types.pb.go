@@ -707,6 +707,76 @@ func (m *VoteSetBits) GetVotes() bits.BitArray {
 	return bits.BitArray{}
 }
 
+// VoteSetMissing is sent to communicate the bit-array of vote signature shares
+// the sender is still missing at the given height, round and type.
+type VoteSetMissing struct {
+	Height  int64               `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int32               `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Type    types.SignedMsgType `protobuf:"varint,3,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
+	Missing bits.BitArray       `protobuf:"bytes,4,opt,name=missing,proto3" json:"missing"`
+}
+
+func (m *VoteSetMissing) Reset()         { *m = VoteSetMissing{} }
+func (m *VoteSetMissing) String() string { return proto.CompactTextString(m) }
+func (*VoteSetMissing) ProtoMessage()    {}
+func (*VoteSetMissing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81a22d2efc008981, []int{11}
+}
+func (m *VoteSetMissing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteSetMissing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteSetMissing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteSetMissing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteSetMissing.Merge(m, src)
+}
+func (m *VoteSetMissing) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteSetMissing) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteSetMissing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteSetMissing proto.InternalMessageInfo
+
+func (m *VoteSetMissing) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VoteSetMissing) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *VoteSetMissing) GetType() types.SignedMsgType {
+	if m != nil {
+		return m.Type
+	}
+	return types.UnknownType
+}
+
+func (m *VoteSetMissing) GetMissing() bits.BitArray {
+	if m != nil {
+		return m.Missing
+	}
+	return bits.BitArray{}
+}
+
 type Message struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Message_NewRoundStep
@@ -720,6 +790,7 @@ type Message struct {
 	//	*Message_VoteSetBits
 	//	*Message_Commit
 	//	*Message_HasCommit
+	//	*Message_VoteSetMissing
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -727,7 +798,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_81a22d2efc008981, []int{11}
+	return fileDescriptor_81a22d2efc008981, []int{12}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,18 +866,22 @@ type Message_Commit struct {
 type Message_HasCommit struct {
 	HasCommit *HasCommit `protobuf:"bytes,11,opt,name=has_commit,json=hasCommit,proto3,oneof" json:"has_commit,omitempty"`
 }
+type Message_VoteSetMissing struct {
+	VoteSetMissing *VoteSetMissing `protobuf:"bytes,12,opt,name=vote_set_missing,json=voteSetMissing,proto3,oneof" json:"vote_set_missing,omitempty"`
+}
 
-func (*Message_NewRoundStep) isMessage_Sum()  {}
-func (*Message_NewValidBlock) isMessage_Sum() {}
-func (*Message_Proposal) isMessage_Sum()      {}
-func (*Message_ProposalPol) isMessage_Sum()   {}
-func (*Message_BlockPart) isMessage_Sum()     {}
-func (*Message_Vote) isMessage_Sum()          {}
-func (*Message_HasVote) isMessage_Sum()       {}
-func (*Message_VoteSetMaj23) isMessage_Sum()  {}
-func (*Message_VoteSetBits) isMessage_Sum()   {}
-func (*Message_Commit) isMessage_Sum()        {}
-func (*Message_HasCommit) isMessage_Sum()     {}
+func (*Message_NewRoundStep) isMessage_Sum()   {}
+func (*Message_NewValidBlock) isMessage_Sum()  {}
+func (*Message_Proposal) isMessage_Sum()       {}
+func (*Message_ProposalPol) isMessage_Sum()    {}
+func (*Message_BlockPart) isMessage_Sum()      {}
+func (*Message_Vote) isMessage_Sum()           {}
+func (*Message_HasVote) isMessage_Sum()        {}
+func (*Message_VoteSetMaj23) isMessage_Sum()   {}
+func (*Message_VoteSetBits) isMessage_Sum()    {}
+func (*Message_Commit) isMessage_Sum()         {}
+func (*Message_HasCommit) isMessage_Sum()      {}
+func (*Message_VoteSetMissing) isMessage_Sum() {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -892,6 +967,13 @@ func (m *Message) GetHasCommit() *HasCommit {
 	return nil
 }
 
+func (m *Message) GetVoteSetMissing() *VoteSetMissing {
+	if x, ok := m.GetSum().(*Message_VoteSetMissing); ok {
+		return x.VoteSetMissing
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -906,6 +988,7 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_VoteSetBits)(nil),
 		(*Message_Commit)(nil),
 		(*Message_HasCommit)(nil),
+		(*Message_VoteSetMissing)(nil),
 	}
 }
 
@@ -921,71 +1004,74 @@ func init() {
 	proto.RegisterType((*HasCommit)(nil), "tendermint.consensus.HasCommit")
 	proto.RegisterType((*VoteSetMaj23)(nil), "tendermint.consensus.VoteSetMaj23")
 	proto.RegisterType((*VoteSetBits)(nil), "tendermint.consensus.VoteSetBits")
+	proto.RegisterType((*VoteSetMissing)(nil), "tendermint.consensus.VoteSetMissing")
 	proto.RegisterType((*Message)(nil), "tendermint.consensus.Message")
 }
 
 func init() { proto.RegisterFile("tendermint/consensus/types.proto", fileDescriptor_81a22d2efc008981) }
 
 var fileDescriptor_81a22d2efc008981 = []byte{
-	// 914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0x9e, 0x21, 0xfe, 0xad, 0xc9, 0x0f, 0xb4, 0xb2, 0x2b, 0x13, 0x16, 0x27, 0x0c, 0x97, 0x08,
-	0x21, 0x7b, 0xe5, 0x48, 0x20, 0x02, 0x12, 0x60, 0x7e, 0x76, 0x76, 0xb5, 0xd9, 0xb5, 0xc6, 0xcb,
-	0x0a, 0x71, 0x19, 0x8d, 0x3d, 0x2d, 0xbb, 0x59, 0x7b, 0x7a, 0x34, 0xdd, 0x49, 0xc8, 0x95, 0x27,
-	0xe0, 0x01, 0x78, 0x0d, 0x24, 0x1e, 0x61, 0x8f, 0x7b, 0xe4, 0xb4, 0x42, 0xc9, 0x0b, 0x20, 0x21,
-	0xee, 0xa8, 0xab, 0xdb, 0xe3, 0x0e, 0x99, 0x04, 0x7c, 0x41, 0xda, 0xdb, 0xcc, 0x54, 0xd5, 0xd7,
-	0x55, 0x5f, 0x55, 0x7d, 0x3d, 0xb0, 0x27, 0x69, 0x9a, 0xd0, 0x7c, 0xce, 0x52, 0xd9, 0x1d, 0xf3,
-	0x54, 0xd0, 0x54, 0x1c, 0x8b, 0xae, 0x3c, 0xcb, 0xa8, 0xe8, 0x64, 0x39, 0x97, 0x9c, 0x6c, 0x2f,
-	0x3d, 0x3a, 0x85, 0xc7, 0xce, 0xf6, 0x84, 0x4f, 0x38, 0x3a, 0x74, 0xd5, 0x93, 0xf6, 0xdd, 0xb9,
-	0x63, 0xa1, 0x21, 0x86, 0x8d, 0xb4, 0x63, 0x9f, 0x35, 0x63, 0x23, 0xd1, 0x1d, 0x31, 0x79, 0xc9,
-	0xc3, 0xff, 0xc5, 0x85, 0xf5, 0x47, 0xf4, 0x34, 0xe4, 0xc7, 0x69, 0x32, 0x94, 0x34, 0x23, 0xb7,
-	0xa1, 0x36, 0xa5, 0x6c, 0x32, 0x95, 0x2d, 0x77, 0xcf, 0xdd, 0x5f, 0x0b, 0xcd, 0x1b, 0xd9, 0x86,
-	0x6a, 0xae, 0x9c, 0x5a, 0xaf, 0xed, 0xb9, 0xfb, 0xd5, 0x50, 0xbf, 0x10, 0x02, 0x15, 0x21, 0x69,
-	0xd6, 0x5a, 0xdb, 0x73, 0xf7, 0x37, 0x42, 0x7c, 0x26, 0x1f, 0x42, 0x4b, 0xd0, 0x31, 0x4f, 0x13,
-	0x11, 0x09, 0x96, 0x8e, 0x69, 0x24, 0x64, 0x9c, 0xcb, 0x48, 0xb2, 0x39, 0x6d, 0x55, 0x10, 0xf3,
-	0x96, 0xb1, 0x0f, 0x95, 0x79, 0xa8, 0xac, 0x4f, 0xd8, 0x9c, 0x92, 0xf7, 0xe0, 0x8d, 0x59, 0x2c,
-	0x64, 0x34, 0xe6, 0xf3, 0x39, 0x93, 0x91, 0x3e, 0xae, 0x8a, 0xc7, 0x6d, 0x29, 0xc3, 0x17, 0xf8,
-	0x1d, 0x53, 0xf5, 0xff, 0x72, 0x61, 0xe3, 0x11, 0x3d, 0x7d, 0x1a, 0xcf, 0x58, 0xd2, 0x9f, 0xf1,
-	0xf1, 0xb3, 0x15, 0x13, 0xff, 0x16, 0x6e, 0x8d, 0x54, 0x58, 0x94, 0xa9, 0xdc, 0x04, 0x95, 0xd1,
-	0x94, 0xc6, 0x09, 0xcd, 0xb1, 0x12, 0xaf, 0xb7, 0xdb, 0xb1, 0x7a, 0xa0, 0xf9, 0x1a, 0xc4, 0xb9,
-	0x1c, 0x52, 0x19, 0xa0, 0x5b, 0xbf, 0xf2, 0xfc, 0xe5, 0xae, 0x13, 0x12, 0xc4, 0xb8, 0x64, 0x21,
-	0x9f, 0x82, 0xb7, 0x44, 0x16, 0x58, 0xb1, 0xd7, 0x6b, 0xdb, 0x78, 0xaa, 0x13, 0x1d, 0xd5, 0x89,
-	0x4e, 0x9f, 0xc9, 0xcf, 0xf3, 0x3c, 0x3e, 0x0b, 0xa1, 0x00, 0x12, 0xe4, 0x2d, 0x68, 0x32, 0x61,
-	0x48, 0xc0, 0xf2, 0x1b, 0x61, 0x83, 0x09, 0x5d, 0xbc, 0x1f, 0x40, 0x63, 0x90, 0xf3, 0x8c, 0x8b,
-	0x78, 0x46, 0x3e, 0x81, 0x46, 0x66, 0x9e, 0xb1, 0x66, 0xaf, 0xb7, 0x53, 0x92, 0xb6, 0xf1, 0x30,
-	0x19, 0x17, 0x11, 0xfe, 0xcf, 0x2e, 0x78, 0x0b, 0xe3, 0xe0, 0xf1, 0xc3, 0x6b, 0xf9, 0x7b, 0x1f,
-	0xc8, 0x22, 0x26, 0xca, 0xf8, 0x2c, 0xb2, 0xc9, 0x7c, 0x7d, 0x61, 0x19, 0xf0, 0x19, 0xf6, 0x85,
-	0xdc, 0x83, 0x75, 0xdb, 0xdb, 0xd0, 0xf9, 0x2f, 0xe5, 0x9b, 0xdc, 0x3c, 0x0b, 0xcd, 0x7f, 0x06,
-	0xcd, 0xfe, 0x82, 0x93, 0x15, 0x7b, 0x7b, 0x17, 0x2a, 0x8a, 0x7b, 0x73, 0xf6, 0xed, 0xf2, 0x56,
-	0x9a, 0x33, 0xd1, 0xd3, 0xef, 0x41, 0xe5, 0x29, 0x97, 0x6a, 0x02, 0x2b, 0x27, 0x5c, 0x52, 0xc3,
-	0x66, 0x49, 0xa4, 0xf2, 0x0a, 0xd1, 0xc7, 0xff, 0xd1, 0x85, 0x7a, 0x10, 0x0b, 0x8c, 0x5b, 0x2d,
-	0xbf, 0x03, 0xa8, 0x28, 0x34, 0xcc, 0x6f, 0xb3, 0x6c, 0xd4, 0x86, 0x6c, 0x92, 0xd2, 0xe4, 0x48,
-	0x4c, 0x9e, 0x9c, 0x65, 0x34, 0x44, 0x67, 0x05, 0xc5, 0xd2, 0x84, 0xfe, 0x80, 0x03, 0x55, 0x0d,
-	0xf5, 0x8b, 0x7f, 0x08, 0x35, 0x3d, 0x18, 0xe4, 0x2e, 0xd4, 0xcc, 0xc8, 0xe8, 0xe4, 0x5b, 0x57,
-	0x61, 0xcd, 0xfe, 0x18, 0x3f, 0xff, 0x23, 0x68, 0x06, 0xb1, 0x99, 0xab, 0xd5, 0x2a, 0xf0, 0x7f,
-	0x75, 0x61, 0x5d, 0x15, 0x3e, 0xa4, 0xf2, 0x28, 0xfe, 0xbe, 0x77, 0xf0, 0x7f, 0x10, 0xf0, 0x15,
-	0x34, 0xf4, 0x5e, 0xb1, 0xc4, 0x2c, 0xd5, 0x9b, 0x57, 0x03, 0x71, 0x64, 0xee, 0x7f, 0xd9, 0xdf,
-	0x52, 0xcd, 0x3d, 0x7f, 0xb9, 0x5b, 0x37, 0x1f, 0xc2, 0x3a, 0xc6, 0xde, 0x4f, 0xfc, 0x3f, 0x5d,
-	0xf0, 0x4c, 0xea, 0x7d, 0x26, 0xc5, 0xab, 0x93, 0x39, 0x39, 0x84, 0xaa, 0x1a, 0x3c, 0x81, 0x9a,
-	0xf0, 0x5f, 0x77, 0x4a, 0x87, 0xf8, 0x7f, 0x54, 0xa1, 0x7e, 0x44, 0x85, 0x88, 0x27, 0x94, 0x3c,
-	0x80, 0xcd, 0x94, 0x9e, 0xea, 0x3d, 0x8e, 0x50, 0xbd, 0xf5, 0xc4, 0xf8, 0x9d, 0xb2, 0x7b, 0xa7,
-	0x63, 0xdf, 0x0e, 0x81, 0x13, 0xae, 0xa7, 0xf6, 0x6d, 0x71, 0x04, 0x5b, 0x0a, 0xeb, 0x44, 0xc9,
-	0x70, 0x84, 0x89, 0x22, 0x5f, 0x5e, 0xef, 0xdd, 0x6b, 0xc1, 0x96, 0x92, 0x1d, 0x38, 0xe1, 0x46,
-	0x7a, 0x49, 0xc3, 0x6d, 0x45, 0x2b, 0x51, 0x8e, 0x25, 0xce, 0x42, 0xb8, 0x02, 0x4b, 0xd1, 0xc8,
-	0xd7, 0xff, 0xd0, 0x1e, 0xcd, 0xf5, 0x3b, 0x37, 0x23, 0x0c, 0x1e, 0x3f, 0x0c, 0x2e, 0x4b, 0x0f,
-	0xf9, 0x0c, 0x60, 0xa9, 0xe0, 0x86, 0xed, 0xdd, 0x72, 0x94, 0x42, 0xa2, 0x02, 0x27, 0x6c, 0x16,
-	0x1a, 0xae, 0x14, 0x08, 0x75, 0xa4, 0x76, 0x55, 0x95, 0x97, 0xb1, 0x6a, 0x0a, 0x03, 0x47, 0xab,
-	0x09, 0x39, 0x84, 0xc6, 0x34, 0x16, 0x11, 0x46, 0xd5, 0x31, 0xea, 0xed, 0xf2, 0x28, 0x23, 0x39,
-	0x81, 0x13, 0xd6, 0xa7, 0x46, 0x7d, 0x1e, 0xc0, 0xa6, 0x8a, 0xc3, 0x5b, 0x6c, 0xae, 0xd6, 0xb1,
-	0xd5, 0xb8, 0xa9, 0xa1, 0xf6, 0xe2, 0xaa, 0x86, 0x9e, 0xd8, 0x8b, 0x7c, 0x0f, 0x36, 0x0a, 0x2c,
-	0x35, 0x4f, 0xad, 0xe6, 0x4d, 0x24, 0x5a, 0x8b, 0xa4, 0x48, 0x3c, 0xb1, 0xf6, 0xea, 0x83, 0x42,
-	0x8f, 0x00, 0x11, 0xee, 0x94, 0x23, 0x68, 0xf9, 0x09, 0x9c, 0x85, 0x2a, 0x29, 0xf2, 0x15, 0x11,
-	0x26, 0xd6, 0xbb, 0x89, 0xfc, 0x42, 0xbd, 0x14, 0xf9, 0xd3, 0xc5, 0x4b, 0xbf, 0x0a, 0x6b, 0xe2,
-	0x78, 0xde, 0xff, 0xe6, 0xf9, 0x79, 0xdb, 0x7d, 0x71, 0xde, 0x76, 0x7f, 0x3f, 0x6f, 0xbb, 0x3f,
-	0x5d, 0xb4, 0x9d, 0x17, 0x17, 0x6d, 0xe7, 0xb7, 0x8b, 0xb6, 0xf3, 0xdd, 0xc7, 0x13, 0x26, 0xa7,
-	0xc7, 0xa3, 0xce, 0x98, 0xcf, 0xbb, 0xf6, 0xef, 0xd3, 0xf2, 0x51, 0xff, 0x66, 0x95, 0xfd, 0xa8,
-	0x8d, 0x6a, 0x68, 0x3b, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xb1, 0x2b, 0x7f, 0xc7, 0x09,
-	0x00, 0x00,
+	// 956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4b, 0x6f, 0x23, 0x45,
+	0x10, 0x9e, 0x21, 0x7e, 0xd6, 0x38, 0xc9, 0xd2, 0xca, 0xae, 0x86, 0xb0, 0x38, 0x61, 0xe0, 0x10,
+	0x21, 0x64, 0xaf, 0x1c, 0x09, 0x44, 0x40, 0x3c, 0xcc, 0x63, 0x67, 0xd1, 0x66, 0xd7, 0x1a, 0x2f,
+	0x2b, 0xc4, 0x65, 0x34, 0xf6, 0xb4, 0xec, 0x66, 0x3d, 0x0f, 0x4d, 0x77, 0x1c, 0x72, 0xe5, 0x17,
+	0xf0, 0x03, 0xf8, 0x17, 0x08, 0x89, 0x33, 0xa7, 0x3d, 0xee, 0x91, 0xd3, 0x0a, 0x39, 0x3f, 0x01,
+	0x71, 0x47, 0xfd, 0x18, 0x4f, 0x9b, 0x4c, 0x2c, 0x7c, 0x89, 0xc4, 0xad, 0x7b, 0xba, 0xea, 0xeb,
+	0xaa, 0xaf, 0xaa, 0xbf, 0x1a, 0x38, 0x64, 0x38, 0x0e, 0x71, 0x16, 0x91, 0x98, 0x75, 0xc7, 0x49,
+	0x4c, 0x71, 0x4c, 0xcf, 0x68, 0x97, 0x5d, 0xa4, 0x98, 0x76, 0xd2, 0x2c, 0x61, 0x09, 0xda, 0x2b,
+	0x2c, 0x3a, 0x4b, 0x8b, 0xfd, 0xbd, 0x49, 0x32, 0x49, 0x84, 0x41, 0x97, 0xaf, 0xa4, 0xed, 0xfe,
+	0x5d, 0x0d, 0x4d, 0x60, 0xe8, 0x48, 0xfb, 0xfa, 0x5d, 0x33, 0x32, 0xa2, 0xdd, 0x11, 0x61, 0x2b,
+	0x16, 0xce, 0xaf, 0x26, 0xb4, 0x1e, 0xe1, 0x73, 0x2f, 0x39, 0x8b, 0xc3, 0x21, 0xc3, 0x29, 0xba,
+	0x03, 0xb5, 0x29, 0x26, 0x93, 0x29, 0xb3, 0xcd, 0x43, 0xf3, 0x68, 0xcb, 0x53, 0x3b, 0xb4, 0x07,
+	0xd5, 0x8c, 0x1b, 0xd9, 0xaf, 0x1c, 0x9a, 0x47, 0x55, 0x4f, 0x6e, 0x10, 0x82, 0x0a, 0x65, 0x38,
+	0xb5, 0xb7, 0x0e, 0xcd, 0xa3, 0x6d, 0x4f, 0xac, 0xd1, 0xfb, 0x60, 0x53, 0x3c, 0x4e, 0xe2, 0x90,
+	0xfa, 0x94, 0xc4, 0x63, 0xec, 0x53, 0x16, 0x64, 0xcc, 0x67, 0x24, 0xc2, 0x76, 0x45, 0x60, 0xde,
+	0x56, 0xe7, 0x43, 0x7e, 0x3c, 0xe4, 0xa7, 0x4f, 0x48, 0x84, 0xd1, 0x3b, 0xf0, 0xea, 0x2c, 0xa0,
+	0xcc, 0x1f, 0x27, 0x51, 0x44, 0x98, 0x2f, 0xaf, 0xab, 0x8a, 0xeb, 0x76, 0xf9, 0xc1, 0xe7, 0xe2,
+	0xbb, 0x08, 0xd5, 0xf9, 0xdb, 0x84, 0xed, 0x47, 0xf8, 0xfc, 0x69, 0x30, 0x23, 0x61, 0x7f, 0x96,
+	0x8c, 0x9f, 0x6d, 0x18, 0xf8, 0xb7, 0x70, 0x7b, 0xc4, 0xdd, 0xfc, 0x94, 0xc7, 0x46, 0x31, 0xf3,
+	0xa7, 0x38, 0x08, 0x71, 0x26, 0x32, 0xb1, 0x7a, 0x07, 0x1d, 0xad, 0x06, 0x92, 0xaf, 0x41, 0x90,
+	0xb1, 0x21, 0x66, 0xae, 0x30, 0xeb, 0x57, 0x9e, 0xbf, 0x3c, 0x30, 0x3c, 0x24, 0x30, 0x56, 0x4e,
+	0xd0, 0x27, 0x60, 0x15, 0xc8, 0x54, 0x64, 0x6c, 0xf5, 0xda, 0x3a, 0x1e, 0xaf, 0x44, 0x87, 0x57,
+	0xa2, 0xd3, 0x27, 0xec, 0xb3, 0x2c, 0x0b, 0x2e, 0x3c, 0x58, 0x02, 0x51, 0xf4, 0x3a, 0x34, 0x09,
+	0x55, 0x24, 0x88, 0xf4, 0x1b, 0x5e, 0x83, 0x50, 0x99, 0xbc, 0xe3, 0x42, 0x63, 0x90, 0x25, 0x69,
+	0x42, 0x83, 0x19, 0xfa, 0x08, 0x1a, 0xa9, 0x5a, 0x8b, 0x9c, 0xad, 0xde, 0x7e, 0x49, 0xd8, 0xca,
+	0x42, 0x45, 0xbc, 0xf4, 0x70, 0x7e, 0x36, 0xc1, 0xca, 0x0f, 0x07, 0x8f, 0x1f, 0x5e, 0xcb, 0xdf,
+	0xbb, 0x80, 0x72, 0x1f, 0x3f, 0x4d, 0x66, 0xbe, 0x4e, 0xe6, 0xad, 0xfc, 0x64, 0x90, 0xcc, 0x44,
+	0x5d, 0xd0, 0x7d, 0x68, 0xe9, 0xd6, 0xf6, 0xd6, 0x7f, 0x49, 0x5f, 0xc5, 0x66, 0x69, 0x68, 0xce,
+	0x33, 0x68, 0xf6, 0x73, 0x4e, 0x36, 0xac, 0xed, 0x3d, 0xa8, 0x70, 0xee, 0xd5, 0xdd, 0x77, 0xca,
+	0x4b, 0xa9, 0xee, 0x14, 0x96, 0x4e, 0x0f, 0x2a, 0x4f, 0x13, 0xc6, 0x3b, 0xb0, 0x32, 0x4f, 0x18,
+	0xb6, 0xcd, 0xeb, 0x3c, 0xb9, 0x95, 0x27, 0x6c, 0x9c, 0x1f, 0x4d, 0xa8, 0xbb, 0x01, 0x15, 0x7e,
+	0x9b, 0xc5, 0x77, 0x0c, 0x15, 0x8e, 0x26, 0xe2, 0xdb, 0x29, 0x6b, 0xb5, 0x21, 0x99, 0xc4, 0x38,
+	0x3c, 0xa5, 0x93, 0x27, 0x17, 0x29, 0xf6, 0x84, 0x31, 0x87, 0x22, 0x71, 0x88, 0x7f, 0x10, 0x0d,
+	0x55, 0xf5, 0xe4, 0xc6, 0x39, 0x81, 0x9a, 0x6c, 0x0c, 0x74, 0x0f, 0x6a, 0xaa, 0x65, 0x64, 0xf0,
+	0xf6, 0x55, 0x58, 0xf5, 0x7e, 0x94, 0x9d, 0xf3, 0x01, 0x34, 0xdd, 0x40, 0xf5, 0xd5, 0x66, 0x19,
+	0x38, 0xbf, 0x99, 0xd0, 0xe2, 0x89, 0x0f, 0x31, 0x3b, 0x0d, 0xbe, 0xef, 0x1d, 0xdf, 0x04, 0x01,
+	0x5f, 0x42, 0x43, 0xbe, 0x2b, 0x12, 0xaa, 0x47, 0xf5, 0xda, 0x55, 0x47, 0xd1, 0x32, 0x0f, 0xbe,
+	0xe8, 0xef, 0xf2, 0xe2, 0x2e, 0x5e, 0x1e, 0xd4, 0xd5, 0x07, 0xaf, 0x2e, 0x7c, 0x1f, 0x84, 0xce,
+	0x5f, 0x26, 0x58, 0x2a, 0xf4, 0x3e, 0x61, 0xf4, 0xff, 0x13, 0x39, 0x3a, 0x81, 0x2a, 0x6f, 0x3c,
+	0x6a, 0x57, 0x37, 0x78, 0x53, 0xd2, 0xc5, 0xf9, 0xc5, 0x84, 0x9d, 0xbc, 0x60, 0x84, 0x52, 0x12,
+	0x4f, 0x6e, 0x22, 0xf1, 0x8f, 0xa1, 0x1e, 0xc9, 0xdb, 0xec, 0xca, 0x06, 0x31, 0xe7, 0x4e, 0xce,
+	0xef, 0x35, 0xa8, 0x9f, 0x62, 0x4a, 0x83, 0x09, 0x46, 0x5f, 0xc3, 0x4e, 0x8c, 0xcf, 0xa5, 0xfa,
+	0xf8, 0x62, 0xe6, 0xc8, 0x3e, 0x77, 0x3a, 0x65, 0xd3, 0xb2, 0xa3, 0xcf, 0x34, 0xd7, 0xf0, 0x5a,
+	0xb1, 0xb6, 0x47, 0xa7, 0xb0, 0xcb, 0xb1, 0xe6, 0x7c, 0x78, 0xf8, 0x82, 0x5e, 0x91, 0xac, 0xd5,
+	0x7b, 0xeb, 0x5a, 0xb0, 0x62, 0xd0, 0xb8, 0x86, 0xb7, 0x1d, 0xeb, 0x1f, 0x56, 0x74, 0xb8, 0x44,
+	0xef, 0x0a, 0x9c, 0x5c, 0x6e, 0x5d, 0x4d, 0x87, 0xd1, 0x57, 0xff, 0x52, 0x4c, 0xc9, 0xd4, 0x9b,
+	0xeb, 0x11, 0x06, 0x8f, 0x1f, 0xba, 0xab, 0x82, 0x89, 0x3e, 0x05, 0x28, 0xe6, 0x8e, 0xea, 0x91,
+	0x83, 0x72, 0x94, 0xa5, 0xb0, 0xba, 0x86, 0xd7, 0x5c, 0x4e, 0x1e, 0xae, 0x9b, 0x42, 0xfd, 0x6a,
+	0x57, 0x67, 0x49, 0xe1, 0xcb, 0xbb, 0xc8, 0x35, 0xa4, 0x06, 0xa2, 0x13, 0x68, 0x4c, 0x03, 0xea,
+	0x0b, 0xaf, 0xba, 0xf0, 0x7a, 0xa3, 0xdc, 0x4b, 0x09, 0xa5, 0x6b, 0x78, 0xf5, 0xa9, 0x5c, 0xf2,
+	0x82, 0x72, 0x3f, 0x31, 0x7b, 0x23, 0x2e, 0x22, 0x76, 0x63, 0x5d, 0x41, 0x75, 0xb9, 0xe1, 0x05,
+	0x9d, 0x6b, 0x7b, 0x74, 0x1f, 0xb6, 0x97, 0x58, 0xbc, 0xa3, 0xec, 0xe6, 0x3a, 0x12, 0xb5, 0xe7,
+	0xcf, 0x49, 0x9c, 0x17, 0x5b, 0xf4, 0xde, 0x52, 0x45, 0x41, 0x20, 0xdc, 0x2d, 0x47, 0x90, 0xa2,
+	0xe9, 0x1a, 0xb9, 0x96, 0x72, 0xf2, 0x39, 0x11, 0xca, 0xd7, 0x5a, 0x47, 0xfe, 0x52, 0x73, 0x39,
+	0xf9, 0xd3, 0x7c, 0x83, 0x06, 0x70, 0xab, 0xa0, 0x43, 0x3d, 0x9a, 0x96, 0xc0, 0x79, 0x7b, 0x3d,
+	0x21, 0xd2, 0xd6, 0x35, 0xbc, 0x9d, 0xf9, 0xca, 0x97, 0x7e, 0x15, 0xb6, 0xe8, 0x59, 0xd4, 0xff,
+	0xe6, 0xf9, 0xa2, 0x6d, 0xbe, 0x58, 0xb4, 0xcd, 0x3f, 0x17, 0x6d, 0xf3, 0xa7, 0xcb, 0xb6, 0xf1,
+	0xe2, 0xb2, 0x6d, 0xfc, 0x71, 0xd9, 0x36, 0xbe, 0xfb, 0x70, 0x42, 0xd8, 0xf4, 0x6c, 0xd4, 0x19,
+	0x27, 0x51, 0x57, 0xff, 0x8d, 0x2c, 0x96, 0xf2, 0x77, 0xb3, 0xec, 0x87, 0x75, 0x54, 0x13, 0x67,
+	0xc7, 0xff, 0x0c, 0x00, 0x8e, 0x71, 0x4c, 0x19, 0xcf, 0x0a, 0x00, 0x00,
 }
 
 func (m *NewRoundStep) Marshal() (dAtA []byte, err error) {
@@ -1472,6 +1558,54 @@ func (m *VoteSetBits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoteSetMissing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteSetMissing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteSetMissing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Missing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Round != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1735,6 +1869,27 @@ func (m *Message_HasCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_VoteSetMissing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_VoteSetMissing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VoteSetMissing != nil {
+		{
+			size, err := m.VoteSetMissing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1943,6 +2098,26 @@ func (m *VoteSetBits) Size() (n int) {
 	return n
 }
 
+func (m *VoteSetMissing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTypes(uint64(m.Round))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = m.Missing.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Message) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2087,6 +2262,18 @@ func (m *Message_HasCommit) Size() (n int) {
 	}
 	return n
 }
+func (m *Message_VoteSetMissing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteSetMissing != nil {
+		l = m.VoteSetMissing.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -3440,6 +3627,146 @@ func (m *VoteSetBits) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteSetMissing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteSetMissing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteSetMissing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.SignedMsgType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Missing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Missing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3854,6 +4181,41 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Message_HasCommit{v}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteSetMissing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VoteSetMissing{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_VoteSetMissing{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
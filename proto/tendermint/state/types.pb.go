@@ -281,6 +281,12 @@ type State struct {
 	LastResultsHash []byte `protobuf:"bytes,12,opt,name=last_results_hash,json=lastResultsHash,proto3" json:"last_results_hash,omitempty"`
 	// the latest AppHash we've received from calling abci.Commit()
 	AppHash []byte `protobuf:"bytes,13,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+	// HaltHeight is the height after which the chain halts, as requested by
+	// the application, 0 if none.
+	HaltHeight int64 `protobuf:"varint,103,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
+	// AppVersionProposals are the app versions proposed by the validators in
+	// their latest blocks, which are higher than the current app version.
+	AppVersionProposals []AppVersionProposal `protobuf:"bytes,104,rep,name=app_version_proposals,json=appVersionProposals,proto3" json:"app_version_proposals"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -435,71 +441,155 @@ func (m *State) GetAppHash() []byte {
 	return nil
 }
 
+func (m *State) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
+func (m *State) GetAppVersionProposals() []AppVersionProposal {
+	if m != nil {
+		return m.AppVersionProposals
+	}
+	return nil
+}
+
+// AppVersionProposal is the app version proposed by a validator in its latest
+// block.
+type AppVersionProposal struct {
+	ProTxHash  []byte `protobuf:"bytes,1,opt,name=pro_tx_hash,json=proTxHash,proto3" json:"pro_tx_hash,omitempty"`
+	AppVersion uint64 `protobuf:"varint,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// height is the height of the block proposing the app version
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AppVersionProposal) Reset()         { *m = AppVersionProposal{} }
+func (m *AppVersionProposal) String() string { return proto.CompactTextString(m) }
+func (*AppVersionProposal) ProtoMessage()    {}
+func (*AppVersionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccfacf933f22bf93, []int{5}
+}
+func (m *AppVersionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppVersionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppVersionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppVersionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppVersionProposal.Merge(m, src)
+}
+func (m *AppVersionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppVersionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppVersionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppVersionProposal proto.InternalMessageInfo
+
+func (m *AppVersionProposal) GetProTxHash() []byte {
+	if m != nil {
+		return m.ProTxHash
+	}
+	return nil
+}
+
+func (m *AppVersionProposal) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func (m *AppVersionProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ABCIResponses)(nil), "tendermint.state.ABCIResponses")
 	proto.RegisterType((*ValidatorsInfo)(nil), "tendermint.state.ValidatorsInfo")
 	proto.RegisterType((*ConsensusParamsInfo)(nil), "tendermint.state.ConsensusParamsInfo")
 	proto.RegisterType((*Version)(nil), "tendermint.state.Version")
 	proto.RegisterType((*State)(nil), "tendermint.state.State")
+	proto.RegisterType((*AppVersionProposal)(nil), "tendermint.state.AppVersionProposal")
 }
 
 func init() { proto.RegisterFile("tendermint/state/types.proto", fileDescriptor_ccfacf933f22bf93) }
 
 var fileDescriptor_ccfacf933f22bf93 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe2, 0x46,
-	0x14, 0xc7, 0x71, 0xd9, 0x5d, 0x60, 0x1c, 0x60, 0x77, 0xb2, 0x07, 0x2f, 0xdb, 0x35, 0x2c, 0xdb,
-	0x56, 0xa8, 0x07, 0x23, 0x6d, 0x0f, 0x55, 0x2f, 0x91, 0x62, 0x53, 0x35, 0xa8, 0x51, 0xd5, 0x3a,
-	0x51, 0x0e, 0xbd, 0x58, 0x83, 0x3d, 0xd8, 0x56, 0xc1, 0xb6, 0x3c, 0x03, 0x4d, 0xff, 0x80, 0xde,
-	0x73, 0xed, 0x7f, 0x94, 0x63, 0x8e, 0x55, 0x0e, 0x69, 0x45, 0xfe, 0x91, 0x6a, 0x7e, 0xd8, 0x1e,
-	0x20, 0x91, 0x52, 0xed, 0x6d, 0x3c, 0xef, 0xfb, 0x3e, 0xf3, 0xf5, 0xf3, 0xbc, 0x07, 0xe0, 0x73,
-	0x8a, 0x93, 0x00, 0xe7, 0xcb, 0x38, 0xa1, 0x63, 0x42, 0x11, 0xc5, 0x63, 0xfa, 0x47, 0x86, 0x89,
-	0x95, 0xe5, 0x29, 0x4d, 0xe1, 0xcb, 0x2a, 0x6a, 0xf1, 0x68, 0xef, 0x75, 0x98, 0x86, 0x29, 0x0f,
-	0x8e, 0xd9, 0x4a, 0xe8, 0x7a, 0x6f, 0x15, 0x0a, 0x9a, 0xf9, 0xb1, 0x0a, 0xe9, 0xa9, 0x47, 0xf0,
-	0xfd, 0xad, 0xe8, 0x60, 0x2f, 0xba, 0x46, 0x8b, 0x38, 0x40, 0x34, 0xcd, 0xa5, 0xe2, 0xdd, 0x9e,
-	0x22, 0x43, 0x39, 0x5a, 0x16, 0x00, 0x53, 0x09, 0xaf, 0x71, 0x4e, 0xe2, 0x34, 0xd9, 0x3a, 0xa0,
-	0x1f, 0xa6, 0x69, 0xb8, 0xc0, 0x63, 0xfe, 0x34, 0x5b, 0xcd, 0xc7, 0x34, 0x5e, 0x62, 0x42, 0xd1,
-	0x32, 0x13, 0x82, 0xe1, 0xad, 0x06, 0xda, 0xc7, 0xb6, 0x33, 0x75, 0x31, 0xc9, 0xd2, 0x84, 0x60,
-	0x02, 0x1d, 0xa0, 0x07, 0x78, 0x11, 0xaf, 0x71, 0xee, 0xd1, 0x4b, 0x62, 0x68, 0x83, 0xfa, 0x48,
-	0xff, 0x38, 0xb4, 0x94, 0x62, 0xb0, 0x97, 0xb4, 0x8a, 0x84, 0x89, 0xd0, 0x9e, 0x5f, 0xba, 0x20,
-	0x28, 0x96, 0x04, 0x1e, 0x81, 0x16, 0x4e, 0x02, 0x6f, 0xb6, 0x48, 0xfd, 0xdf, 0x8c, 0xcf, 0x06,
-	0xda, 0x48, 0xff, 0xf8, 0xfe, 0x51, 0xc4, 0xf7, 0x49, 0x60, 0x33, 0xa1, 0xdb, 0xc4, 0x72, 0x05,
-	0x27, 0x40, 0x9f, 0xe1, 0x30, 0x4e, 0x24, 0xa1, 0xce, 0x09, 0x1f, 0x1e, 0x25, 0xd8, 0x4c, 0x2b,
-	0x18, 0x60, 0x56, 0xae, 0x87, 0x7f, 0x6a, 0xa0, 0x73, 0x51, 0x14, 0x94, 0x4c, 0x93, 0x79, 0x0a,
-	0x1d, 0xd0, 0x2e, 0x4b, 0xec, 0x11, 0x4c, 0x0d, 0x8d, 0xa3, 0x4d, 0x15, 0x2d, 0x0a, 0x58, 0x26,
-	0x9e, 0x61, 0xea, 0x1e, 0xac, 0x95, 0x27, 0x68, 0x81, 0xc3, 0x05, 0x22, 0xd4, 0x8b, 0x70, 0x1c,
-	0x46, 0xd4, 0xf3, 0x23, 0x94, 0x84, 0x38, 0xe0, 0xef, 0x59, 0x77, 0x5f, 0xb1, 0xd0, 0x09, 0x8f,
-	0x38, 0x22, 0x30, 0xfc, 0x4b, 0x03, 0x87, 0x0e, 0xf3, 0x99, 0x90, 0x15, 0xf9, 0x99, 0x7f, 0x3f,
-	0x6e, 0xc6, 0x05, 0x2f, 0xfd, 0x62, 0xdb, 0x13, 0xdf, 0x55, 0xfa, 0x79, 0xbf, 0xef, 0x67, 0x07,
-	0x60, 0x3f, 0xbb, 0xbe, 0xeb, 0xd7, 0xdc, 0xae, 0xbf, 0xbd, 0xfd, 0xbf, 0xbd, 0x45, 0xa0, 0x71,
-	0x21, 0x2e, 0x0e, 0x3c, 0x06, 0xad, 0x92, 0x26, 0x7d, 0xbc, 0x53, 0x7d, 0xc8, 0x0b, 0x56, 0x39,
-	0x91, 0x1e, 0xaa, 0x2c, 0xd8, 0x03, 0x4d, 0x92, 0xce, 0xe9, 0xef, 0x28, 0xc7, 0xfc, 0xc8, 0x96,
-	0x5b, 0x3e, 0x0f, 0x6f, 0x9b, 0xe0, 0xf9, 0x19, 0xeb, 0x23, 0xf8, 0x1d, 0x68, 0x48, 0x96, 0x3c,
-	0xe6, 0x8d, 0xb5, 0xdb, 0x6b, 0x96, 0x34, 0x25, 0x8f, 0x28, 0xf4, 0xf0, 0x2b, 0xd0, 0xf4, 0x23,
-	0x14, 0x27, 0x5e, 0x2c, 0xde, 0xa9, 0x65, 0xeb, 0x9b, 0xbb, 0x7e, 0xc3, 0x61, 0x7b, 0xd3, 0x89,
-	0xdb, 0xe0, 0xc1, 0x69, 0x00, 0xbf, 0x04, 0x9d, 0x38, 0x89, 0x69, 0x8c, 0x16, 0xb2, 0x12, 0x46,
-	0x87, 0x57, 0xa0, 0x2d, 0x77, 0x45, 0x11, 0xe0, 0xd7, 0x80, 0x97, 0x44, 0x5c, 0xb3, 0x42, 0x59,
-	0xe7, 0xca, 0x2e, 0x0b, 0xf0, 0x7b, 0x24, 0xb5, 0x2e, 0x68, 0x2b, 0xda, 0x38, 0x30, 0x9e, 0xed,
-	0x7b, 0x17, 0x9f, 0x8a, 0x67, 0x4d, 0x27, 0xf6, 0x21, 0xf3, 0xbe, 0xb9, 0xeb, 0xeb, 0xa7, 0x05,
-	0x6a, 0x3a, 0x71, 0xf5, 0x92, 0x3b, 0x0d, 0x4a, 0x26, 0x7f, 0x67, 0xc6, 0x9c, 0x3f, 0xc6, 0xe4,
-	0x95, 0xdb, 0x65, 0xca, 0x4d, 0xc1, 0x14, 0x0f, 0x01, 0x3c, 0x05, 0x5d, 0xc5, 0x27, 0x6b, 0x78,
-	0xe3, 0x39, 0xa7, 0xf6, 0x2c, 0x31, 0x0d, 0xac, 0x62, 0x1a, 0x58, 0xe7, 0xc5, 0x34, 0xb0, 0x9b,
-	0x0c, 0x7b, 0xf5, 0x4f, 0x5f, 0x73, 0xdb, 0xa5, 0x3f, 0x16, 0x85, 0x3f, 0x82, 0x0f, 0x9c, 0xe6,
-	0xa7, 0x39, 0xf6, 0x44, 0xe9, 0x59, 0x0c, 0x07, 0xdb, 0x35, 0x0b, 0x06, 0xda, 0xa8, 0xed, 0x9a,
-	0x4c, 0xea, 0xa4, 0x39, 0xe6, 0xdf, 0xe3, 0x94, 0xeb, 0xd4, 0x12, 0x5e, 0x80, 0xd7, 0x09, 0xbe,
-	0xdc, 0x83, 0x19, 0x98, 0xfb, 0xeb, 0x3f, 0x74, 0xe9, 0x15, 0x16, 0xbf, 0x0b, 0x9a, 0xfb, 0x8a,
-	0x21, 0xb6, 0x02, 0xf0, 0x07, 0xd0, 0xe5, 0xdc, 0xb2, 0x4b, 0x89, 0xf1, 0xe2, 0x49, 0x7d, 0xdd,
-	0x61, 0x69, 0xd5, 0x88, 0x80, 0x47, 0x00, 0x28, 0x8c, 0xc6, 0x93, 0x18, 0x4a, 0x06, 0x33, 0xc2,
-	0xab, 0xa5, 0x40, 0x9a, 0x4f, 0x33, 0xc2, 0xd2, 0x14, 0x23, 0x0e, 0x30, 0xd5, 0x36, 0xae, 0x78,
-	0x65, 0x47, 0xb7, 0xf8, 0x2d, 0x7d, 0x5b, 0x75, 0x74, 0x95, 0x2d, 0x7b, 0xfb, 0xc1, 0xf9, 0x02,
-	0x3e, 0x71, 0xbe, 0xfc, 0x04, 0xbe, 0xd8, 0x9a, 0x2f, 0x3b, 0xfc, 0xd2, 0x9e, 0xce, 0xed, 0x0d,
-	0x94, 0x81, 0xb3, 0x0d, 0x2a, 0x3c, 0x16, 0x1d, 0x98, 0x63, 0xb2, 0x5a, 0x50, 0xe2, 0x45, 0x88,
-	0x44, 0xc6, 0xc1, 0x40, 0x1b, 0x1d, 0x88, 0x0e, 0x74, 0xc5, 0xfe, 0x09, 0x22, 0x11, 0x7c, 0x03,
-	0x9a, 0x28, 0xcb, 0x84, 0xa4, 0xcd, 0x25, 0x0d, 0x94, 0x65, 0x2c, 0x64, 0xff, 0x72, 0xbd, 0x31,
-	0xb5, 0x9b, 0x8d, 0xa9, 0xfd, 0xbb, 0x31, 0xb5, 0xab, 0x7b, 0xb3, 0x76, 0x73, 0x6f, 0xd6, 0xfe,
-	0xbe, 0x37, 0x6b, 0xbf, 0x7e, 0x1b, 0xc6, 0x34, 0x5a, 0xcd, 0x2c, 0x3f, 0x5d, 0x8e, 0xd5, 0x1f,
-	0xd3, 0x6a, 0x29, 0x7e, 0xd1, 0x77, 0xff, 0x0b, 0xcc, 0x5e, 0xf0, 0xfd, 0x6f, 0xfe, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xe3, 0xad, 0x37, 0x54, 0x26, 0x08, 0x00, 0x00,
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0xa4, 0x8d, 0xed, 0xb7, 0x71, 0xd2, 0x4e, 0x0a, 0xda, 0xba, 0x74, 0x6d, 0xdc,
+	0x82, 0x22, 0x0e, 0x6b, 0xa9, 0x1c, 0x10, 0x17, 0xa4, 0xd8, 0x46, 0xd4, 0x22, 0x42, 0x65, 0x1b,
+	0xe5, 0xc0, 0x81, 0xd5, 0xd8, 0x3b, 0xf1, 0xae, 0xb0, 0x77, 0x56, 0x3b, 0x93, 0x60, 0xfe, 0x00,
+	0xee, 0xbd, 0xf2, 0x1f, 0xf5, 0xd8, 0x23, 0x02, 0x29, 0x20, 0xe7, 0x1f, 0x41, 0xf3, 0x66, 0x76,
+	0x3d, 0xb6, 0x1b, 0x29, 0x88, 0xdb, 0xcc, 0xfb, 0xf1, 0x99, 0xef, 0xbe, 0x99, 0xf7, 0x6c, 0xf8,
+	0x58, 0xb2, 0x2c, 0x66, 0xc5, 0x3c, 0xcd, 0x64, 0x4f, 0x48, 0x2a, 0x59, 0x4f, 0xfe, 0x9a, 0x33,
+	0x11, 0xe4, 0x05, 0x97, 0x9c, 0x3c, 0x58, 0x79, 0x03, 0xf4, 0xb6, 0x1e, 0x4d, 0xf9, 0x94, 0xa3,
+	0xb3, 0xa7, 0x56, 0x3a, 0xae, 0xf5, 0xc4, 0xa2, 0xd0, 0xf1, 0x24, 0xb5, 0x21, 0x2d, 0xfb, 0x08,
+	0xb4, 0xaf, 0x79, 0x3b, 0x5b, 0xde, 0x2b, 0x3a, 0x4b, 0x63, 0x2a, 0x79, 0x61, 0x22, 0x9e, 0x6e,
+	0x45, 0xe4, 0xb4, 0xa0, 0xf3, 0x12, 0xe0, 0x5b, 0xee, 0x2b, 0x56, 0x88, 0x94, 0x67, 0x6b, 0x07,
+	0xb4, 0xa7, 0x9c, 0x4f, 0x67, 0xac, 0x87, 0xbb, 0xf1, 0xe5, 0x45, 0x4f, 0xa6, 0x73, 0x26, 0x24,
+	0x9d, 0xe7, 0x3a, 0xa0, 0xfb, 0xa7, 0x03, 0xcd, 0x93, 0xfe, 0x60, 0x14, 0x32, 0x91, 0xf3, 0x4c,
+	0x30, 0x41, 0x06, 0xe0, 0xc6, 0x6c, 0x96, 0x5e, 0xb1, 0x22, 0x92, 0x0b, 0xe1, 0x39, 0x9d, 0xdd,
+	0x63, 0xf7, 0x45, 0x37, 0xb0, 0x8a, 0xa1, 0x3e, 0x32, 0x28, 0x13, 0x86, 0x3a, 0xf6, 0x6c, 0x11,
+	0x42, 0x5c, 0x2e, 0x05, 0xf9, 0x1a, 0x1a, 0x2c, 0x8b, 0xa3, 0xf1, 0x8c, 0x4f, 0x7e, 0xf6, 0x3e,
+	0xe8, 0x38, 0xc7, 0xee, 0x8b, 0x4f, 0x6e, 0x45, 0x7c, 0x93, 0xc5, 0x7d, 0x15, 0x18, 0xd6, 0x99,
+	0x59, 0x91, 0x21, 0xb8, 0x63, 0x36, 0x4d, 0x33, 0x43, 0xd8, 0x45, 0xc2, 0xb3, 0x5b, 0x09, 0x7d,
+	0x15, 0xab, 0x19, 0x30, 0xae, 0xd6, 0xdd, 0xdf, 0x1c, 0x38, 0x38, 0x2f, 0x0b, 0x2a, 0x46, 0xd9,
+	0x05, 0x27, 0x03, 0x68, 0x56, 0x25, 0x8e, 0x04, 0x93, 0x9e, 0x83, 0x68, 0xdf, 0x46, 0xeb, 0x02,
+	0x56, 0x89, 0xaf, 0x99, 0x0c, 0xf7, 0xaf, 0xac, 0x1d, 0x09, 0xe0, 0x68, 0x46, 0x85, 0x8c, 0x12,
+	0x96, 0x4e, 0x13, 0x19, 0x4d, 0x12, 0x9a, 0x4d, 0x59, 0x8c, 0xdf, 0xb9, 0x1b, 0x3e, 0x54, 0xae,
+	0x97, 0xe8, 0x19, 0x68, 0x47, 0xf7, 0x77, 0x07, 0x8e, 0x06, 0x4a, 0x67, 0x26, 0x2e, 0xc5, 0x2b,
+	0xbc, 0x3f, 0x14, 0x13, 0xc2, 0x83, 0x49, 0x69, 0x8e, 0xf4, 0xbd, 0x7a, 0xce, 0x76, 0xb1, 0xb4,
+	0x9e, 0x0d, 0x40, 0xff, 0xde, 0xdb, 0xeb, 0xf6, 0x4e, 0x78, 0x38, 0x59, 0x37, 0xff, 0x67, 0x6d,
+	0x09, 0xd4, 0xce, 0xf5, 0xc3, 0x21, 0x27, 0xd0, 0xa8, 0x68, 0x46, 0xc7, 0x53, 0x5b, 0x87, 0x79,
+	0x60, 0x2b, 0x25, 0x46, 0xc3, 0x2a, 0x8b, 0xb4, 0xa0, 0x2e, 0xf8, 0x85, 0xfc, 0x85, 0x16, 0x0c,
+	0x8f, 0x6c, 0x84, 0xd5, 0xbe, 0xfb, 0x57, 0x03, 0xee, 0xbf, 0x56, 0x7d, 0x44, 0xbe, 0x82, 0x9a,
+	0x61, 0x99, 0x63, 0x1e, 0x07, 0x9b, 0xbd, 0x16, 0x18, 0x51, 0xe6, 0x88, 0x32, 0x9e, 0x7c, 0x06,
+	0xf5, 0x49, 0x42, 0xd3, 0x2c, 0x4a, 0xf5, 0x37, 0x35, 0xfa, 0xee, 0xf2, 0xba, 0x5d, 0x1b, 0x28,
+	0xdb, 0x68, 0x18, 0xd6, 0xd0, 0x39, 0x8a, 0xc9, 0xa7, 0x70, 0x90, 0x66, 0xa9, 0x4c, 0xe9, 0xcc,
+	0x54, 0xc2, 0x3b, 0xc0, 0x0a, 0x34, 0x8d, 0x55, 0x17, 0x81, 0x7c, 0x0e, 0x58, 0x12, 0xfd, 0xcc,
+	0xca, 0xc8, 0x5d, 0x8c, 0x3c, 0x54, 0x0e, 0x7c, 0x47, 0x26, 0x36, 0x84, 0xa6, 0x15, 0x9b, 0xc6,
+	0xde, 0xbd, 0x6d, 0xed, 0xfa, 0xaa, 0x30, 0x6b, 0x34, 0xec, 0x1f, 0x29, 0xed, 0xcb, 0xeb, 0xb6,
+	0x7b, 0x5a, 0xa2, 0x46, 0xc3, 0xd0, 0xad, 0xb8, 0xa3, 0xb8, 0x62, 0xe2, 0x37, 0x2b, 0xe6, 0xc5,
+	0x6d, 0x4c, 0xac, 0xdc, 0x26, 0xd3, 0x18, 0x35, 0x53, 0x6f, 0x62, 0x72, 0x0a, 0x87, 0x96, 0x4e,
+	0xd5, 0xf0, 0xde, 0x7d, 0xa4, 0xb6, 0x02, 0x3d, 0x0d, 0x82, 0x72, 0x1a, 0x04, 0x67, 0xe5, 0x34,
+	0xe8, 0xd7, 0x15, 0xf6, 0xcd, 0xdf, 0x6d, 0x27, 0x6c, 0x56, 0xfa, 0x94, 0x97, 0x7c, 0x07, 0xcf,
+	0x90, 0x36, 0xe1, 0x05, 0x8b, 0x74, 0xe9, 0x95, 0x8f, 0xc5, 0xeb, 0x35, 0x8b, 0x3b, 0xce, 0x71,
+	0x33, 0xf4, 0x55, 0xe8, 0x80, 0x17, 0x0c, 0xef, 0xe3, 0x14, 0xe3, 0xec, 0x12, 0x9e, 0xc3, 0xa3,
+	0x8c, 0x2d, 0xb6, 0x60, 0x1e, 0x43, 0x7d, 0xed, 0xf7, 0x3d, 0x7a, 0x8b, 0x85, 0x6f, 0xc1, 0x09,
+	0x1f, 0x2a, 0xc4, 0x9a, 0x83, 0x7c, 0x0b, 0x87, 0xc8, 0xad, 0xba, 0x54, 0x78, 0x7b, 0x77, 0xea,
+	0xeb, 0x03, 0x95, 0x56, 0x59, 0xd4, 0xdc, 0x02, 0x8b, 0x51, 0xbb, 0x13, 0xc3, 0xca, 0x50, 0x42,
+	0xb0, 0x5a, 0x16, 0xa4, 0x7e, 0x37, 0x21, 0x2a, 0xcd, 0x12, 0x32, 0x00, 0xdf, 0x6e, 0xe3, 0x15,
+	0xaf, 0xea, 0xe8, 0x06, 0xbe, 0xd2, 0x27, 0xab, 0x8e, 0x5e, 0x65, 0x9b, 0xde, 0x7e, 0xef, 0x7c,
+	0x81, 0xff, 0x39, 0x5f, 0xbe, 0x87, 0xe7, 0x6b, 0xf3, 0x65, 0x83, 0x5f, 0xc9, 0x73, 0x51, 0x5e,
+	0xc7, 0x1a, 0x38, 0xeb, 0xa0, 0x52, 0x63, 0xd9, 0x81, 0x05, 0x13, 0x97, 0x33, 0x29, 0xa2, 0x84,
+	0x8a, 0xc4, 0xdb, 0xef, 0x38, 0xc7, 0xfb, 0xba, 0x03, 0x43, 0x6d, 0x7f, 0x49, 0x45, 0x42, 0x1e,
+	0x43, 0x9d, 0xe6, 0xb9, 0x0e, 0x69, 0x62, 0x48, 0x8d, 0xe6, 0x39, 0xba, 0xda, 0xe0, 0x26, 0x74,
+	0x56, 0xca, 0xf2, 0xa6, 0x78, 0x3a, 0x28, 0x93, 0x79, 0x7a, 0x3f, 0xc1, 0x87, 0x2a, 0xd7, 0xcc,
+	0x91, 0x28, 0x2f, 0x78, 0xce, 0x05, 0x9d, 0x09, 0x2f, 0xc1, 0x1f, 0xb8, 0xe7, 0xdb, 0x13, 0xe8,
+	0x24, 0xcf, 0xcd, 0x10, 0x7a, 0x65, 0x82, 0x4d, 0x4d, 0x8e, 0xe8, 0x96, 0x47, 0x74, 0xe7, 0x40,
+	0xb6, 0x13, 0x88, 0x0f, 0x6e, 0x5e, 0xf0, 0x48, 0x2e, 0xb4, 0x68, 0x07, 0x45, 0x37, 0xf2, 0x82,
+	0x9f, 0x2d, 0x4a, 0xd9, 0x96, 0x2a, 0x9c, 0x68, 0xf7, 0x42, 0x58, 0xf1, 0xc9, 0x47, 0xb0, 0xb7,
+	0x36, 0x95, 0xcc, 0xae, 0xff, 0xc3, 0xdb, 0xa5, 0xef, 0xbc, 0x5b, 0xfa, 0xce, 0x3f, 0x4b, 0xdf,
+	0x79, 0x73, 0xe3, 0xef, 0xbc, 0xbb, 0xf1, 0x77, 0xfe, 0xb8, 0xf1, 0x77, 0x7e, 0xfc, 0x72, 0x9a,
+	0xca, 0xe4, 0x72, 0x1c, 0x4c, 0xf8, 0xbc, 0x67, 0xff, 0x79, 0x58, 0x2d, 0xf5, 0x3f, 0x98, 0xcd,
+	0xff, 0x3e, 0xe3, 0x3d, 0xb4, 0x7f, 0xf1, 0xef, 0x00, 0xb9, 0x64, 0x2b, 0x13, 0x16, 0x09, 0x00,
+	0x00,
 }
 
 func (m *ABCIResponses) Marshal() (dAtA []byte, err error) {
@@ -701,6 +791,29 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AppVersionProposals) > 0 {
+		for iNdEx := len(m.AppVersionProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AppVersionProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.HaltHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xb8
+	}
 	{
 		size, err := m.LastStateID.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -852,6 +965,46 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AppVersionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppVersionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppVersionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AppVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProTxHash) > 0 {
+		i -= len(m.ProTxHash)
+		copy(dAtA[i:], m.ProTxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -990,6 +1143,34 @@ func (m *State) Size() (n int) {
 	}
 	l = m.LastStateID.Size()
 	n += 2 + l + sovTypes(uint64(l))
+	if m.HaltHeight != 0 {
+		n += 2 + sovTypes(uint64(m.HaltHeight))
+	}
+	if len(m.AppVersionProposals) > 0 {
+		for _, e := range m.AppVersionProposals {
+			l = e.Size()
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppVersionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProTxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovTypes(uint64(m.AppVersion))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
 	return n
 }
 
@@ -2010,6 +2191,181 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 103:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersionProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersionProposals = append(m.AppVersionProposals, AppVersionProposal{})
+			if err := m.AppVersionProposals[len(m.AppVersionProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppVersionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppVersionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppVersionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProTxHash = append(m.ProTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProTxHash == nil {
+				m.ProTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
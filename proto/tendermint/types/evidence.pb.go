@@ -30,6 +30,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
+	//	*Evidence_InvalidChainLockEvidence
+	//	*Evidence_DuplicateProposalEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -75,8 +77,16 @@ type isEvidence_Sum interface {
 type Evidence_DuplicateVoteEvidence struct {
 	DuplicateVoteEvidence *DuplicateVoteEvidence `protobuf:"bytes,1,opt,name=duplicate_vote_evidence,json=duplicateVoteEvidence,proto3,oneof" json:"duplicate_vote_evidence,omitempty"`
 }
+type Evidence_InvalidChainLockEvidence struct {
+	InvalidChainLockEvidence *InvalidChainLockEvidence `protobuf:"bytes,100,opt,name=invalid_chain_lock_evidence,json=invalidChainLockEvidence,proto3,oneof" json:"invalid_chain_lock_evidence,omitempty"`
+}
+type Evidence_DuplicateProposalEvidence struct {
+	DuplicateProposalEvidence *DuplicateProposalEvidence `protobuf:"bytes,101,opt,name=duplicate_proposal_evidence,json=duplicateProposalEvidence,proto3,oneof" json:"duplicate_proposal_evidence,omitempty"`
+}
 
-func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum() {}
+func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()     {}
+func (*Evidence_InvalidChainLockEvidence) isEvidence_Sum()  {}
+func (*Evidence_DuplicateProposalEvidence) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -92,10 +102,26 @@ func (m *Evidence) GetDuplicateVoteEvidence() *DuplicateVoteEvidence {
 	return nil
 }
 
+func (m *Evidence) GetInvalidChainLockEvidence() *InvalidChainLockEvidence {
+	if x, ok := m.GetSum().(*Evidence_InvalidChainLockEvidence); ok {
+		return x.InvalidChainLockEvidence
+	}
+	return nil
+}
+
+func (m *Evidence) GetDuplicateProposalEvidence() *DuplicateProposalEvidence {
+	if x, ok := m.GetSum().(*Evidence_DuplicateProposalEvidence); ok {
+		return x.DuplicateProposalEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
+		(*Evidence_InvalidChainLockEvidence)(nil),
+		(*Evidence_DuplicateProposalEvidence)(nil),
 	}
 }
 
@@ -176,6 +202,196 @@ func (m *DuplicateVoteEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// InvalidChainLockEvidence contains evidence of a proposer signing a proposal,
+// which either regresses the core chain locked height, or is for a block with
+// an invalid chain lock. In the latter case, the last parts of the block prove
+// that the block contains the chain lock.
+type InvalidChainLockEvidence struct {
+	Proposal          *Proposal      `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	ProposerProTxHash []byte         `protobuf:"bytes,2,opt,name=proposer_pro_tx_hash,json=proposerProTxHash,proto3" json:"proposer_pro_tx_hash,omitempty"`
+	Header            *Header        `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	CoreChainLock     *CoreChainLock `protobuf:"bytes,4,opt,name=core_chain_lock,json=coreChainLock,proto3" json:"core_chain_lock,omitempty"`
+	BlockParts        []*Part        `protobuf:"bytes,5,rep,name=block_parts,json=blockParts,proto3" json:"block_parts,omitempty"`
+	TotalVotingPower  int64          `protobuf:"varint,6,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower    int64          `protobuf:"varint,7,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp         time.Time      `protobuf:"bytes,8,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *InvalidChainLockEvidence) Reset()         { *m = InvalidChainLockEvidence{} }
+func (m *InvalidChainLockEvidence) String() string { return proto.CompactTextString(m) }
+func (*InvalidChainLockEvidence) ProtoMessage()    {}
+func (*InvalidChainLockEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6825fabc78e0a168, []int{2}
+}
+func (m *InvalidChainLockEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvalidChainLockEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvalidChainLockEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvalidChainLockEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidChainLockEvidence.Merge(m, src)
+}
+func (m *InvalidChainLockEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvalidChainLockEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidChainLockEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidChainLockEvidence proto.InternalMessageInfo
+
+func (m *InvalidChainLockEvidence) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+func (m *InvalidChainLockEvidence) GetProposerProTxHash() []byte {
+	if m != nil {
+		return m.ProposerProTxHash
+	}
+	return nil
+}
+
+func (m *InvalidChainLockEvidence) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *InvalidChainLockEvidence) GetCoreChainLock() *CoreChainLock {
+	if m != nil {
+		return m.CoreChainLock
+	}
+	return nil
+}
+
+func (m *InvalidChainLockEvidence) GetBlockParts() []*Part {
+	if m != nil {
+		return m.BlockParts
+	}
+	return nil
+}
+
+func (m *InvalidChainLockEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *InvalidChainLockEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *InvalidChainLockEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// DuplicateProposalEvidence contains evidence of a proposer signing two
+// conflicting proposals.
+type DuplicateProposalEvidence struct {
+	ProposalA         *Proposal `protobuf:"bytes,1,opt,name=proposal_a,json=proposalA,proto3" json:"proposal_a,omitempty"`
+	ProposalB         *Proposal `protobuf:"bytes,2,opt,name=proposal_b,json=proposalB,proto3" json:"proposal_b,omitempty"`
+	ProposerProTxHash []byte    `protobuf:"bytes,3,opt,name=proposer_pro_tx_hash,json=proposerProTxHash,proto3" json:"proposer_pro_tx_hash,omitempty"`
+	TotalVotingPower  int64     `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower    int64     `protobuf:"varint,5,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp         time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *DuplicateProposalEvidence) Reset()         { *m = DuplicateProposalEvidence{} }
+func (m *DuplicateProposalEvidence) String() string { return proto.CompactTextString(m) }
+func (*DuplicateProposalEvidence) ProtoMessage()    {}
+func (*DuplicateProposalEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6825fabc78e0a168, []int{3}
+}
+func (m *DuplicateProposalEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateProposalEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateProposalEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateProposalEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateProposalEvidence.Merge(m, src)
+}
+func (m *DuplicateProposalEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateProposalEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateProposalEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateProposalEvidence proto.InternalMessageInfo
+
+func (m *DuplicateProposalEvidence) GetProposalA() *Proposal {
+	if m != nil {
+		return m.ProposalA
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetProposalB() *Proposal {
+	if m != nil {
+		return m.ProposalB
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetProposerProTxHash() []byte {
+	if m != nil {
+		return m.ProposerProTxHash
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
 }
@@ -184,7 +400,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6825fabc78e0a168, []int{2}
+	return fileDescriptor_6825fabc78e0a168, []int{4}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -223,38 +439,55 @@ func (m *EvidenceList) GetEvidence() []Evidence {
 func init() {
 	proto.RegisterType((*Evidence)(nil), "tendermint.types.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "tendermint.types.DuplicateVoteEvidence")
+	proto.RegisterType((*InvalidChainLockEvidence)(nil), "tendermint.types.InvalidChainLockEvidence")
+	proto.RegisterType((*DuplicateProposalEvidence)(nil), "tendermint.types.DuplicateProposalEvidence")
 	proto.RegisterType((*EvidenceList)(nil), "tendermint.types.EvidenceList")
 }
 
 func init() { proto.RegisterFile("tendermint/types/evidence.proto", fileDescriptor_6825fabc78e0a168) }
 
 var fileDescriptor_6825fabc78e0a168 = []byte{
-	// 385 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3d, 0x4f, 0xf2, 0x50,
-	0x14, 0xc7, 0x5b, 0x0a, 0x84, 0xe7, 0x3e, 0x46, 0x49, 0x23, 0xda, 0x10, 0xd3, 0x12, 0x16, 0x18,
-	0xf4, 0x36, 0xd1, 0xc1, 0xc5, 0xc5, 0x46, 0x13, 0x07, 0x06, 0x6d, 0x0c, 0x83, 0x0b, 0x69, 0xe9,
-	0xb5, 0xde, 0xa4, 0xed, 0x6d, 0xda, 0x53, 0x8c, 0xdf, 0x82, 0x8f, 0xc5, 0xc8, 0xe8, 0xa4, 0x06,
-	0xbe, 0x88, 0xe9, 0xed, 0x0b, 0x84, 0x92, 0xb8, 0x34, 0xb7, 0xe7, 0xff, 0x3b, 0xef, 0x07, 0x69,
-	0x40, 0x02, 0x87, 0x44, 0x3e, 0x0d, 0x40, 0x87, 0x8f, 0x90, 0xc4, 0x3a, 0x99, 0x51, 0x87, 0x04,
-	0x53, 0x82, 0xc3, 0x88, 0x01, 0x93, 0xdb, 0x1b, 0x00, 0x73, 0xa0, 0x7b, 0xec, 0x32, 0x97, 0x71,
-	0x51, 0x4f, 0x5f, 0x19, 0xd7, 0xd5, 0x5c, 0xc6, 0x5c, 0x8f, 0xe8, 0xfc, 0xcf, 0x4e, 0x5e, 0x75,
-	0xa0, 0x3e, 0x89, 0xc1, 0xf2, 0xc3, 0x1c, 0x38, 0xab, 0x64, 0xe2, 0xdf, 0x5c, 0xed, 0x55, 0xd4,
-	0x99, 0xe5, 0x51, 0xc7, 0x02, 0x16, 0x65, 0x44, 0x1f, 0x50, 0xeb, 0x3e, 0x2f, 0x4d, 0xb6, 0xd0,
-	0xa9, 0x93, 0x84, 0x1e, 0x9d, 0x5a, 0x40, 0x26, 0x33, 0x06, 0x64, 0x52, 0x54, 0xad, 0x88, 0x3d,
-	0x71, 0xf8, 0xff, 0x72, 0x80, 0x77, 0xcb, 0xc6, 0x77, 0x85, 0xc3, 0x98, 0x01, 0x29, 0x22, 0x3d,
-	0x08, 0x66, 0xc7, 0xd9, 0x27, 0x18, 0x0d, 0x24, 0xc5, 0x89, 0xdf, 0x9f, 0xd7, 0x50, 0x67, 0xaf,
-	0xa7, 0x7c, 0x81, 0x9a, 0x3c, 0xb3, 0x95, 0xa7, 0x3c, 0xa9, 0xa6, 0x4c, 0x79, 0xb3, 0x91, 0x52,
-	0xb7, 0x25, 0x6e, 0x2b, 0xb5, 0xbf, 0x71, 0x43, 0x3e, 0x47, 0x32, 0x30, 0xb0, 0xbc, 0xb4, 0x3b,
-	0x1a, 0xb8, 0x93, 0x90, 0xbd, 0x93, 0x48, 0x91, 0x7a, 0xe2, 0x50, 0x32, 0xdb, 0x5c, 0x19, 0x73,
-	0xe1, 0x31, 0xb5, 0xcb, 0x03, 0x74, 0x54, 0x8e, 0x2b, 0x47, 0xeb, 0x1c, 0x3d, 0x2c, 0xcd, 0x19,
-	0x68, 0xa0, 0x7f, 0xe5, 0x5e, 0x94, 0x06, 0x2f, 0xa4, 0x8b, 0xb3, 0xcd, 0xe1, 0x62, 0x73, 0xf8,
-	0xb9, 0x20, 0x8c, 0xd6, 0xe2, 0x4b, 0x13, 0xe6, 0xdf, 0x9a, 0x68, 0x6e, 0xdc, 0xfa, 0x23, 0x74,
-	0x50, 0x0c, 0x61, 0x44, 0x63, 0x90, 0x6f, 0x50, 0x6b, 0x6b, 0xfa, 0x12, 0x0f, 0x59, 0xe9, 0xad,
-	0x9c, 0x6b, 0x3d, 0x0d, 0x69, 0x96, 0x1e, 0xc6, 0xd3, 0x62, 0xa5, 0x8a, 0xcb, 0x95, 0x2a, 0xfe,
-	0xac, 0x54, 0x71, 0xbe, 0x56, 0x85, 0xe5, 0x5a, 0x15, 0x3e, 0xd7, 0xaa, 0xf0, 0x72, 0xed, 0x52,
-	0x78, 0x4b, 0x6c, 0x3c, 0x65, 0xbe, 0xbe, 0x7d, 0x1d, 0x9b, 0x67, 0x76, 0x84, 0xbb, 0x97, 0x63,
-	0x37, 0xb9, 0xfd, 0xea, 0x37, 0x00, 0x00, 0xff, 0xff, 0x25, 0x83, 0x8b, 0x10, 0xdc, 0x02, 0x00,
-	0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xe3, 0x26, 0xa4, 0x93, 0x42, 0xcb, 0xa8, 0x05, 0x37, 0x45, 0x4e, 0xd4, 0x4d, 0x2b,
+	0x1e, 0x36, 0x2a, 0x12, 0x15, 0x12, 0x9b, 0xba, 0x20, 0x8a, 0x94, 0x45, 0xb0, 0xaa, 0x2e, 0xd8,
+	0x58, 0x13, 0x7b, 0xb0, 0xad, 0xd8, 0x1e, 0xcb, 0x9e, 0x84, 0xf2, 0x17, 0xf9, 0xac, 0x2e, 0x23,
+	0x56, 0x6c, 0x78, 0x28, 0xf9, 0x11, 0xe4, 0xf1, 0x93, 0x3a, 0x26, 0x51, 0x37, 0xd1, 0x64, 0xce,
+	0xb9, 0x3e, 0x77, 0xce, 0xb9, 0xf6, 0x80, 0x2e, 0xc5, 0x9e, 0x81, 0x03, 0xd7, 0xf6, 0xa8, 0x4c,
+	0xbf, 0xf9, 0x38, 0x94, 0xf1, 0xc4, 0x36, 0xb0, 0xa7, 0x63, 0xc9, 0x0f, 0x08, 0x25, 0x70, 0x27,
+	0x27, 0x48, 0x8c, 0xd0, 0xd9, 0x35, 0x89, 0x49, 0x18, 0x28, 0x47, 0xab, 0x98, 0xd7, 0xe9, 0x9a,
+	0x84, 0x98, 0x0e, 0x96, 0xd9, 0xbf, 0xe1, 0xf8, 0x8b, 0x4c, 0x6d, 0x17, 0x87, 0x14, 0xb9, 0x7e,
+	0x42, 0x78, 0x52, 0x52, 0x62, 0xbf, 0x09, 0xda, 0x2b, 0xa1, 0x13, 0xe4, 0xd8, 0x06, 0xa2, 0x24,
+	0x88, 0x19, 0x87, 0xb3, 0x3a, 0x68, 0xbd, 0x4f, 0x7a, 0x83, 0x08, 0x3c, 0x36, 0xc6, 0xbe, 0x63,
+	0xeb, 0x88, 0x62, 0x6d, 0x42, 0x28, 0xd6, 0xd2, 0xb6, 0x05, 0xae, 0xc7, 0x1d, 0xb7, 0x4f, 0x8e,
+	0xa4, 0xdb, 0x7d, 0x4b, 0xef, 0xd2, 0x82, 0x2b, 0x42, 0x71, 0xfa, 0xa4, 0x8b, 0x9a, 0xba, 0x67,
+	0x2c, 0x03, 0xe0, 0x08, 0x1c, 0xd8, 0x1e, 0x6b, 0x42, 0xd3, 0x2d, 0x64, 0x7b, 0x9a, 0x43, 0xf4,
+	0x51, 0x2e, 0x63, 0x30, 0x99, 0xa7, 0x65, 0x99, 0x8f, 0x71, 0xd1, 0x79, 0x54, 0xd3, 0x27, 0xfa,
+	0xa8, 0xa0, 0x24, 0xd8, 0x15, 0x18, 0x74, 0xc1, 0x41, 0x7e, 0x1e, 0x3f, 0x20, 0x3e, 0x09, 0x91,
+	0x93, 0x8b, 0x61, 0x26, 0xf6, 0xec, 0x3f, 0x67, 0x1a, 0x24, 0x35, 0x05, 0xb5, 0x7d, 0xa3, 0x0a,
+	0x54, 0x1a, 0x80, 0x0f, 0xc7, 0xee, 0xe1, 0xb4, 0x0e, 0xf6, 0x96, 0xba, 0x02, 0x5f, 0x80, 0x26,
+	0x73, 0x15, 0x25, 0x76, 0x3e, 0x2a, 0x4b, 0x47, 0x7c, 0xb5, 0x11, 0xb1, 0xce, 0x32, 0xfa, 0x50,
+	0xa8, 0xaf, 0xa6, 0x2b, 0xf0, 0x39, 0x80, 0x94, 0x50, 0xe4, 0x44, 0xc9, 0xd9, 0x9e, 0xa9, 0xf9,
+	0xe4, 0x2b, 0x0e, 0x04, 0xbe, 0xc7, 0x1d, 0xf3, 0xea, 0x0e, 0x43, 0xae, 0x18, 0x30, 0x88, 0xf6,
+	0xe1, 0x11, 0xd8, 0xce, 0x66, 0x21, 0xa1, 0x6e, 0x30, 0xea, 0x83, 0x6c, 0x3b, 0x26, 0x2a, 0x60,
+	0x33, 0x1b, 0x3a, 0xa1, 0xc1, 0x1a, 0xe9, 0x48, 0xf1, 0x58, 0x4a, 0xe9, 0x58, 0x4a, 0x97, 0x29,
+	0x43, 0x69, 0xdd, 0xfc, 0xea, 0xd6, 0xa6, 0xbf, 0xbb, 0x9c, 0x9a, 0x97, 0x1d, 0x7e, 0xe7, 0x81,
+	0x50, 0x95, 0x20, 0x7c, 0x0d, 0x5a, 0x69, 0x36, 0x89, 0x2f, 0x9d, 0xf2, 0x41, 0x53, 0xb3, 0xd5,
+	0x8c, 0x0b, 0x65, 0xb0, 0x1b, 0xaf, 0x71, 0x10, 0x85, 0xab, 0xd1, 0x6b, 0xcd, 0x42, 0xa1, 0xc5,
+	0xcc, 0xda, 0x52, 0x1f, 0xa6, 0xd8, 0x20, 0x20, 0x97, 0xd7, 0x17, 0x28, 0xb4, 0xe0, 0x4b, 0xd0,
+	0xb4, 0x30, 0x32, 0x12, 0x53, 0xda, 0x27, 0x42, 0x59, 0xe6, 0x82, 0xe1, 0x6a, 0xc2, 0x83, 0x1f,
+	0xc0, 0xb6, 0x4e, 0x02, 0x5c, 0x18, 0x55, 0x66, 0x52, 0xfb, 0xa4, 0x5b, 0x2e, 0x3d, 0x27, 0x01,
+	0xce, 0x0e, 0xa7, 0xde, 0xd7, 0x8b, 0x7f, 0xe1, 0x29, 0x68, 0x0f, 0xd9, 0xa4, 0xfb, 0x28, 0xa0,
+	0xa1, 0xd0, 0xe8, 0xf1, 0xcb, 0xf3, 0x1c, 0xa0, 0x80, 0xaa, 0x80, 0x51, 0xa3, 0x65, 0x58, 0x11,
+	0x6a, 0x73, 0xfd, 0x50, 0xef, 0xad, 0x0e, 0xb5, 0x75, 0xb7, 0x50, 0x7f, 0xd6, 0xc1, 0x7e, 0xe5,
+	0x9b, 0x02, 0xdf, 0x00, 0x90, 0xbd, 0x71, 0x68, 0x8d, 0x5c, 0x37, 0x53, 0xf6, 0xd9, 0x3f, 0xa5,
+	0xe9, 0xec, 0xaf, 0x55, 0xaa, 0x54, 0xce, 0x04, 0x5f, 0x35, 0x13, 0xcb, 0xfd, 0xdd, 0x58, 0xdf,
+	0xdf, 0xc6, 0x6a, 0x7f, 0x9b, 0x77, 0xf3, 0xb7, 0x0f, 0xb6, 0x52, 0x37, 0xfb, 0x76, 0x48, 0xe1,
+	0x5b, 0xd0, 0x2a, 0x7c, 0x8e, 0xf9, 0xe5, 0xa6, 0x64, 0x1f, 0xa3, 0x8d, 0xe8, 0x91, 0x6a, 0x56,
+	0xa1, 0x7c, 0xba, 0x99, 0x8b, 0xdc, 0x6c, 0x2e, 0x72, 0x7f, 0xe6, 0x22, 0x37, 0x5d, 0x88, 0xb5,
+	0xd9, 0x42, 0xac, 0xfd, 0x58, 0x88, 0xb5, 0xcf, 0xa7, 0xa6, 0x4d, 0xad, 0xf1, 0x50, 0xd2, 0x89,
+	0x2b, 0x17, 0xef, 0x8b, 0x7c, 0x19, 0x5f, 0x4b, 0xb7, 0xef, 0x92, 0x61, 0x93, 0xed, 0xbf, 0xfa,
+	0x3b, 0x00, 0xd0, 0x3a, 0x1d, 0xcc, 0xee, 0x06, 0x00, 0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
@@ -310,6 +543,52 @@ func (m *Evidence_DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_InvalidChainLockEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_InvalidChainLockEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.InvalidChainLockEvidence != nil {
+		{
+			size, err := m.InvalidChainLockEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Evidence_DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateProposalEvidence != nil {
+		{
+			size, err := m.DuplicateProposalEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -330,12 +609,12 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -375,7 +654,7 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
+func (m *InvalidChainLockEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -385,20 +664,38 @@ func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EvidenceList) MarshalTo(dAtA []byte) (int, error) {
+func (m *InvalidChainLockEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InvalidChainLockEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Evidence) > 0 {
-		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintEvidence(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x42
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.BlockParts) > 0 {
+		for iNdEx := len(m.BlockParts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlockParts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -406,52 +703,228 @@ func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintEvidence(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CoreChainLock != nil {
+		{
+			size, err := m.CoreChainLock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProposerProTxHash) > 0 {
+		i -= len(m.ProposerProTxHash)
+		copy(dAtA[i:], m.ProposerProTxHash)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ProposerProTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvidence(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DuplicateProposalEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+
+func (m *DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Evidence_DuplicateVoteEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DuplicateVoteEvidence != nil {
-		l = m.DuplicateVoteEvidence.Size()
-		n += 1 + l + sovEvidence(uint64(l))
-	}
-	return n
-}
-func (m *DuplicateVoteEvidence) Size() (n int) {
-	if m == nil {
-		return 0
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
 	}
-	var l int
+	i -= n11
+	i = encodeVarintEvidence(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerProTxHash) > 0 {
+		i -= len(m.ProposerProTxHash)
+		copy(dAtA[i:], m.ProposerProTxHash)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ProposerProTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalB != nil {
+		{
+			size, err := m.ProposalB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalA != nil {
+		{
+			size, err := m.ProposalA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Evidence_DuplicateVoteEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateVoteEvidence != nil {
+		l = m.DuplicateVoteEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_InvalidChainLockEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InvalidChainLockEvidence != nil {
+		l = m.InvalidChainLockEvidence.Size()
+		n += 2 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *Evidence_DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateProposalEvidence != nil {
+		l = m.DuplicateProposalEvidence.Size()
+		n += 2 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+func (m *DuplicateVoteEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.VoteA != nil {
 		l = m.VoteA.Size()
@@ -472,6 +945,74 @@ func (m *DuplicateVoteEvidence) Size() (n int) {
 	return n
 }
 
+func (m *InvalidChainLockEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ProposerProTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.CoreChainLock != nil {
+		l = m.CoreChainLock.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if len(m.BlockParts) > 0 {
+		for _, e := range m.BlockParts {
+			l = e.Size()
+			n += 1 + l + sovEvidence(uint64(l))
+		}
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func (m *DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalA != nil {
+		l = m.ProposalA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalB != nil {
+		l = m.ProposalB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ProposerProTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func (m *EvidenceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -557,6 +1098,76 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_DuplicateVoteEvidence{v}
 			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidChainLockEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InvalidChainLockEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_InvalidChainLockEvidence{v}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateProposalEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DuplicateProposalEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_DuplicateProposalEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -771,6 +1382,530 @@ func (m *DuplicateVoteEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *InvalidChainLockEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidChainLockEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidChainLockEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerProTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerProTxHash = append(m.ProposerProTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerProTxHash == nil {
+				m.ProposerProTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreChainLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreChainLock == nil {
+				m.CoreChainLock = &CoreChainLock{}
+			}
+			if err := m.CoreChainLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockParts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockParts = append(m.BlockParts, &Part{})
+			if err := m.BlockParts[len(m.BlockParts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DuplicateProposalEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalA == nil {
+				m.ProposalA = &Proposal{}
+			}
+			if err := m.ProposalA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalB == nil {
+				m.ProposalB = &Proposal{}
+			}
+			if err := m.ProposalB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerProTxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerProTxHash = append(m.ProposerProTxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerProTxHash == nil {
+				m.ProposerProTxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EvidenceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -34,6 +34,7 @@ type ConsensusParams struct {
 	Evidence  EvidenceParams  `protobuf:"bytes,2,opt,name=evidence,proto3" json:"evidence"`
 	Validator ValidatorParams `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator"`
 	Version   VersionParams   `protobuf:"bytes,4,opt,name=version,proto3" json:"version"`
+	Synchrony SynchronyParams `protobuf:"bytes,5,opt,name=synchrony,proto3" json:"synchrony"`
 }
 
 func (m *ConsensusParams) Reset()         { *m = ConsensusParams{} }
@@ -97,6 +98,13 @@ func (m *ConsensusParams) GetVersion() VersionParams {
 	return VersionParams{}
 }
 
+func (m *ConsensusParams) GetSynchrony() SynchronyParams {
+	if m != nil {
+		return m.Synchrony
+	}
+	return SynchronyParams{}
+}
+
 // BlockParams contains limits on the block size.
 type BlockParams struct {
 	// Max block size, in bytes.
@@ -330,6 +338,65 @@ func (m *VersionParams) GetAppVersion() uint64 {
 	return 0
 }
 
+// SynchronyParams configure the bounds under which a proposed block's timestamp
+// is considered valid. These parameters are part of the proposer-based
+// timestamps algorithm.
+type SynchronyParams struct {
+	// Bound for how skewed a proposer's clock may be from any validator on the
+	// network while still producing valid proposals.
+	Precision time.Duration `protobuf:"bytes,1,opt,name=precision,proto3,stdduration" json:"precision"`
+	// Bound for how long a proposal message may take to reach all validators on
+	// a network and still be considered valid.
+	MessageDelay time.Duration `protobuf:"bytes,2,opt,name=message_delay,json=messageDelay,proto3,stdduration" json:"message_delay"`
+}
+
+func (m *SynchronyParams) Reset()         { *m = SynchronyParams{} }
+func (m *SynchronyParams) String() string { return proto.CompactTextString(m) }
+func (*SynchronyParams) ProtoMessage()    {}
+func (*SynchronyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e12598271a686f57, []int{5}
+}
+func (m *SynchronyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SynchronyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SynchronyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SynchronyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynchronyParams.Merge(m, src)
+}
+func (m *SynchronyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SynchronyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynchronyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SynchronyParams proto.InternalMessageInfo
+
+func (m *SynchronyParams) GetPrecision() time.Duration {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *SynchronyParams) GetMessageDelay() time.Duration {
+	if m != nil {
+		return m.MessageDelay
+	}
+	return 0
+}
+
 // HashedParams is a subset of ConsensusParams.
 //
 // It is hashed into the Header.ConsensusHash.
@@ -342,7 +409,7 @@ func (m *HashedParams) Reset()         { *m = HashedParams{} }
 func (m *HashedParams) String() string { return proto.CompactTextString(m) }
 func (*HashedParams) ProtoMessage()    {}
 func (*HashedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e12598271a686f57, []int{5}
+	return fileDescriptor_e12598271a686f57, []int{6}
 }
 func (m *HashedParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,47 +458,52 @@ func init() {
 	proto.RegisterType((*EvidenceParams)(nil), "tendermint.types.EvidenceParams")
 	proto.RegisterType((*ValidatorParams)(nil), "tendermint.types.ValidatorParams")
 	proto.RegisterType((*VersionParams)(nil), "tendermint.types.VersionParams")
+	proto.RegisterType((*SynchronyParams)(nil), "tendermint.types.SynchronyParams")
 	proto.RegisterType((*HashedParams)(nil), "tendermint.types.HashedParams")
 }
 
 func init() { proto.RegisterFile("tendermint/types/params.proto", fileDescriptor_e12598271a686f57) }
 
 var fileDescriptor_e12598271a686f57 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xd5, 0xa5, 0x4d, 0xbe, 0x34, 0x4d, 0x75, 0x42, 0x22, 0x14, 0xd5, 0x0e, 0x1e, 0x50,
-	0x25, 0x24, 0x5b, 0x82, 0x01, 0xd1, 0xa5, 0xc2, 0x50, 0x15, 0x84, 0x82, 0x90, 0x05, 0x0c, 0x5d,
-	0xac, 0x73, 0x72, 0xb8, 0x56, 0x73, 0x3e, 0xcb, 0x77, 0x8e, 0x92, 0x7f, 0xc1, 0xd8, 0xb1, 0x23,
-	0xfc, 0x03, 0x7e, 0x42, 0xc7, 0x8e, 0x4c, 0x80, 0x92, 0x85, 0x9f, 0x81, 0x7c, 0xce, 0xe1, 0x38,
-	0x65, 0xf3, 0x7d, 0xdf, 0x7b, 0xef, 0xfc, 0xde, 0xd3, 0xc1, 0x81, 0xa4, 0xc9, 0x88, 0x66, 0x2c,
-	0x4e, 0xa4, 0x2b, 0x67, 0x29, 0x15, 0x6e, 0x4a, 0x32, 0xc2, 0x84, 0x93, 0x66, 0x5c, 0x72, 0xbc,
-	0x57, 0xad, 0x1d, 0xb5, 0xde, 0xbf, 0x1b, 0xf1, 0x88, 0xab, 0xa5, 0x5b, 0x7c, 0x95, 0xb8, 0x7d,
-	0x33, 0xe2, 0x3c, 0x1a, 0x53, 0x57, 0x9d, 0xc2, 0xfc, 0xb3, 0x3b, 0xca, 0x33, 0x22, 0x63, 0x9e,
-	0x94, 0x7b, 0xfb, 0x72, 0x03, 0xba, 0x2f, 0x79, 0x22, 0x68, 0x22, 0x72, 0xf1, 0x5e, 0xdd, 0x80,
-	0x9f, 0xc3, 0x9d, 0x70, 0xcc, 0x87, 0x17, 0x3d, 0xd4, 0x47, 0x87, 0xed, 0x27, 0x07, 0xce, 0xfa,
-	0x5d, 0x8e, 0x57, 0xac, 0x4b, 0xb4, 0xb7, 0x79, 0xfd, 0xd3, 0x6a, 0xf8, 0x25, 0x03, 0x7b, 0xd0,
-	0xa4, 0x93, 0x78, 0x44, 0x93, 0x21, 0xed, 0x6d, 0x28, 0x76, 0xff, 0x36, 0xfb, 0x64, 0x89, 0xa8,
-	0x09, 0xfc, 0xe3, 0xe1, 0x13, 0x68, 0x4d, 0xc8, 0x38, 0x1e, 0x11, 0xc9, 0xb3, 0x9e, 0xa1, 0x44,
-	0x1e, 0xde, 0x16, 0xf9, 0xa4, 0x21, 0x35, 0x95, 0x8a, 0x89, 0x8f, 0x61, 0x7b, 0x42, 0x33, 0x11,
-	0xf3, 0xa4, 0xb7, 0xa9, 0x44, 0xac, 0xff, 0x88, 0x94, 0x80, 0x9a, 0x84, 0x66, 0xd9, 0x14, 0xda,
-	0x2b, 0x3e, 0xf1, 0x03, 0x68, 0x31, 0x32, 0x0d, 0xc2, 0x99, 0xa4, 0x42, 0x25, 0x63, 0xf8, 0x4d,
-	0x46, 0xa6, 0x5e, 0x71, 0xc6, 0xf7, 0x60, 0xbb, 0x58, 0x46, 0x44, 0x28, 0xdb, 0x86, 0xbf, 0xc5,
-	0xc8, 0xf4, 0x94, 0x08, 0xdc, 0x87, 0x1d, 0x19, 0x33, 0x1a, 0xc4, 0x5c, 0x92, 0x80, 0x09, 0xe5,
-	0xc7, 0xf0, 0xa1, 0x98, 0xbd, 0xe1, 0x92, 0x0c, 0x84, 0xfd, 0x0d, 0xc1, 0x6e, 0x3d, 0x11, 0xfc,
-	0x18, 0x70, 0xa1, 0x46, 0x22, 0x1a, 0x24, 0x39, 0x0b, 0x54, 0xb4, 0xfa, 0xce, 0x2e, 0x23, 0xd3,
-	0x17, 0x11, 0x7d, 0x97, 0x33, 0xf5, 0x73, 0x02, 0x0f, 0x60, 0x4f, 0x83, 0x75, 0xb7, 0xcb, 0xe8,
-	0xef, 0x3b, 0x65, 0xf9, 0x8e, 0x2e, 0xdf, 0x79, 0xb5, 0x04, 0x78, 0xcd, 0xc2, 0xea, 0xe5, 0x2f,
-	0x0b, 0xf9, 0xbb, 0xa5, 0x9e, 0xde, 0xd4, 0x6d, 0x1a, 0x75, 0x9b, 0xf6, 0x31, 0x74, 0xd7, 0x72,
-	0xc7, 0x36, 0x74, 0xd2, 0x3c, 0x0c, 0x2e, 0xe8, 0x2c, 0x50, 0x99, 0xf6, 0x50, 0xdf, 0x38, 0x6c,
-	0xf9, 0xed, 0x34, 0x0f, 0xdf, 0xd2, 0xd9, 0x87, 0x62, 0x74, 0xd4, 0xfc, 0x7e, 0x65, 0xa1, 0x3f,
-	0x57, 0x16, 0xb2, 0x8f, 0xa0, 0x53, 0xcb, 0x1c, 0x5b, 0xd0, 0x26, 0x69, 0x1a, 0xe8, 0xa6, 0x0a,
-	0x8f, 0x9b, 0x3e, 0x90, 0x34, 0x5d, 0xc2, 0x56, 0xb8, 0x67, 0xb0, 0xf3, 0x9a, 0x88, 0x73, 0x3a,
-	0x5a, 0x52, 0x1f, 0x41, 0x57, 0x25, 0x13, 0xac, 0xd7, 0xd2, 0x51, 0xe3, 0x81, 0xee, 0xc6, 0x86,
-	0x4e, 0x85, 0xab, 0x1a, 0x6a, 0x6b, 0xd4, 0x29, 0x11, 0xde, 0xc7, 0xaf, 0x73, 0x13, 0x5d, 0xcf,
-	0x4d, 0x74, 0x33, 0x37, 0xd1, 0xef, 0xb9, 0x89, 0xbe, 0x2c, 0xcc, 0xc6, 0xcd, 0xc2, 0x6c, 0xfc,
-	0x58, 0x98, 0x8d, 0xb3, 0x67, 0x51, 0x2c, 0xcf, 0xf3, 0xd0, 0x19, 0x72, 0xe6, 0xae, 0x3e, 0xcb,
-	0xea, 0xb3, 0x7c, 0x77, 0xeb, 0x4f, 0x36, 0xdc, 0x52, 0xf3, 0xa7, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0xe0, 0x3d, 0x9c, 0xcd, 0x03, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0xd6, 0xfd, 0x49, 0x26, 0x4d, 0x53, 0xad, 0x90, 0x30, 0x45, 0xb5, 0x83, 0x0f, 0xa8,
+	0x12, 0x92, 0x2d, 0xc1, 0x01, 0xd1, 0x4b, 0x55, 0xd3, 0xaa, 0x45, 0xa8, 0x08, 0x99, 0x9f, 0x43,
+	0x2f, 0xd6, 0x3a, 0x59, 0x5c, 0xab, 0x59, 0xaf, 0xe5, 0xb5, 0xab, 0xf8, 0x2d, 0x38, 0x72, 0xec,
+	0xb1, 0xbc, 0x01, 0x8f, 0xd0, 0x63, 0x8f, 0x9c, 0x28, 0x4a, 0x2f, 0x3c, 0x06, 0xf2, 0xda, 0xae,
+	0xe3, 0x14, 0x24, 0xb8, 0xed, 0xce, 0x7c, 0xdf, 0xb7, 0x33, 0xdf, 0x8c, 0x16, 0x36, 0x13, 0x1a,
+	0x8e, 0x68, 0xcc, 0x82, 0x30, 0xb1, 0x92, 0x2c, 0xa2, 0xc2, 0x8a, 0x48, 0x4c, 0x98, 0x30, 0xa3,
+	0x98, 0x27, 0x1c, 0xaf, 0xd7, 0x69, 0x53, 0xa6, 0x37, 0xee, 0xf9, 0xdc, 0xe7, 0x32, 0x69, 0xe5,
+	0xa7, 0x02, 0xb7, 0xa1, 0xf9, 0x9c, 0xfb, 0x63, 0x6a, 0xc9, 0x9b, 0x97, 0x7e, 0xb2, 0x46, 0x69,
+	0x4c, 0x92, 0x80, 0x87, 0x45, 0xde, 0xb8, 0x5e, 0x80, 0xfe, 0x4b, 0x1e, 0x0a, 0x1a, 0x8a, 0x54,
+	0xbc, 0x95, 0x2f, 0xe0, 0x17, 0xb0, 0xe4, 0x8d, 0xf9, 0xf0, 0x54, 0x45, 0x03, 0xb4, 0xd5, 0x7d,
+	0xba, 0x69, 0xce, 0xbf, 0x65, 0xda, 0x79, 0xba, 0x40, 0xdb, 0x8b, 0x97, 0x3f, 0xf4, 0x96, 0x53,
+	0x30, 0xb0, 0x0d, 0x6d, 0x7a, 0x16, 0x8c, 0x68, 0x38, 0xa4, 0xea, 0x82, 0x64, 0x0f, 0xee, 0xb2,
+	0xf7, 0x4b, 0x44, 0x43, 0xe0, 0x96, 0x87, 0xf7, 0xa1, 0x73, 0x46, 0xc6, 0xc1, 0x88, 0x24, 0x3c,
+	0x56, 0x15, 0x29, 0xf2, 0xe8, 0xae, 0xc8, 0xc7, 0x0a, 0xd2, 0x50, 0xa9, 0x99, 0x78, 0x07, 0x56,
+	0xce, 0x68, 0x2c, 0x02, 0x1e, 0xaa, 0x8b, 0x52, 0x44, 0xff, 0x83, 0x48, 0x01, 0x68, 0x48, 0x54,
+	0xac, 0xbc, 0x0e, 0x91, 0x85, 0xc3, 0x93, 0x98, 0x87, 0x99, 0xba, 0xf4, 0xb7, 0x3a, 0xde, 0x55,
+	0x90, 0x66, 0x1d, 0xb7, 0x4c, 0x83, 0x42, 0x77, 0xc6, 0x2e, 0xfc, 0x10, 0x3a, 0x8c, 0x4c, 0x5c,
+	0x2f, 0x4b, 0xa8, 0x90, 0x06, 0x2b, 0x4e, 0x9b, 0x91, 0x89, 0x9d, 0xdf, 0xf1, 0x7d, 0x58, 0xc9,
+	0x93, 0x3e, 0x11, 0xd2, 0x3d, 0xc5, 0x59, 0x66, 0x64, 0x72, 0x40, 0x04, 0x1e, 0xc0, 0x6a, 0x12,
+	0x30, 0xea, 0x06, 0x3c, 0x21, 0x2e, 0x13, 0xd2, 0x16, 0xc5, 0x81, 0x3c, 0xf6, 0x8a, 0x27, 0xe4,
+	0x48, 0x18, 0x5f, 0x11, 0xac, 0x35, 0x8d, 0xc5, 0x4f, 0x00, 0xe7, 0x6a, 0xc4, 0xa7, 0x6e, 0x98,
+	0x32, 0x57, 0x4e, 0xa8, 0x7a, 0xb3, 0xcf, 0xc8, 0x64, 0xd7, 0xa7, 0x6f, 0x52, 0x26, 0x8b, 0x13,
+	0xf8, 0x08, 0xd6, 0x2b, 0x70, 0xb5, 0x22, 0xe5, 0x04, 0x1f, 0x98, 0xc5, 0x0e, 0x99, 0xd5, 0x0e,
+	0x99, 0x7b, 0x25, 0xc0, 0x6e, 0xe7, 0xcd, 0x7e, 0xb9, 0xd6, 0x91, 0xb3, 0x56, 0xe8, 0x55, 0x99,
+	0x66, 0x9b, 0x4a, 0xb3, 0x4d, 0x63, 0x07, 0xfa, 0x73, 0xe3, 0xc3, 0x06, 0xf4, 0xa2, 0xd4, 0x73,
+	0x4f, 0x69, 0xe6, 0x4a, 0x5f, 0x55, 0x34, 0x50, 0xb6, 0x3a, 0x4e, 0x37, 0x4a, 0xbd, 0xd7, 0x34,
+	0x7b, 0x9f, 0x87, 0xb6, 0xdb, 0xdf, 0xce, 0x75, 0xf4, 0xeb, 0x5c, 0x47, 0xc6, 0x36, 0xf4, 0x1a,
+	0xa3, 0xc3, 0x3a, 0x74, 0x49, 0x14, 0xb9, 0xd5, 0xc0, 0xf3, 0x1e, 0x17, 0x1d, 0x20, 0x51, 0x54,
+	0xc2, 0x66, 0xb8, 0x17, 0x08, 0xfa, 0x73, 0x43, 0xc3, 0xbb, 0xd0, 0x89, 0x62, 0x3a, 0x0c, 0x6e,
+	0xc9, 0xff, 0xd8, 0x75, 0xcd, 0xc2, 0x87, 0xd0, 0x63, 0x54, 0x08, 0xe9, 0x1f, 0x1d, 0x93, 0xec,
+	0x7f, 0xcc, 0x5b, 0x2d, 0x99, 0x7b, 0x39, 0x71, 0xa6, 0xd4, 0x63, 0x58, 0x3d, 0x24, 0xe2, 0x84,
+	0x8e, 0xca, 0x32, 0x1f, 0x43, 0x5f, 0x0e, 0xd1, 0x9d, 0xdf, 0xa0, 0x9e, 0x0c, 0x1f, 0x55, 0x6b,
+	0x64, 0x40, 0xaf, 0xc6, 0xd5, 0xcb, 0xd4, 0xad, 0x50, 0x07, 0x44, 0xd8, 0x1f, 0x2e, 0xa6, 0x1a,
+	0xba, 0x9c, 0x6a, 0xe8, 0x6a, 0xaa, 0xa1, 0x9f, 0x53, 0x0d, 0x7d, 0xbe, 0xd1, 0x5a, 0x57, 0x37,
+	0x5a, 0xeb, 0xfb, 0x8d, 0xd6, 0x3a, 0x7e, 0xee, 0x07, 0xc9, 0x49, 0xea, 0x99, 0x43, 0xce, 0xac,
+	0xd9, 0x8f, 0xa8, 0x3e, 0x16, 0x3f, 0xcd, 0xfc, 0x27, 0xe5, 0x2d, 0xcb, 0xf8, 0xb3, 0xdf, 0x03,
+	0x00, 0xa6, 0x86, 0xd4, 0x81, 0xbf, 0x04, 0x00, 0x00,
 }
 
 func (this *ConsensusParams) Equal(that interface{}) bool {
@@ -465,6 +537,9 @@ func (this *ConsensusParams) Equal(that interface{}) bool {
 	if !this.Version.Equal(&that1.Version) {
 		return false
 	}
+	if !this.Synchrony.Equal(&that1.Synchrony) {
+		return false
+	}
 	return true
 }
 func (this *BlockParams) Equal(that interface{}) bool {
@@ -580,6 +655,33 @@ func (this *VersionParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SynchronyParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SynchronyParams)
+	if !ok {
+		that2, ok := that.(SynchronyParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Precision != that1.Precision {
+		return false
+	}
+	if this.MessageDelay != that1.MessageDelay {
+		return false
+	}
+	return true
+}
 func (this *HashedParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -627,6 +729,16 @@ func (m *ConsensusParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Synchrony.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Version.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -733,12 +845,12 @@ func (m *EvidenceParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
-	if err5 != nil {
-		return 0, err5
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n5
-	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.MaxAgeNumBlocks != 0 {
@@ -809,6 +921,45 @@ func (m *VersionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SynchronyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SynchronyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynchronyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MessageDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintParams(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Precision, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintParams(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HashedParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -873,6 +1024,17 @@ func NewPopulatedVersionParams(r randyParams, easy bool) *VersionParams {
 	return this
 }
 
+func NewPopulatedSynchronyParams(r randyParams, easy bool) *SynchronyParams {
+	this := &SynchronyParams{}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Precision = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.MessageDelay = *v3
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyParams interface {
 	Float32() float32
 	Float64() float64
@@ -892,9 +1054,9 @@ func randUTF8RuneParams(r randyParams) rune {
 	return rune(ru + 61)
 }
 func randStringParams(r randyParams) string {
-	v2 := r.Intn(100)
-	tmps := make([]rune, v2)
-	for i := 0; i < v2; i++ {
+	v4 := r.Intn(100)
+	tmps := make([]rune, v4)
+	for i := 0; i < v4; i++ {
 		tmps[i] = randUTF8RuneParams(r)
 	}
 	return string(tmps)
@@ -916,11 +1078,11 @@ func randFieldParams(dAtA []byte, r randyParams, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateParams(dAtA, uint64(key))
-		v3 := r.Int63()
+		v5 := r.Int63()
 		if r.Intn(2) == 0 {
-			v3 *= -1
+			v5 *= -1
 		}
-		dAtA = encodeVarintPopulateParams(dAtA, uint64(v3))
+		dAtA = encodeVarintPopulateParams(dAtA, uint64(v5))
 	case 1:
 		dAtA = encodeVarintPopulateParams(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -959,6 +1121,8 @@ func (m *ConsensusParams) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.Version.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.Synchrony.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -1024,6 +1188,19 @@ func (m *VersionParams) Size() (n int) {
 	return n
 }
 
+func (m *SynchronyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Precision)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MessageDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
 func (m *HashedParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1206,6 +1383,39 @@ func (m *ConsensusParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synchrony", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Synchrony.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1606,6 +1816,122 @@ func (m *VersionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SynchronyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SynchronyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SynchronyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Precision, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MessageDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HashedParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,6 +18,7 @@ type AppConnConsensus interface {
 
 	BeginBlockSync(types.RequestBeginBlock) (*types.ResponseBeginBlock, error)
 	DeliverTxAsync(types.RequestDeliverTx) *abcicli.ReqRes
+	DeliverBatchSync(types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error)
 	EndBlockSync(types.RequestEndBlock) (*types.ResponseEndBlock, error)
 	CommitSync() (*types.ResponseCommit, error)
 }
@@ -85,6 +86,10 @@ func (app *appConnConsensus) DeliverTxAsync(req types.RequestDeliverTx) *abcicli
 	return app.appConn.DeliverTxAsync(req)
 }
 
+func (app *appConnConsensus) DeliverBatchSync(req types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error) {
+	return app.appConn.DeliverBatchSync(req)
+}
+
 func (app *appConnConsensus) EndBlockSync(req types.RequestEndBlock) (*types.ResponseEndBlock, error) {
 	return app.appConn.EndBlockSync(req)
 }
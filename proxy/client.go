@@ -44,21 +44,25 @@ type remoteClientCreator struct {
 	addr        string
 	transport   string
 	mustConnect bool
+	options     []abcicli.SocketClientOption
 }
 
 // NewRemoteClientCreator returns a ClientCreator for the given address (e.g.
 // "192.168.0.1") and transport (e.g. "tcp"). Set mustConnect to true if you
-// want the client to connect before reporting success.
-func NewRemoteClientCreator(addr, transport string, mustConnect bool) ClientCreator {
+// want the client to connect before reporting success. The options are
+// applied to the socket clients.
+func NewRemoteClientCreator(addr, transport string, mustConnect bool,
+	options ...abcicli.SocketClientOption) ClientCreator {
 	return &remoteClientCreator{
 		addr:        addr,
 		transport:   transport,
 		mustConnect: mustConnect,
+		options:     options,
 	}
 }
 
 func (r *remoteClientCreator) NewABCIClient() (abcicli.Client, error) {
-	remoteApp, err := abcicli.NewClient(r.addr, r.transport, r.mustConnect)
+	remoteApp, err := abcicli.NewClient(r.addr, r.transport, r.mustConnect, r.options...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to proxy: %w", err)
 	}
@@ -68,8 +72,9 @@ func (r *remoteClientCreator) NewABCIClient() (abcicli.Client, error) {
 
 // DefaultClientCreator returns a default ClientCreator, which will create a
 // local client if addr is one of: 'counter', 'counter_serial', 'kvstore',
-// 'persistent_kvstore' or 'noop', otherwise - a remote client.
-func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
+// 'persistent_kvstore' or 'noop', otherwise - a remote client, whose socket
+// clients are created with the options.
+func DefaultClientCreator(addr, transport, dbDir string, options ...abcicli.SocketClientOption) ClientCreator {
 	switch addr {
 	case "counter":
 		return NewLocalClientCreator(counter.NewApplication(false))
@@ -83,6 +88,6 @@ func DefaultClientCreator(addr, transport, dbDir string) ClientCreator {
 		return NewLocalClientCreator(types.NewBaseApplication())
 	default:
 		mustConnect := false // loop retrying
-		return NewRemoteClientCreator(addr, transport, mustConnect)
+		return NewRemoteClientCreator(addr, transport, mustConnect, options...)
 	}
 }
@@ -7,6 +7,7 @@ import (
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/libs/service"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
 )
 
 const (
@@ -29,6 +30,13 @@ type AppConns interface {
 	Query() AppConnQuery
 	// Snapshot connection
 	Snapshot() AppConnSnapshot
+
+	// Disconnected returns the names of the connections, which lost the
+	// connection to the application and are reconnecting to it.
+	Disconnected() []string
+	// SetDisconnectedCallback sets the callback called with the names of the
+	// disconnected connections, whenever a connection is lost or resumed.
+	SetDisconnectedCallback(cb func(disconnected []string))
 }
 
 // NewAppConns calls NewMultiAppConn.
@@ -55,6 +63,9 @@ type multiAppConn struct {
 	snapshotConnClient  abcicli.Client
 
 	clientCreator ClientCreator
+
+	mtx            tmsync.Mutex
+	disconnectedCb func(disconnected []string)
 }
 
 // NewMultiAppConn makes all necessary abci connections to the application.
@@ -82,6 +93,41 @@ func (app *multiAppConn) Snapshot() AppConnSnapshot {
 	return app.snapshotConn
 }
 
+func (app *multiAppConn) Disconnected() []string {
+	var disconnected []string
+	for _, c := range []struct {
+		name   string
+		client abcicli.Client
+	}{
+		{connConsensus, app.consensusConnClient},
+		{connMempool, app.mempoolConnClient},
+		{connQuery, app.queryConnClient},
+		{connSnapshot, app.snapshotConnClient},
+	} {
+		if cm, ok := c.client.(abcicli.ConnectionMonitor); ok && !cm.IsConnected() {
+			disconnected = append(disconnected, c.name)
+		}
+	}
+	return disconnected
+}
+
+func (app *multiAppConn) SetDisconnectedCallback(cb func(disconnected []string)) {
+	app.mtx.Lock()
+	app.disconnectedCb = cb
+	app.mtx.Unlock()
+}
+
+// connectionChanged calls the disconnected callback, if set.
+func (app *multiAppConn) connectionChanged() {
+	app.mtx.Lock()
+	cb := app.disconnectedCb
+	app.mtx.Unlock()
+
+	if cb != nil {
+		cb(app.Disconnected())
+	}
+}
+
 func (app *multiAppConn) OnStart() error {
 	c, err := app.abciClientFor(connQuery)
 	if err != nil {
@@ -179,11 +225,22 @@ func (app *multiAppConn) stopAllClients() {
 }
 
 func (app *multiAppConn) abciClientFor(conn string) (abcicli.Client, error) {
-	c, err := app.clientCreator.NewABCIClient()
+	var (
+		c   abcicli.Client
+		err error
+	)
+	if cc, ok := app.clientCreator.(connClientCreator); ok {
+		c, err = cc.newABCIClientFor(conn)
+	} else {
+		c, err = app.clientCreator.NewABCIClient()
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating ABCI client (%s connection): %w", conn, err)
 	}
 	c.SetLogger(app.Logger.With("module", "abci-client", "connection", conn))
+	if cm, ok := c.(abcicli.ConnectionMonitor); ok {
+		cm.SetConnectionCallback(func(bool) { app.connectionChanged() })
+	}
 	if err := c.Start(); err != nil {
 		return nil, fmt.Errorf("error starting ABCI client (%s connection): %w", conn, err)
 	}
@@ -0,0 +1,424 @@
+package proxy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/libs/protoio"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+)
+
+const (
+	// RecordingExt is the extension of the files the requests and responses
+	// of the connections to the application are recorded to.
+	RecordingExt = ".abcirec"
+
+	// recordQueueSize is the number of requests queued for recording, before
+	// the requests to the application block.
+	recordQueueSize = 1000
+
+	// maxRecordSize is the maximum size of a recorded request or response, the
+	// maximum size of the ABCI messages.
+	maxRecordSize = 209715200 // 200MB
+)
+
+// connClientCreator is implemented by the client creators, which create the
+// clients depending on the connection to the application they are used for.
+type connClientCreator interface {
+	newABCIClientFor(conn string) (abcicli.Client, error)
+}
+
+// recordingClientCreator wraps the clients of another creator into clients
+// recording the traffic with the application.
+type recordingClientCreator struct {
+	creator ClientCreator
+	dir     string
+
+	mtx     tmsync.Mutex
+	clients int
+}
+
+var _ connClientCreator = (*recordingClientCreator)(nil)
+
+// NewRecordingClientCreator returns a ClientCreator wrapping the clients of
+// the creator, so that they record every request to the application along
+// with its response, except flushes. The requests of each connection
+// (consensus, mempool, query and snapshot) are appended to the file named
+// after it in the directory, as length-delimited pairs of abci.Request and
+// abci.Response, which NewRecordingReader reads.
+//
+// The asynchronous requests are recorded in the order they are sent, and the
+// synchronous ones once they are responded.
+func NewRecordingClientCreator(creator ClientCreator, dir string) ClientCreator {
+	return &recordingClientCreator{
+		creator: creator,
+		dir:     dir,
+	}
+}
+
+// NewABCIClient implements ClientCreator. The clients created for no
+// particular connection are recorded to the files named client1, client2...
+func (r *recordingClientCreator) NewABCIClient() (abcicli.Client, error) {
+	r.mtx.Lock()
+	r.clients++
+	conn := fmt.Sprintf("client%d", r.clients)
+	r.mtx.Unlock()
+
+	return r.newABCIClientFor(conn)
+}
+
+func (r *recordingClientCreator) newABCIClientFor(conn string) (abcicli.Client, error) {
+	if err := tmos.EnsureDir(r.dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create the recording directory: %w", err)
+	}
+	file, err := os.OpenFile(filepath.Join(r.dir, conn+RecordingExt), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the recording: %w", err)
+	}
+
+	client, err := r.creator.NewABCIClient()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return newRecordingClient(client, file), nil
+}
+
+// recordingClient is an abcicli.Client recording the requests and responses
+// of another client.
+type recordingClient struct {
+	abcicli.Client
+
+	logger  log.Logger
+	writer  protoio.WriteCloser
+	records chan *abcicli.ReqRes
+	done    chan struct{}
+
+	mtx     tmsync.Mutex
+	stopped bool
+}
+
+var _ abcicli.Client = (*recordingClient)(nil)
+var _ abcicli.ConnectionMonitor = (*recordingClient)(nil)
+
+func newRecordingClient(client abcicli.Client, w io.WriteCloser) *recordingClient {
+	c := &recordingClient{
+		Client:  client,
+		logger:  log.NewNopLogger(),
+		writer:  protoio.NewDelimitedWriter(w),
+		records: make(chan *abcicli.ReqRes, recordQueueSize),
+		done:    make(chan struct{}),
+	}
+	go c.recordRoutine()
+	return c
+}
+
+// IsConnected implements abcicli.ConnectionMonitor.
+func (c *recordingClient) IsConnected() bool {
+	if cm, ok := c.Client.(abcicli.ConnectionMonitor); ok {
+		return cm.IsConnected()
+	}
+	return true
+}
+
+// SetConnectionCallback implements abcicli.ConnectionMonitor.
+func (c *recordingClient) SetConnectionCallback(cb func(connected bool)) {
+	if cm, ok := c.Client.(abcicli.ConnectionMonitor); ok {
+		cm.SetConnectionCallback(cb)
+	}
+}
+
+// SetLogger implements abcicli.Client.
+func (c *recordingClient) SetLogger(logger log.Logger) {
+	c.logger = logger
+	c.Client.SetLogger(logger)
+}
+
+// Stop implements abcicli.Client by stopping the client and closing the
+// recording, once the requests sent are recorded.
+//
+// The recording stops before the client, so that the requests sent while the
+// client stops, which may never be responded nor resolved by the client, are
+// not waited for.
+func (c *recordingClient) Stop() error {
+	c.mtx.Lock()
+	if !c.stopped {
+		c.stopped = true
+		close(c.records)
+	}
+	c.mtx.Unlock()
+
+	err := c.Client.Stop()
+
+	<-c.done
+	return err
+}
+
+// recordAsync sends an asynchronous request and queues it for recording.
+func (c *recordingClient) recordAsync(send func() *abcicli.ReqRes) *abcicli.ReqRes {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	reqres := send()
+	if !c.stopped {
+		c.records <- reqres
+	}
+	return reqres
+}
+
+// recordSync queues the responded request for recording.
+func (c *recordingClient) recordSync(req *types.Request, res *types.Response) {
+	reqres := abcicli.NewReqRes(req)
+	reqres.Response = res
+	reqres.Done()
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.stopped {
+		c.records <- reqres
+	}
+}
+
+func (c *recordingClient) recordRoutine() {
+	defer close(c.done)
+	defer c.writer.Close()
+
+	for reqres := range c.records {
+		reqres.Wait()
+		if reqres.Response == nil {
+			// the client stopped before the response
+			continue
+		}
+		if _, err := c.writer.WriteMsg(reqres.Request); err != nil {
+			c.logger.Error("Failed to record request", "err", err)
+			continue
+		}
+		if _, err := c.writer.WriteMsg(reqres.Response); err != nil {
+			c.logger.Error("Failed to record response", "err", err)
+		}
+	}
+}
+
+//----------------------------------------
+
+func (c *recordingClient) EchoAsync(msg string) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.EchoAsync(msg) })
+}
+
+func (c *recordingClient) InfoAsync(req types.RequestInfo) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.InfoAsync(req) })
+}
+
+func (c *recordingClient) SetOptionAsync(req types.RequestSetOption) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.SetOptionAsync(req) })
+}
+
+func (c *recordingClient) DeliverTxAsync(req types.RequestDeliverTx) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.DeliverTxAsync(req) })
+}
+
+func (c *recordingClient) DeliverBatchAsync(req types.RequestDeliverBatch) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.DeliverBatchAsync(req) })
+}
+
+func (c *recordingClient) CheckTxAsync(req types.RequestCheckTx) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.CheckTxAsync(req) })
+}
+
+func (c *recordingClient) QueryAsync(req types.RequestQuery) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.QueryAsync(req) })
+}
+
+func (c *recordingClient) CommitAsync() *abcicli.ReqRes {
+	return c.recordAsync(c.Client.CommitAsync)
+}
+
+func (c *recordingClient) InitChainAsync(req types.RequestInitChain) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.InitChainAsync(req) })
+}
+
+func (c *recordingClient) BeginBlockAsync(req types.RequestBeginBlock) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.BeginBlockAsync(req) })
+}
+
+func (c *recordingClient) EndBlockAsync(req types.RequestEndBlock) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.EndBlockAsync(req) })
+}
+
+func (c *recordingClient) ListSnapshotsAsync(req types.RequestListSnapshots) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.ListSnapshotsAsync(req) })
+}
+
+func (c *recordingClient) OfferSnapshotAsync(req types.RequestOfferSnapshot) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.OfferSnapshotAsync(req) })
+}
+
+func (c *recordingClient) LoadSnapshotChunkAsync(req types.RequestLoadSnapshotChunk) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.LoadSnapshotChunkAsync(req) })
+}
+
+func (c *recordingClient) ApplySnapshotChunkAsync(req types.RequestApplySnapshotChunk) *abcicli.ReqRes {
+	return c.recordAsync(func() *abcicli.ReqRes { return c.Client.ApplySnapshotChunkAsync(req) })
+}
+
+//----------------------------------------
+
+func (c *recordingClient) EchoSync(msg string) (*types.ResponseEcho, error) {
+	res, err := c.Client.EchoSync(msg)
+	if err == nil {
+		c.recordSync(types.ToRequestEcho(msg), types.ToResponseEcho(res.Message))
+	}
+	return res, err
+}
+
+func (c *recordingClient) InfoSync(req types.RequestInfo) (*types.ResponseInfo, error) {
+	res, err := c.Client.InfoSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestInfo(req), types.ToResponseInfo(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) SetOptionSync(req types.RequestSetOption) (*types.ResponseSetOption, error) {
+	res, err := c.Client.SetOptionSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestSetOption(req), types.ToResponseSetOption(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) DeliverTxSync(req types.RequestDeliverTx) (*types.ResponseDeliverTx, error) {
+	res, err := c.Client.DeliverTxSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestDeliverTx(req), types.ToResponseDeliverTx(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) DeliverBatchSync(req types.RequestDeliverBatch) (*types.ResponseDeliverBatch, error) {
+	res, err := c.Client.DeliverBatchSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestDeliverBatch(req), types.ToResponseDeliverBatch(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) CheckTxSync(req types.RequestCheckTx) (*types.ResponseCheckTx, error) {
+	res, err := c.Client.CheckTxSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestCheckTx(req), types.ToResponseCheckTx(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) QuerySync(req types.RequestQuery) (*types.ResponseQuery, error) {
+	res, err := c.Client.QuerySync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestQuery(req), types.ToResponseQuery(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) CommitSync() (*types.ResponseCommit, error) {
+	res, err := c.Client.CommitSync()
+	if err == nil {
+		c.recordSync(types.ToRequestCommit(), types.ToResponseCommit(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) InitChainSync(req types.RequestInitChain) (*types.ResponseInitChain, error) {
+	res, err := c.Client.InitChainSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestInitChain(req), types.ToResponseInitChain(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) BeginBlockSync(req types.RequestBeginBlock) (*types.ResponseBeginBlock, error) {
+	res, err := c.Client.BeginBlockSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestBeginBlock(req), types.ToResponseBeginBlock(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) EndBlockSync(req types.RequestEndBlock) (*types.ResponseEndBlock, error) {
+	res, err := c.Client.EndBlockSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestEndBlock(req), types.ToResponseEndBlock(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) ListSnapshotsSync(req types.RequestListSnapshots) (*types.ResponseListSnapshots, error) {
+	res, err := c.Client.ListSnapshotsSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestListSnapshots(req), types.ToResponseListSnapshots(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) OfferSnapshotSync(req types.RequestOfferSnapshot) (*types.ResponseOfferSnapshot, error) {
+	res, err := c.Client.OfferSnapshotSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestOfferSnapshot(req), types.ToResponseOfferSnapshot(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) LoadSnapshotChunkSync(
+	req types.RequestLoadSnapshotChunk) (*types.ResponseLoadSnapshotChunk, error) {
+	res, err := c.Client.LoadSnapshotChunkSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestLoadSnapshotChunk(req), types.ToResponseLoadSnapshotChunk(*res))
+	}
+	return res, err
+}
+
+func (c *recordingClient) ApplySnapshotChunkSync(
+	req types.RequestApplySnapshotChunk) (*types.ResponseApplySnapshotChunk, error) {
+	res, err := c.Client.ApplySnapshotChunkSync(req)
+	if err == nil {
+		c.recordSync(types.ToRequestApplySnapshotChunk(req), types.ToResponseApplySnapshotChunk(*res))
+	}
+	return res, err
+}
+
+//----------------------------------------
+
+// RecordingReader reads the requests and responses recorded by the clients
+// of NewRecordingClientCreator.
+type RecordingReader struct {
+	r protoio.ReadCloser
+}
+
+// NewRecordingReader returns a new RecordingReader reading from r.
+func NewRecordingReader(r io.Reader) *RecordingReader {
+	return &RecordingReader{r: protoio.NewDelimitedReader(bufio.NewReader(r), maxRecordSize)}
+}
+
+// Read returns the next recorded request and its response. It returns io.EOF
+// at the end of the recording.
+func (r *RecordingReader) Read() (*types.Request, *types.Response, error) {
+	req := &types.Request{}
+	if _, err := r.r.ReadMsg(req); err != nil {
+		return nil, nil, err
+	}
+	res := &types.Response{}
+	if _, err := r.r.ReadMsg(res); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, nil, err
+	}
+	return req, res, nil
+}
@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	"github.com/tendermint/tendermint/abci/example/counter"
+	"github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestRecordingClientCreator(t *testing.T) {
+	dir := t.TempDir()
+	creator := NewRecordingClientCreator(NewLocalClientCreator(counter.NewApplication(false)), dir)
+
+	appConns := NewAppConns(creator)
+	appConns.SetLogger(log.TestingLogger())
+	require.NoError(t, appConns.Start())
+
+	_, err := appConns.Query().InfoSync(types.RequestInfo{})
+	require.NoError(t, err)
+
+	consensus := appConns.Consensus()
+	consensus.SetResponseCallback(func(*types.Request, *types.Response) {})
+	_, err = consensus.BeginBlockSync(types.RequestBeginBlock{})
+	require.NoError(t, err)
+	consensus.DeliverTxAsync(types.RequestDeliverTx{Tx: []byte{0x00}})
+	consensus.DeliverTxAsync(types.RequestDeliverTx{Tx: []byte{0x01}})
+	_, err = consensus.EndBlockSync(types.RequestEndBlock{})
+	require.NoError(t, err)
+	_, err = consensus.CommitSync()
+	require.NoError(t, err)
+
+	require.NoError(t, appConns.Stop())
+
+	for _, conn := range []string{connConsensus, connMempool, connQuery, connSnapshot} {
+		assert.FileExists(t, filepath.Join(dir, conn+RecordingExt))
+	}
+
+	// the requests are recorded in order along with their responses
+	reqs, ress := readRecording(t, filepath.Join(dir, connConsensus+RecordingExt))
+	require.Len(t, reqs, 5)
+	assert.NotNil(t, reqs[0].GetBeginBlock())
+	assert.Equal(t, []byte{0x00}, reqs[1].GetDeliverTx().Tx)
+	assert.Equal(t, []byte{0x01}, reqs[2].GetDeliverTx().Tx)
+	assert.NotNil(t, reqs[3].GetEndBlock())
+	assert.NotNil(t, reqs[4].GetCommit())
+	assert.NotNil(t, ress[2].GetDeliverTx())
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 2}, ress[4].GetCommit().Data) // the number of txs
+
+	reqs, ress = readRecording(t, filepath.Join(dir, connQuery+RecordingExt))
+	require.Len(t, reqs, 1)
+	assert.NotNil(t, reqs[0].GetInfo())
+	assert.NotNil(t, ress[0].GetInfo())
+}
+
+// stoppingClient resolves the requests sent before it stops, but not the ones
+// sent while it stops, like a socket client.
+type stoppingClient struct {
+	abcicli.Client
+
+	sent   []*abcicli.ReqRes
+	onStop func()
+}
+
+func (c *stoppingClient) EchoAsync(msg string) *abcicli.ReqRes {
+	reqres := abcicli.NewReqRes(types.ToRequestEcho(msg))
+	c.sent = append(c.sent, reqres)
+	return reqres
+}
+
+func (c *stoppingClient) Stop() error {
+	for _, reqres := range c.sent {
+		reqres.Done()
+	}
+	c.onStop()
+	return nil
+}
+
+func TestRecordingClientStop(t *testing.T) {
+	inner := &stoppingClient{}
+	c := newRecordingClient(inner, nopWriteCloser{ioutil.Discard})
+	inner.onStop = func() { c.EchoAsync("sent while stopping") }
+
+	c.EchoAsync("sent before stopping")
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- c.Stop() }()
+	select {
+	case err := <-stopped:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "Stop hangs")
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func readRecording(t *testing.T, path string) ([]*types.Request, []*types.Response) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var (
+		reader = NewRecordingReader(file)
+		reqs   []*types.Request
+		ress   []*types.Response
+	)
+	for {
+		req, res, err := reader.Read()
+		if err == io.EOF {
+			return reqs, ress
+		}
+		require.NoError(t, err)
+		reqs = append(reqs, req)
+		ress = append(ress, res)
+	}
+}
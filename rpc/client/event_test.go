@@ -52,6 +52,34 @@ func TestHeaderEvents(t *testing.T) {
 	}
 }
 
+// follow new block headers in the event log and make sure no height is missed
+func TestEventLog(t *testing.T) {
+	for _, c := range GetClients() {
+		c := c
+		t.Run(reflect.TypeOf(c).String(), func(t *testing.T) {
+			const filter = "tm.event = 'NewBlockHeader'"
+			ctx := context.Background()
+
+			res, err := c.Events(ctx, filter, 1, "", waitForEventTimeout)
+			require.NoError(t, err)
+			require.Len(t, res.Items, 1)
+
+			cursor := res.Items[0].Cursor
+			height := res.Items[0].Data.(types.EventDataNewBlockHeader).Header.Height
+			for i := 0; i < 3; i++ {
+				res, err := c.Events(ctx, filter, 1, cursor, waitForEventTimeout)
+				require.NoError(t, err)
+				require.Len(t, res.Items, 1)
+
+				header, ok := res.Items[0].Data.(types.EventDataNewBlockHeader)
+				require.True(t, ok, "%#v", res.Items[0].Data)
+				require.Equal(t, height+1, header.Header.Height)
+				cursor, height = res.Items[0].Cursor, header.Header.Height
+			}
+		})
+	}
+}
+
 // subscribe to new blocks and make sure height increments by 1
 func TestBlockEvents(t *testing.T) {
 	for _, c := range GetClients() {
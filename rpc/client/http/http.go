@@ -361,6 +361,27 @@ func (c *baseRPCClient) ConsensusParams(
 	return result, nil
 }
 
+func (c *baseRPCClient) Events(
+	ctx context.Context,
+	filter string,
+	maxItems int,
+	after string,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+	result := new(ctypes.ResultEvents)
+	params := map[string]interface{}{
+		"filter":   filter,
+		"maxItems": maxItems,
+		"after":    after,
+		"waitTime": waitTime,
+	}
+	_, err := c.caller.Call(ctx, "events", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call(ctx, "health", map[string]interface{}{}, result)
@@ -560,6 +581,54 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(
+	ctx context.Context,
+	page, perPage *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	params := make(map[string]interface{})
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "pending_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) EvidenceSearch(
+	ctx context.Context,
+	minInfractionHeight, maxInfractionHeight int64,
+	evType string,
+	proTxHash bytes.HexBytes,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	result := new(ctypes.ResultEvidenceSearch)
+	params := map[string]interface{}{
+		"min_height":  minInfractionHeight,
+		"max_height":  maxInfractionHeight,
+		"type":        evType,
+		"pro_tx_hash": proTxHash,
+	}
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "evidence_search", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 //-----------------------------------------------------------------------------
 // WSEvents
 
@@ -22,6 +22,7 @@ implementation.
 
 import (
 	"context"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/service"
@@ -129,6 +130,16 @@ type EventsClient interface {
 	Unsubscribe(ctx context.Context, subscriber, query string) error
 	// UnsubscribeAll unsubscribes given subscriber from all the queries.
 	UnsubscribeAll(ctx context.Context, subscriber string) error
+
+	// Events returns up to maxItems events from the event log of the node,
+	// which match the filter query (all events if empty). If after is a
+	// cursor of an event, the events following it are returned; otherwise,
+	// the newest events are. If there are no such events, it waits for a
+	// matching event up to waitTime. Unlike Subscribe, Events doesn't require
+	// a WebSocket connection, and a client which follows the cursors doesn't
+	// miss events, as long as it reads them before they leave the log.
+	Events(ctx context.Context, filter string, maxItems int, after string,
+		waitTime time.Duration) (*ctypes.ResultEvents, error)
 }
 
 // MempoolClient shows us data about current mempool state.
@@ -139,9 +150,12 @@ type MempoolClient interface {
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behaviour.
+// behaviour, and for listing pending and committed evidence.
 type EvidenceClient interface {
 	BroadcastEvidence(context.Context, types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(ctx context.Context, page, perPage *int) (*ctypes.ResultPendingEvidence, error)
+	EvidenceSearch(ctx context.Context, minInfractionHeight, maxInfractionHeight int64, evType string,
+		proTxHash bytes.HexBytes, page, perPage *int) (*ctypes.ResultEvidenceSearch, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
@@ -203,6 +203,30 @@ func (c *Local) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctyp
 	return core.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(ctx context.Context, page, perPage *int) (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence(c.ctx, page, perPage)
+}
+
+func (c *Local) EvidenceSearch(
+	ctx context.Context,
+	minInfractionHeight, maxInfractionHeight int64,
+	evType string,
+	proTxHash bytes.HexBytes,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	return core.EvidenceSearch(c.ctx, minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage)
+}
+
+func (c *Local) Events(
+	_ context.Context,
+	filter string,
+	maxItems int,
+	after string,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+	return core.Events(c.ctx, filter, maxItems, after, waitTime)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
@@ -178,3 +178,13 @@ func (c Client) Validators(ctx context.Context, height *int64, page, perPage *in
 func (c Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return core.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(ctx context.Context, page, perPage *int) (*ctypes.ResultPendingEvidence, error) {
+	return core.PendingEvidence(&rpctypes.Context{}, page, perPage)
+}
+
+func (c Client) EvidenceSearch(ctx context.Context, minInfractionHeight, maxInfractionHeight int64, evType string,
+	proTxHash bytes.HexBytes, page, perPage *int) (*ctypes.ResultEvidenceSearch, error) {
+	return core.EvidenceSearch(&rpctypes.Context{}, minInfractionHeight, maxInfractionHeight, evType, proTxHash,
+		page, perPage)
+}
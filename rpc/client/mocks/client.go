@@ -14,6 +14,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	types "github.com/tendermint/tendermint/types"
 )
 
@@ -413,6 +415,52 @@ func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDump
 	return r0, r1
 }
 
+// EvidenceSearch provides a mock function with given fields: ctx, minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage
+func (_m *Client) EvidenceSearch(ctx context.Context, minInfractionHeight int64, maxInfractionHeight int64, evType string, proTxHash bytes.HexBytes, page *int, perPage *int) (*coretypes.ResultEvidenceSearch, error) {
+	ret := _m.Called(ctx, minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage)
+
+	var r0 *coretypes.ResultEvidenceSearch
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, bytes.HexBytes, *int, *int) *coretypes.ResultEvidenceSearch); ok {
+		r0 = rf(ctx, minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidenceSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string, bytes.HexBytes, *int, *int) error); ok {
+		r1 = rf(ctx, minInfractionHeight, maxInfractionHeight, evType, proTxHash, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Events provides a mock function with given fields: ctx, filter, maxItems, after, waitTime
+func (_m *Client) Events(ctx context.Context, filter string, maxItems int, after string, waitTime time.Duration) (*coretypes.ResultEvents, error) {
+	ret := _m.Called(ctx, filter, maxItems, after, waitTime)
+
+	var r0 *coretypes.ResultEvents
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string, time.Duration) *coretypes.ResultEvents); ok {
+		r0 = rf(ctx, filter, maxItems, after, waitTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvents)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string, time.Duration) error); ok {
+		r1 = rf(ctx, filter, maxItems, after, waitTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Genesis provides a mock function with given fields: _a0
 func (_m *Client) Genesis(_a0 context.Context) (*coretypes.ResultGenesis, error) {
 	ret := _m.Called(_a0)
@@ -552,6 +600,29 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// PendingEvidence provides a mock function with given fields: ctx, page, perPage
+func (_m *Client) PendingEvidence(ctx context.Context, page *int, perPage *int) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(ctx, page, perPage)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, *int, *int) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(ctx, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int, *int) error); ok {
+		r1 = rf(ctx, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
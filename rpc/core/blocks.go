@@ -92,6 +92,54 @@ func Block(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlock, error)
 	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
 }
 
+// maxChainLockSearchBlocks is the number of blocks ChainLock looks through for
+// the block, which included the chain lock.
+const maxChainLockSearchBlocks = 1000
+
+// ChainLock gets the core chain locked height of the block at the given
+// height, and the chain lock of that core height. The chain lock is included
+// in the first block locking the core height, which is looked for among the
+// preceding blocks; it's nil if it's not found within
+// maxChainLockSearchBlocks blocks, has been pruned or was set in genesis.
+// It's only served by the gRPC API.
+func ChainLock(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultChainLock, error) {
+	height, err := getHeight(env.BlockStore.Height(), heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := env.BlockStore.LoadBlockMeta(height)
+	if blockMeta == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	res := &ctypes.ResultChainLock{
+		Height:                height,
+		CoreChainLockedHeight: blockMeta.Header.CoreChainLockedHeight,
+	}
+
+	// look for the first block locking the core height
+	first := height
+	for ; first > env.BlockStore.Base(); first-- {
+		if height-first >= maxChainLockSearchBlocks {
+			return res, nil
+		}
+		if err := ctx.Context().Err(); err != nil {
+			return nil, err
+		}
+		meta := env.BlockStore.LoadBlockMeta(first - 1)
+		if meta == nil || meta.Header.CoreChainLockedHeight != res.CoreChainLockedHeight {
+			break
+		}
+	}
+
+	block := env.BlockStore.LoadBlock(first)
+	if block != nil && block.CoreChainLock != nil && block.CoreChainLock.CoreBlockHeight == res.CoreChainLockedHeight {
+		res.ChainLock = block.CoreChainLock
+		res.ChainLockHeight = first
+	}
+	return res, nil
+}
+
 // BlockByHash gets block by hash.
 // More: https://docs.tendermint.com/master/rpc/#/Info/block_by_hash
 func BlockByHash(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultBlock, error) {
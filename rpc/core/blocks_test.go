@@ -115,6 +115,93 @@ func TestBlockResults(t *testing.T) {
 	}
 }
 
+func TestChainLock(t *testing.T) {
+	// the core height is locked by the chain lock of genesis, then by the
+	// chain locks included at heights 3 and 6
+	store := chainLockBlockStore{
+		mockBlockStore: mockBlockStore{height: 8},
+		coreHeights:    map[int64]uint32{1: 10, 2: 10, 3: 20, 4: 20, 5: 20, 6: 30, 7: 30, 8: 30},
+		chainLocks: map[int64]*types.CoreChainLock{
+			3: {CoreBlockHeight: 20, CoreBlockHash: make([]byte, 32), Signature: make([]byte, 96)},
+			6: {CoreBlockHeight: 30, CoreBlockHash: make([]byte, 32), Signature: make([]byte, 96)},
+		},
+	}
+	env = &Environment{BlockStore: store}
+
+	testCases := []struct {
+		height          int64
+		coreHeight      uint32
+		chainLockHeight int64
+	}{
+		{1, 10, 0},
+		{2, 10, 0},
+		{3, 20, 3},
+		{5, 20, 3},
+		{8, 30, 6},
+	}
+	for _, tc := range testCases {
+		res, err := ChainLock(&rpctypes.Context{}, &tc.height)
+		require.NoError(t, err, "height %d", tc.height)
+		assert.Equal(t, tc.height, res.Height)
+		assert.Equal(t, tc.coreHeight, res.CoreChainLockedHeight, "height %d", tc.height)
+		assert.Equal(t, tc.chainLockHeight, res.ChainLockHeight, "height %d", tc.height)
+		if tc.chainLockHeight == 0 {
+			assert.Nil(t, res.ChainLock, "height %d", tc.height)
+		} else {
+			assert.Equal(t, store.chainLocks[tc.chainLockHeight], res.ChainLock, "height %d", tc.height)
+		}
+	}
+
+	// the latest height by default
+	res, err := ChainLock(&rpctypes.Context{}, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, res.Height)
+	assert.EqualValues(t, 6, res.ChainLockHeight)
+
+	// the block including the chain lock was pruned
+	store.base = 4
+	env.BlockStore = store
+	res, err = ChainLock(&rpctypes.Context{}, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, res.ChainLockHeight)
+	height := int64(5)
+	res, err = ChainLock(&rpctypes.Context{}, &height)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, res.CoreChainLockedHeight)
+	assert.Nil(t, res.ChainLock)
+}
+
+// chainLockBlockStore serves the core chain locked heights and chain locks of
+// the blocks.
+type chainLockBlockStore struct {
+	mockBlockStore
+	base        int64
+	coreHeights map[int64]uint32
+	chainLocks  map[int64]*types.CoreChainLock
+}
+
+func (store chainLockBlockStore) Base() int64 {
+	if store.base == 0 {
+		return 1
+	}
+	return store.base
+}
+
+func (store chainLockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	if height < store.Base() || height > store.height {
+		return nil
+	}
+	return &types.BlockMeta{Header: types.Header{Height: height, CoreChainLockedHeight: store.coreHeights[height]}}
+}
+
+func (store chainLockBlockStore) LoadBlock(height int64) *types.Block {
+	meta := store.LoadBlockMeta(height)
+	if meta == nil {
+		return nil
+	}
+	return &types.Block{Header: meta.Header, CoreChainLock: store.chainLocks[height]}
+}
+
 type mockBlockStore struct {
 	height                int64
 	coreChainLockedHeight uint32
@@ -7,6 +7,8 @@ import (
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/evidence"
+	"github.com/tendermint/tendermint/libs/eventlog"
 	"github.com/tendermint/tendermint/libs/log"
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
@@ -64,17 +66,33 @@ type peers interface {
 	Peers() p2p.IPeerSet
 }
 
+// evidencePool is the evidence pool, which also lists the committed evidence.
+type evidencePool interface {
+	sm.EvidencePool
+	CommittedEvidence(
+		minInfractionHeight, maxInfractionHeight int64,
+		match func(types.Evidence) bool,
+		skip, limit int,
+	) ([]evidence.CommittedEvidence, int, error)
+}
+
+// appConns reports the connections to the application, which are lost.
+type appConns interface {
+	Disconnected() []string
+}
+
 // Environment contains objects and interfaces used by the RPC. It is expected
 // to be setup once during startup.
 type Environment struct {
 	// external, thread safe interfaces
 	ProxyAppQuery   proxy.AppConnQuery
 	ProxyAppMempool proxy.AppConnMempool
+	ProxyAppConns   appConns
 
 	// interfaces defined in types and above
 	StateStore     sm.Store
 	BlockStore     sm.BlockStore
-	EvidencePool   sm.EvidencePool
+	EvidencePool   evidencePool
 	ConsensusState Consensus
 	P2PPeers       peers
 	P2PTransport   transport
@@ -86,6 +104,7 @@ type Environment struct {
 	BlockIndexer     indexer.BlockIndexer
 	ConsensusReactor *consensus.Reactor
 	EventBus         *types.EventBus // thread safe
+	EventLog         *eventlog.Log   // thread safe, nil if disabled
 	Mempool          mempl.Mempool
 
 	Logger log.Logger
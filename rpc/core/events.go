@@ -2,9 +2,11 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/libs/eventlog"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
@@ -14,6 +16,9 @@ import (
 const (
 	// Buffer on the Tendermint (server) side to allow some slowness in clients.
 	subBufferSize = 100
+
+	defaultEventsMaxItems = 10
+	maxEventsMaxItems     = 100
 )
 
 // Subscribe for events via WebSocket.
@@ -110,3 +115,136 @@ func UnsubscribeAll(ctx *rpctypes.Context) (*ctypes.ResultUnsubscribe, error) {
 	}
 	return &ctypes.ResultUnsubscribe{}, nil
 }
+
+// StreamEvents subscribes the subscriber to events matching the query and
+// passes them to send until ctx is done, send fails or the subscription is
+// cancelled. The limits of websocket subscriptions apply. It's used by the
+// gRPC server, which streams events to its clients.
+func StreamEvents(
+	ctx context.Context,
+	subscriber string,
+	query string,
+	send func(*ctypes.ResultEvent) error,
+) error {
+	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
+		return fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	} else if env.EventBus.NumClientSubscriptions(subscriber) >= env.Config.MaxSubscriptionsPerClient {
+		return fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
+	}
+
+	env.Logger.Info("Subscribe to query", "remote", subscriber, "query", query)
+
+	q, err := tmquery.New(query)
+	if err != nil {
+		return fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	subCtx, cancel := context.WithTimeout(ctx, SubscribeTimeout)
+	defer cancel()
+
+	sub, err := env.EventBus.Subscribe(subCtx, subscriber, q, subBufferSize)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := env.EventBus.Unsubscribe(context.Background(), subscriber, q); err != nil &&
+			err != tmpubsub.ErrSubscriptionNotFound {
+			env.Logger.Error("Failed to unsubscribe", "remote", subscriber, "query", query, "err", err)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			if err := send(&ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}); err != nil {
+				return err
+			}
+		case <-sub.Cancelled():
+			if sub.Err() == nil {
+				return errors.New("subscription was cancelled (reason: Tendermint exited)")
+			}
+			return fmt.Errorf("subscription was cancelled (reason: %w)", sub.Err())
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Events returns up to maxItems events from the event log, which match the
+// filter query, ordered from the oldest. If after is a cursor of an event,
+// the events following it are returned; otherwise, the newest events are. If
+// there are no such events and waitTime is positive, it waits for a matching
+// event up to waitTime, but not longer than timeout_broadcast_tx_commit.
+// More: https://docs.tendermint.com/master/rpc/#/Info/events
+func Events(
+	ctx *rpctypes.Context,
+	filter string,
+	maxItems int,
+	after string,
+	waitTime time.Duration,
+) (*ctypes.ResultEvents, error) {
+	if env.EventLog == nil {
+		return nil, errors.New("the event log is disabled (see event_log_window_size)")
+	}
+
+	var q tmpubsub.Query = tmquery.Empty{}
+	if filter != "" {
+		var err error
+		if q, err = tmquery.New(filter); err != nil {
+			return nil, fmt.Errorf("failed to parse filter: %w", err)
+		}
+	}
+
+	cursor, err := eventlog.ParseCursor(after)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxItems <= 0 {
+		maxItems = defaultEventsMaxItems
+	} else if maxItems > maxEventsMaxItems {
+		maxItems = maxEventsMaxItems
+	}
+
+	// waiting must end before the server's write timeout
+	if waitTime > env.Config.TimeoutBroadcastTxCommit {
+		waitTime = env.Config.TimeoutBroadcastTxCommit
+	}
+	waitCtx, cancel := context.WithTimeout(ctx.Context(), waitTime)
+	defer cancel()
+
+	match := func(item *eventlog.Item) (bool, error) {
+		return q.Matches(item.Events)
+	}
+	for {
+		info := env.EventLog.Info()
+		items, more, err := env.EventLog.Read(cursor, maxItems, match)
+		if err != nil {
+			return nil, err
+		}
+
+		// wait until a newer event is added and look for matches again
+		if len(items) == 0 && waitTime > 0 {
+			if env.EventLog.WaitAfter(waitCtx, info.Newest) == nil {
+				continue
+			}
+			info = env.EventLog.Info()
+		}
+
+		result := &ctypes.ResultEvents{
+			Items:  make([]*ctypes.EventItem, 0, len(items)),
+			More:   more,
+			Oldest: info.Oldest.String(),
+			Newest: info.Newest.String(),
+		}
+		for _, item := range items {
+			result.Items = append(result.Items, &ctypes.EventItem{
+				Cursor: item.Cursor.String(),
+				Event:  item.Type,
+				Data:   item.Data,
+				Events: item.Events,
+			})
+		}
+		return result, nil
+	}
+}
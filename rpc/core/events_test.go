@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/eventlog"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func addTestEvent(lg *eventlog.Log, etype string, height int64) eventlog.Cursor {
+	return lg.Add(etype, types.EventDataNewBlockHeader{Header: types.Header{Height: height}},
+		map[string][]string{types.EventTypeKey: {etype}})
+}
+
+func eventItemTypes(res *ctypes.ResultEvents) []string {
+	events := make([]string, 0, len(res.Items))
+	for _, item := range res.Items {
+		events = append(events, item.Event)
+	}
+	return events
+}
+
+func TestEvents(t *testing.T) {
+	env = &Environment{Config: *cfg.DefaultRPCConfig()}
+	ctx := &rpctypes.Context{}
+
+	_, err := Events(ctx, "", 0, "", 0)
+	assert.Error(t, err, "the event log is disabled")
+
+	lg, err := eventlog.New(eventlog.Config{WindowSize: time.Minute})
+	require.NoError(t, err)
+	env.EventLog = lg
+
+	first := addTestEvent(lg, types.EventNewBlockHeader, 1)
+	addTestEvent(lg, types.EventNewBlock, 1)
+	addTestEvent(lg, types.EventNewBlockHeader, 2)
+
+	res, err := Events(ctx, "", 0, "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{types.EventNewBlockHeader, types.EventNewBlock, types.EventNewBlockHeader},
+		eventItemTypes(res))
+	assert.False(t, res.More)
+	assert.Equal(t, first.String(), res.Oldest)
+	assert.Equal(t, res.Items[2].Cursor, res.Newest)
+
+	res, err = Events(ctx, "tm.event = 'NewBlockHeader'", 1, first.String(), 0)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, types.EventNewBlockHeader, res.Items[0].Event)
+	assert.EqualValues(t, 2, res.Items[0].Data.(types.EventDataNewBlockHeader).Header.Height)
+	assert.False(t, res.More)
+
+	res, err = Events(ctx, "", 1, first.String(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{types.EventNewBlock}, eventItemTypes(res))
+	assert.True(t, res.More)
+
+	_, err = Events(ctx, "tm.event = ", 0, "", 0)
+	assert.Error(t, err)
+	_, err = Events(ctx, "", 0, "foo", 0)
+	assert.Error(t, err)
+
+	// waits for a matching event
+	newest := res.Newest
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		addTestEvent(lg, types.EventNewBlock, 2)
+		time.Sleep(10 * time.Millisecond)
+		addTestEvent(lg, types.EventNewBlockHeader, 3)
+	}()
+	res, err = Events(ctx, "tm.event = 'NewBlockHeader'", 0, newest, time.Second)
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	assert.EqualValues(t, 3, res.Items[0].Data.(types.EventDataNewBlockHeader).Header.Height)
+
+	// returns nothing when the wait time passes
+	res, err = Events(ctx, "", 0, res.Newest, 10*time.Millisecond)
+	require.NoError(t, err)
+	assert.Empty(t, res.Items)
+}
+
+func TestStreamEvents(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { _ = eventBus.Stop() })
+	env = &Environment{
+		Config:   *cfg.DefaultRPCConfig(),
+		EventBus: eventBus,
+		Logger:   log.TestingLogger(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	received := make(chan *ctypes.ResultEvent)
+	go func() {
+		done <- StreamEvents(ctx, "client", "tm.event = 'NewBlockHeader'", func(event *ctypes.ResultEvent) error {
+			received <- event
+			return nil
+		})
+	}()
+
+	require.Eventually(t, func() bool { return eventBus.NumClientSubscriptions("client") == 1 },
+		time.Second, 10*time.Millisecond)
+	require.NoError(t, eventBus.PublishEventNewBlockHeader(
+		types.EventDataNewBlockHeader{Header: types.Header{Height: 1}}))
+
+	event := <-received
+	assert.EqualValues(t, 1, event.Data.(types.EventDataNewBlockHeader).Header.Height)
+
+	// cancelling the context ends the subscription
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	assert.Zero(t, eventBus.NumClientSubscriptions("client"))
+
+	err := StreamEvents(context.Background(), "client", "tm.event = ", nil)
+	assert.Error(t, err)
+}
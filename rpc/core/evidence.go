@@ -1,9 +1,14 @@
 package core
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/evidence"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmmath "github.com/tendermint/tendermint/libs/math"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 	"github.com/tendermint/tendermint/types"
@@ -25,3 +30,127 @@ func BroadcastEvidence(ctx *rpctypes.Context, ev types.Evidence) (*ctypes.Result
 	}
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence returns the evidence, which is pending to be committed, from
+// oldest to newest.
+// More: https://docs.tendermint.com/master/rpc/#/Info/pending_evidence
+func PendingEvidence(ctx *rpctypes.Context, pagePtr, perPagePtr *int) (*ctypes.ResultPendingEvidence, error) {
+	pending, _ := env.EvidencePool.PendingEvidence(-1)
+
+	results := make([]*ctypes.ResultEvidence, 0, len(pending))
+	for _, ev := range pending {
+		results = append(results, &ctypes.ResultEvidence{Evidence: ev, Hash: ev.Hash()})
+	}
+
+	page, err := paginateEvidence(results, pagePtr, perPagePtr)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultPendingEvidence{Evidence: page, TotalCount: len(results)}, nil
+}
+
+// EvidenceSearch returns the committed evidence of the infraction heights from
+// minInfractionHeight to maxInfractionHeight (inclusive), from oldest to
+// newest, along with the heights of the blocks it was committed in. The
+// infraction height is the height of the evidence, i.e. of the conflicting
+// votes or proposals, so it is lower than the height of the block the evidence
+// was committed in. If evType is not empty, only the evidence of
+// the type is returned, and if proTxHash is not empty, only the evidence
+// against the validator. The type is one of the ABCI evidence types, e.g.
+// "DUPLICATE_VOTE".
+//
+// The range can't span more than evidence.MaxCommittedEvidenceHeights heights.
+// If maxInfractionHeight is 0, it defaults to the latest height, and if
+// minInfractionHeight is 0, it defaults to the lowest height of the widest
+// range, but not lower than 1.
+// More: https://docs.tendermint.com/master/rpc/#/Info/evidence_search
+func EvidenceSearch(
+	ctx *rpctypes.Context,
+	minInfractionHeight, maxInfractionHeight int64,
+	evType string,
+	proTxHash tmbytes.HexBytes,
+	pagePtr, perPagePtr *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	if minInfractionHeight < 0 || maxInfractionHeight < 0 {
+		return nil, errors.New("heights must be non-negative")
+	}
+	if maxInfractionHeight == 0 {
+		maxInfractionHeight = env.BlockStore.Height()
+	}
+	if minInfractionHeight == 0 {
+		minInfractionHeight = tmmath.MaxInt64(1, maxInfractionHeight-evidence.MaxCommittedEvidenceHeights+1)
+	}
+	if minInfractionHeight > maxInfractionHeight {
+		return nil, fmt.Errorf("min height %d can't be greater than max height %d", minInfractionHeight, maxInfractionHeight)
+	}
+
+	var abciType abci.EvidenceType
+	if evType != "" {
+		t, ok := abci.EvidenceType_value[evType]
+		if !ok || abci.EvidenceType(t) == abci.EvidenceType_UNKNOWN {
+			return nil, fmt.Errorf("unknown evidence type %q", evType)
+		}
+		abciType = abci.EvidenceType(t)
+	}
+
+	var match func(types.Evidence) bool
+	if abciType != abci.EvidenceType_UNKNOWN || len(proTxHash) != 0 {
+		match = func(ev types.Evidence) bool { return matchesEvidence(ev, abciType, proTxHash) }
+	}
+
+	// the pool paginates the evidence, so that only the evidence of the page
+	// is loaded, if possible
+	perPage := validatePerPage(perPagePtr)
+	page := 1
+	if pagePtr != nil {
+		page = *pagePtr
+	}
+	committed, totalCount, err := env.EvidencePool.CommittedEvidence(
+		minInfractionHeight, maxInfractionHeight, match, validateSkipCount(page, perPage), perPage)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := validatePage(pagePtr, perPage, totalCount); err != nil {
+		return nil, err
+	}
+
+	results := make([]*ctypes.ResultEvidence, 0, len(committed))
+	for _, ce := range committed {
+		results = append(results, &ctypes.ResultEvidence{
+			Evidence:        ce.Evidence,
+			Hash:            ce.Evidence.Hash(),
+			CommittedHeight: ce.Height,
+		})
+	}
+	return &ctypes.ResultEvidenceSearch{Evidence: results, TotalCount: totalCount}, nil
+}
+
+// matchesEvidence returns true if the evidence is of the type and against the
+// validator. An unknown type and an empty proTxHash match any evidence.
+func matchesEvidence(ev types.Evidence, evType abci.EvidenceType, proTxHash tmbytes.HexBytes) bool {
+	for _, abciEv := range ev.ABCI() {
+		if (evType == abci.EvidenceType_UNKNOWN || abciEv.Type == evType) &&
+			(len(proTxHash) == 0 || bytes.Equal(abciEv.Validator.ProTxHash, proTxHash)) {
+			return true
+		}
+	}
+	return false
+}
+
+func paginateEvidence(
+	results []*ctypes.ResultEvidence,
+	pagePtr, perPagePtr *int,
+) ([]*ctypes.ResultEvidence, error) {
+	totalCount := len(results)
+	perPage := validatePerPage(perPagePtr)
+
+	page, err := validatePage(pagePtr, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	skipCount := validateSkipCount(page, perPage)
+	pageSize := tmmath.MinInt(perPage, totalCount-skipCount)
+
+	return results[skipCount : skipCount+pageSize], nil
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/evidence"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+type mockEvidencePool struct {
+	sm.EmptyEvidencePool
+	pending   []types.Evidence
+	committed []evidence.CommittedEvidence
+}
+
+func (pool mockEvidencePool) PendingEvidence(int64) ([]types.Evidence, int64) {
+	return pool.pending, 0
+}
+
+func (pool mockEvidencePool) CommittedEvidence(
+	minInfractionHeight, maxInfractionHeight int64,
+	match func(types.Evidence) bool,
+	skip, limit int,
+) ([]evidence.CommittedEvidence, int, error) {
+	if maxInfractionHeight-minInfractionHeight >= evidence.MaxCommittedEvidenceHeights {
+		return nil, 0, errors.New("range too wide")
+	}
+	var (
+		res   []evidence.CommittedEvidence
+		total int
+	)
+	for _, ce := range pool.committed {
+		if ce.Evidence.Height() < minInfractionHeight || ce.Evidence.Height() > maxInfractionHeight ||
+			(match != nil && !match(ce.Evidence)) {
+			continue
+		}
+		if total >= skip && len(res) < limit {
+			res = append(res, ce)
+		}
+		total++
+	}
+	return res, total, nil
+}
+
+func duplicateVoteEvidence(height int64, proTxHash crypto.ProTxHash) *types.DuplicateVoteEvidence {
+	vote := func() *types.Vote {
+		return &types.Vote{
+			Type:               tmproto.PrecommitType,
+			Height:             height,
+			BlockID:            types.BlockID{Hash: crypto.CRandBytes(crypto.DefaultHashSize)},
+			ValidatorProTxHash: proTxHash,
+		}
+	}
+	return &types.DuplicateVoteEvidence{VoteA: vote(), VoteB: vote()}
+}
+
+func TestPendingEvidence(t *testing.T) {
+	ev := duplicateVoteEvidence(10, crypto.RandProTxHash())
+	env = &Environment{EvidencePool: mockEvidencePool{pending: []types.Evidence{ev}}}
+
+	res, err := PendingEvidence(&rpctypes.Context{}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &ctypes.ResultPendingEvidence{
+		Evidence:   []*ctypes.ResultEvidence{{Evidence: ev, Hash: ev.Hash()}},
+		TotalCount: 1,
+	}, res)
+}
+
+func TestEvidenceSearch(t *testing.T) {
+	var (
+		proTxHash  = crypto.RandProTxHash()
+		voteEv     = duplicateVoteEvidence(10, proTxHash)
+		otherEv    = duplicateVoteEvidence(20, crypto.RandProTxHash())
+		proposalEv = &types.DuplicateProposalEvidence{
+			ProposalA:         &types.Proposal{Height: 30},
+			ProposalB:         &types.Proposal{Height: 30},
+			ProposerProTxHash: proTxHash,
+		}
+	)
+	env = &Environment{
+		BlockStore: mockBlockStore{height: 100},
+		EvidencePool: mockEvidencePool{committed: []evidence.CommittedEvidence{
+			{Evidence: voteEv, Height: 11},
+			{Evidence: otherEv, Height: 21},
+			{Evidence: proposalEv, Height: 31},
+		}},
+	}
+
+	testCases := []struct {
+		name                 string
+		minHeight, maxHeight int64
+		evType               string
+		proTxHash            []byte
+		wantHeights          []int64
+		wantErr              bool
+	}{
+		{"all", 0, 0, "", nil, []int64{11, 21, 31}, false},
+		{"height range", 15, 40, "", nil, []int64{21, 31}, false},
+		{"type", 0, 0, "DUPLICATE_VOTE", nil, []int64{11, 21}, false},
+		{"validator", 0, 0, "", proTxHash, []int64{11, 31}, false},
+		{"type and validator", 0, 0, "DUPLICATE_PROPOSAL", proTxHash, []int64{31}, false},
+		{"unknown type", 0, 0, "UNKNOWN", nil, nil, true},
+		{"invalid range", 40, 15, "", nil, nil, true},
+		{"negative height", -1, 0, "", nil, nil, true},
+		{"too wide range", 1, evidence.MaxCommittedEvidenceHeights + 1, "", nil, nil, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := EvidenceSearch(&rpctypes.Context{}, tc.minHeight, tc.maxHeight, tc.evType, tc.proTxHash,
+				nil, nil)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			heights := make([]int64, 0, len(res.Evidence))
+			for _, ev := range res.Evidence {
+				assert.Equal(t, ev.Evidence.Hash(), []byte(ev.Hash))
+				heights = append(heights, ev.CommittedHeight)
+			}
+			assert.Equal(t, tc.wantHeights, heights)
+			assert.Equal(t, len(tc.wantHeights), res.TotalCount)
+		})
+	}
+
+	// the results are paginated
+	page, perPage := 2, 1
+	res, err := EvidenceSearch(&rpctypes.Context{}, 0, 0, "", nil, &page, &perPage)
+	require.NoError(t, err)
+	require.Len(t, res.Evidence, 1)
+	assert.EqualValues(t, 21, res.Evidence[0].CommittedHeight)
+	assert.Equal(t, 3, res.TotalCount)
+	page = 4
+	_, err = EvidenceSearch(&rpctypes.Context{}, 0, 0, "", nil, &page, &perPage)
+	assert.Error(t, err)
+
+	// by default, the widest range up to the latest height is searched
+	env.BlockStore = mockBlockStore{height: evidence.MaxCommittedEvidenceHeights + 15}
+	res, err = EvidenceSearch(&rpctypes.Context{}, 0, 0, "", nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, res.Evidence, 2)
+	assert.EqualValues(t, 21, res.Evidence[0].CommittedHeight)
+}
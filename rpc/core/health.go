@@ -1,13 +1,23 @@
 package core
 
 import (
+	"fmt"
+	"strings"
+
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 )
 
 // Health gets node health. Returns empty result (200 OK) on success, no
-// response - in case of an error.
+// response - in case of an error. Returns an error while the node is
+// reconnecting to the ABCI application.
 // More: https://docs.tendermint.com/master/rpc/#/Info/health
 func Health(ctx *rpctypes.Context) (*ctypes.ResultHealth, error) {
+	if env.ProxyAppConns != nil {
+		if disconnected := env.ProxyAppConns.Disconnected(); len(disconnected) > 0 {
+			return nil, fmt.Errorf("lost connection to the application (%s), reconnecting",
+				strings.Join(disconnected, ", "))
+		}
+	}
 	return &ctypes.ResultHealth{}, nil
 }
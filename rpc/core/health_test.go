@@ -0,0 +1,30 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+type mockAppConns struct {
+	disconnected []string
+}
+
+func (conns mockAppConns) Disconnected() []string {
+	return conns.disconnected
+}
+
+func TestHealth(t *testing.T) {
+	env = &Environment{ProxyAppConns: mockAppConns{}}
+	_, err := Health(&rpctypes.Context{})
+	require.NoError(t, err)
+
+	// unhealthy while reconnecting to the application
+	env = &Environment{ProxyAppConns: mockAppConns{disconnected: []string{"consensus", "mempool"}}}
+	_, err = Health(&rpctypes.Context{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "consensus, mempool")
+}
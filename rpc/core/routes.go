@@ -12,6 +12,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"subscribe":       rpc.NewWSRPCFunc(Subscribe, "query"),
 	"unsubscribe":     rpc.NewWSRPCFunc(Unsubscribe, "query"),
 	"unsubscribe_all": rpc.NewWSRPCFunc(UnsubscribeAll, ""),
+	"events":          rpc.NewRPCFunc(Events, "filter,maxItems,after,waitTime"),
 
 	// info API
 	"health":               rpc.NewRPCFunc(Health, ""),
@@ -45,6 +46,8 @@ var Routes = map[string]*rpc.RPCFunc{
 
 	// evidence API
 	"broadcast_evidence": rpc.NewRPCFunc(BroadcastEvidence, "evidence"),
+	"pending_evidence":   rpc.NewRPCFunc(PendingEvidence, "page,per_page"),
+	"evidence_search":    rpc.NewRPCFunc(EvidenceSearch, "min_height,max_height,type,pro_tx_hash,page,per_page"),
 }
 
 // AddUnsafeRoutes adds unsafe routes.
@@ -59,6 +59,8 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 		validatorInfo.ProTxHash = *env.ProTxHash
 	}
 
+	state := env.ConsensusState.GetState()
+
 	result := &ctypes.ResultStatus{
 		NodeInfo: env.P2PTransport.NodeInfo().(p2p.DefaultNodeInfo),
 		SyncInfo: ctypes.SyncInfo{
@@ -71,6 +73,8 @@ func Status(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
 			EarliestBlockHeight: earliestBlockHeight,
 			EarliestBlockTime:   time.Unix(0, earliestBlockTimeNano),
 			CatchingUp:          env.ConsensusReactor.WaitSync(),
+			Halted:              state.IsHalted(),
+			HaltHeight:          state.HaltHeight,
 		},
 		ValidatorInfo: validatorInfo,
 	}
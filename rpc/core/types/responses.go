@@ -31,6 +31,18 @@ type ResultBlock struct {
 	Block   *types.Block  `json:"block"`
 }
 
+// Core chain lock of a block
+type ResultChainLock struct {
+	// height of the block
+	Height int64 `json:"height"`
+	// core chain locked height of the block
+	CoreChainLockedHeight uint32 `json:"core_chain_locked_height"`
+	// chain lock of the core chain locked height, nil if it wasn't found
+	ChainLock *types.CoreChainLock `json:"chain_lock"`
+	// height of the block, which included the chain lock
+	ChainLockHeight int64 `json:"chain_lock_height"`
+}
+
 // Commit and Header
 type ResultCommit struct {
 	types.SignedHeader `json:"signed_header"`
@@ -74,6 +86,11 @@ type SyncInfo struct {
 	EarliestBlockTime   time.Time      `json:"earliest_block_time"`
 
 	CatchingUp bool `json:"catching_up"`
+
+	// Halted is true if the chain is halted after HaltHeight, as requested by
+	// the application.
+	Halted     bool  `json:"halted"`
+	HaltHeight int64 `json:"halt_height"`
 }
 
 // Info about the node's validator
@@ -229,6 +246,26 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// Evidence with the height of the block it was committed in
+type ResultEvidence struct {
+	Evidence types.Evidence `json:"evidence"`
+	Hash     bytes.HexBytes `json:"hash"`
+	// 0 if the evidence is pending
+	CommittedHeight int64 `json:"committed_height"`
+}
+
+// List of pending evidence
+type ResultPendingEvidence struct {
+	Evidence   []*ResultEvidence `json:"evidence"`
+	TotalCount int               `json:"total_count"`
+}
+
+// Result of searching for committed evidence
+type ResultEvidenceSearch struct {
+	Evidence   []*ResultEvidence `json:"evidence"`
+	TotalCount int               `json:"total_count"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -244,3 +281,25 @@ type ResultEvent struct {
 	Data   types.TMEventData   `json:"data"`
 	Events map[string][]string `json:"events"`
 }
+
+// ResultEvents is the result of reading the event log.
+type ResultEvents struct {
+	// Items are ordered from the oldest.
+	Items []*EventItem `json:"items"`
+	// More is true if there are more items matching the filter, which didn't
+	// fit into the result.
+	More bool `json:"more"`
+	// Cursors of the oldest and the newest items in the log. If the cursor of
+	// the request is older than the oldest item, events may have been missed.
+	Oldest string `json:"oldest"`
+	Newest string `json:"newest"`
+}
+
+// EventItem is an event from the event log.
+type EventItem struct {
+	// Cursor of the item, to be passed as "after" to read the following items.
+	Cursor string              `json:"cursor"`
+	Event  string              `json:"event"`
+	Data   types.TMEventData   `json:"data"`
+	Events map[string][]string `json:"events"`
+}
@@ -2,9 +2,18 @@ package coregrpc
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"sort"
+
+	"google.golang.org/grpc/peer"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	core "github.com/tendermint/tendermint/rpc/core"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 )
 
@@ -17,9 +26,7 @@ func (bapi *broadcastAPI) Ping(ctx context.Context, req *RequestPing) (*Response
 }
 
 func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcastTx) (*ResponseBroadcastTx, error) {
-	// NOTE: there's no way to get client's remote address
-	// see https://stackoverflow.com/questions/33684570/session-and-remote-ip-address-in-grpc-go
-	res, err := core.BroadcastTxCommit(&rpctypes.Context{}, req.Tx)
+	res, err := core.BroadcastTxCommit(rpcContext(ctx), req.Tx)
 	if err != nil {
 		return nil, err
 	}
@@ -37,3 +44,244 @@ func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcast
 		},
 	}, nil
 }
+
+//----------------------------------------
+
+type infoAPI struct {
+}
+
+func (iapi *infoAPI) Status(ctx context.Context, req *RequestStatus) (*ResponseStatus, error) {
+	res, err := core.Status(rpcContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResponseStatus{
+		NodeInfo: res.NodeInfo.ToProto(),
+		SyncInfo: &SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+			Halted:              res.SyncInfo.Halted,
+			HaltHeight:          res.SyncInfo.HaltHeight,
+		},
+		ValidatorInfo: &ValidatorInfo{
+			ProTxHash:   res.ValidatorInfo.ProTxHash,
+			VotingPower: res.ValidatorInfo.VotingPower,
+		},
+	}, nil
+}
+
+func (iapi *infoAPI) Block(ctx context.Context, req *RequestBlock) (*ResponseBlock, error) {
+	res, err := core.Block(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	if res.Block == nil {
+		return nil, errors.New("block not found")
+	}
+	return blockToProto(res)
+}
+
+func (iapi *infoAPI) BlockSearch(ctx context.Context, req *RequestBlockSearch) (*ResponseBlockSearch, error) {
+	res, err := core.BlockSearch(rpcContext(ctx), req.Query, intPtr(req.Page), intPtr(req.PerPage), req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*ResponseBlock, 0, len(res.Blocks))
+	for _, b := range res.Blocks {
+		block, err := blockToProto(b)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return &ResponseBlockSearch{Blocks: blocks, TotalCount: int64(res.TotalCount)}, nil
+}
+
+func blockToProto(res *ctypes.ResultBlock) (*ResponseBlock, error) {
+	block, err := res.Block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBlock{BlockID: res.BlockID.ToProto(), Block: block}, nil
+}
+
+func (iapi *infoAPI) ChainLock(ctx context.Context, req *RequestChainLock) (*ResponseChainLock, error) {
+	res, err := core.ChainLock(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResponseChainLock{
+		Height:                res.Height,
+		CoreChainLockedHeight: res.CoreChainLockedHeight,
+		ChainLock:             res.ChainLock.ToProto(),
+		ChainLockHeight:       res.ChainLockHeight,
+	}, nil
+}
+
+func (iapi *infoAPI) Commit(ctx context.Context, req *RequestCommit) (*ResponseCommit, error) {
+	res, err := core.Commit(rpcContext(ctx), heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errors.New("commit not found")
+	}
+
+	return &ResponseCommit{SignedHeader: res.SignedHeader.ToProto(), Canonical: res.CanonicalCommit}, nil
+}
+
+func (iapi *infoAPI) Validators(ctx context.Context, req *RequestValidators) (*ResponseValidators, error) {
+	requestThresholdPublicKey := !req.SkipThresholdPublicKey
+	res, err := core.Validators(rpcContext(ctx), heightPtr(req.Height),
+		intPtr(req.Page), intPtr(req.PerPage), &requestThresholdPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]*tmproto.Validator, 0, len(res.Validators))
+	for _, val := range res.Validators {
+		pv, err := val.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, pv)
+	}
+
+	result := &ResponseValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  validators,
+		QuorumType:  int32(res.QuorumType),
+		Count:       int64(res.Count),
+		Total:       int64(res.Total),
+	}
+	if res.ThresholdPublicKey != nil && *res.ThresholdPublicKey != nil {
+		pk, err := cryptoenc.PubKeyToProto(*res.ThresholdPublicKey)
+		if err != nil {
+			return nil, err
+		}
+		result.ThresholdPublicKey = &pk
+	}
+	if res.QuorumHash != nil {
+		result.QuorumHash = *res.QuorumHash
+	}
+	return result, nil
+}
+
+func (iapi *infoAPI) Tx(ctx context.Context, req *RequestTx) (*ResponseTx, error) {
+	res, err := core.Tx(rpcContext(ctx), req.Hash, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return txToProto(res, req.Prove), nil
+}
+
+func (iapi *infoAPI) TxSearch(ctx context.Context, req *RequestTxSearch) (*ResponseTxSearch, error) {
+	res, err := core.TxSearch(rpcContext(ctx), req.Query, req.Prove,
+		intPtr(req.Page), intPtr(req.PerPage), req.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*ResponseTx, 0, len(res.Txs))
+	for _, tx := range res.Txs {
+		txs = append(txs, txToProto(tx, req.Prove))
+	}
+	return &ResponseTxSearch{Txs: txs, TotalCount: int64(res.TotalCount)}, nil
+}
+
+func txToProto(res *ctypes.ResultTx, prove bool) *ResponseTx {
+	txResult := res.TxResult
+	tx := &ResponseTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: &txResult,
+		Tx:       res.Tx,
+	}
+	if prove {
+		proof := res.Proof.ToProto()
+		tx.Proof = &proof
+	}
+	return tx
+}
+
+//----------------------------------------
+
+type abciAPI struct {
+}
+
+func (aapi *abciAPI) ABCIQuery(ctx context.Context, req *RequestABCIQuery) (*ResponseABCIQuery, error) {
+	res, err := core.ABCIQuery(rpcContext(ctx), req.Path, req.Data, req.Height, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseABCIQuery{Response: &res.Response}, nil
+}
+
+//----------------------------------------
+
+type eventsAPI struct {
+}
+
+func (eapi *eventsAPI) Subscribe(req *RequestSubscribe, stream EventsAPI_SubscribeServer) error {
+	// subscriptions are limited per client address, like the websocket ones
+	subscriber := rpcContext(stream.Context()).RemoteAddr()
+
+	return core.StreamEvents(stream.Context(), subscriber, req.Query, func(event *ctypes.ResultEvent) error {
+		data, err := tmjson.Marshal(event.Data)
+		if err != nil {
+			return err
+		}
+		res := &ResponseSubscribe{
+			Query:  event.Query,
+			Data:   data,
+			Events: make([]EventAttribute, 0, len(event.Events)),
+		}
+		for key, values := range event.Events {
+			res.Events = append(res.Events, EventAttribute{Key: key, Values: values})
+		}
+		// the events come from a map, so they are sorted to be deterministic
+		sort.Slice(res.Events, func(i, j int) bool { return res.Events[i].Key < res.Events[j].Key })
+		return stream.Send(res)
+	})
+}
+
+//----------------------------------------
+
+// rpcContext returns the context of a core call made for a gRPC request. The
+// call is cancelled along with the request, and attributed to the address of
+// the client (e.g. its subscriptions are limited per address).
+func rpcContext(ctx context.Context) *rpctypes.Context {
+	remoteAddr := "grpc"
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr.String()
+	}
+	return &rpctypes.Context{HTTPReq: (&http.Request{RemoteAddr: remoteAddr}).WithContext(ctx)}
+}
+
+// heightPtr returns nil for a height of 0, which means the latest height.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
+
+// intPtr returns nil for 0, which means the default value.
+func intPtr(i int32) *int {
+	if i == 0 {
+		return nil
+	}
+	v := int(i)
+	return &v
+}
@@ -0,0 +1,19 @@
+package coregrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rpcCtx := rpcContext(ctx)
+	assert.Equal(t, "grpc", rpcCtx.RemoteAddr())
+	assert.NoError(t, rpcCtx.Context().Err())
+
+	// the core call is cancelled along with the request
+	cancel()
+	assert.ErrorIs(t, rpcCtx.Context().Err(), context.Canceled)
+}
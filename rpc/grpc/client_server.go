@@ -14,23 +14,45 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// Client is a client of all the gRPC services.
+type Client struct {
+	BroadcastAPIClient
+	InfoAPIClient
+	ABCIAPIClient
+	EventsAPIClient
+}
+
+// StartGRPCServer starts a new gRPC server, which serves BroadcastAPI,
+// InfoAPI, ABCIAPI and EventsAPI, using the given net.Listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func StartGRPCServer(ln net.Listener) error {
 	grpcServer := grpc.NewServer()
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterInfoAPIServer(grpcServer, &infoAPI{})
+	RegisterABCIAPIServer(grpcServer, &abciAPI{})
+	RegisterEventsAPIServer(grpcServer, &eventsAPI{})
 	return grpcServer.Serve(ln)
 }
 
 // StartGRPCClient dials the gRPC server using protoAddr and returns a new
 // BroadcastAPIClient.
 func StartGRPCClient(protoAddr string) BroadcastAPIClient {
+	return StartGRPCFullClient(protoAddr).BroadcastAPIClient
+}
+
+// StartGRPCFullClient dials the gRPC server using protoAddr and returns a new
+// Client of all the services.
+func StartGRPCFullClient(protoAddr string) *Client {
 	conn, err := grpc.Dial(protoAddr, grpc.WithInsecure(), grpc.WithContextDialer(dialerFunc))
 	if err != nil {
 		panic(err)
 	}
-	return NewBroadcastAPIClient(conn)
+	return &Client{
+		BroadcastAPIClient: NewBroadcastAPIClient(conn),
+		InfoAPIClient:      NewInfoAPIClient(conn),
+		ABCIAPIClient:      NewABCIAPIClient(conn),
+		EventsAPIClient:    NewEventsAPIClient(conn),
+	}
 }
 
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
@@ -2,14 +2,19 @@ package coregrpc_test
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"sort"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/abci/example/kvstore"
+	tmjson "github.com/tendermint/tendermint/libs/json"
 	core_grpc "github.com/tendermint/tendermint/rpc/grpc"
 	rpctest "github.com/tendermint/tendermint/rpc/test"
+	"github.com/tendermint/tendermint/types"
 )
 
 func TestMain(m *testing.M) {
@@ -33,3 +38,86 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestInfoAPI(t *testing.T) {
+	ctx := context.Background()
+	client := rpctest.GetGRPCFullClient()
+
+	status, err := client.Status(ctx, &core_grpc.RequestStatus{})
+	require.NoError(t, err)
+	require.NotNil(t, status.NodeInfo)
+	assert.Equal(t, rpctest.GetConfig().ChainID(), status.NodeInfo.Network)
+
+	block, err := client.Block(ctx, &core_grpc.RequestBlock{})
+	require.NoError(t, err)
+	require.NotNil(t, block.Block)
+	assert.EqualValues(t, status.SyncInfo.LatestBlockHeight, block.Block.Header.Height)
+
+	commit, err := client.Commit(ctx, &core_grpc.RequestCommit{Height: block.Block.Header.Height})
+	require.NoError(t, err)
+	assert.Equal(t, block.BlockID.Hash, commit.SignedHeader.Commit.BlockID.Hash)
+
+	vals, err := client.Validators(ctx, &core_grpc.RequestValidators{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, vals.Total)
+	assert.Len(t, vals.Validators, 1)
+	assert.NotNil(t, vals.ThresholdPublicKey)
+
+	vals, err = client.Validators(ctx, &core_grpc.RequestValidators{SkipThresholdPublicKey: true})
+	require.NoError(t, err)
+	assert.Nil(t, vals.ThresholdPublicKey)
+
+	blocks, err := client.BlockSearch(ctx, &core_grpc.RequestBlockSearch{
+		Query: fmt.Sprintf("block.height = %d", block.Block.Header.Height),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, blocks.TotalCount)
+	assert.Equal(t, block.BlockID, blocks.Blocks[0].BlockID)
+
+	chainLock, err := client.ChainLock(ctx, &core_grpc.RequestChainLock{Height: block.Block.Header.Height})
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Header.Height, chainLock.Height)
+	assert.Equal(t, block.Block.Header.CoreChainLockedHeight, chainLock.CoreChainLockedHeight)
+}
+
+func TestTxAndABCIQuery(t *testing.T) {
+	ctx := context.Background()
+	client := rpctest.GetGRPCFullClient()
+
+	tx := []byte("grpc=query")
+	_, err := client.BroadcastTx(ctx, &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+
+	res, err := client.Tx(ctx, &core_grpc.RequestTx{Hash: types.Tx(tx).Hash(), Prove: true})
+	require.NoError(t, err)
+	assert.Equal(t, tx, res.Tx)
+	assert.NotNil(t, res.Proof)
+
+	search, err := client.TxSearch(ctx, &core_grpc.RequestTxSearch{Query: fmt.Sprintf("tx.height=%d", res.Height)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, search.TotalCount)
+
+	query, err := client.ABCIQuery(ctx, &core_grpc.RequestABCIQuery{Data: []byte("grpc")})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("query"), query.Response.Value)
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := rpctest.GetGRPCFullClient()
+
+	stream, err := client.Subscribe(ctx, &core_grpc.RequestSubscribe{Query: types.EventQueryNewBlockHeader.String()})
+	require.NoError(t, err)
+
+	res, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, types.EventQueryNewBlockHeader.String(), res.Query)
+
+	var data types.TMEventData
+	require.NoError(t, tmjson.Unmarshal(res.Data, &data))
+	assert.IsType(t, types.EventDataNewBlockHeader{}, data)
+	assert.True(t, sort.SliceIsSorted(res.Events, func(i, j int) bool {
+		return res.Events[i].Key < res.Events[j].Key
+	}))
+}
@@ -0,0 +1,311 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	types "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+var (
+	// ErrRouteForbidden is returned when the client isn't allowed to call a
+	// route.
+	ErrRouteForbidden = errors.New("route is forbidden")
+	// ErrRateLimited is returned when the client exceeds its rate limit.
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// Authenticator authenticates the clients of the RPC server.
+type Authenticator interface {
+	// Authenticate returns the name of the client, which sent the request, or
+	// an empty string if the request has no credentials the authenticator
+	// handles. It returns an error if the credentials are invalid.
+	Authenticate(r *http.Request) (string, error)
+}
+
+// TokenAuthenticator authenticates clients by bearer tokens, sent in the
+// "Authorization: Bearer <token>" header.
+type TokenAuthenticator struct {
+	tokens map[string]string // client name -> token
+}
+
+var _ Authenticator = (*TokenAuthenticator)(nil)
+
+// NewTokenAuthenticator returns an authenticator for the given tokens, keyed
+// by client names.
+func NewTokenAuthenticator(tokens map[string]string) *TokenAuthenticator {
+	return &TokenAuthenticator{tokens: tokens}
+}
+
+// Authenticate implements Authenticator.
+func (ta *TokenAuthenticator) Authenticate(r *http.Request) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header == "" {
+		return "", nil
+	}
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return "", errors.New("unsupported authorization scheme")
+	}
+	token := []byte(strings.TrimPrefix(header, prefix))
+
+	// compare with every token to not leak which one matched through timing
+	var name string
+	for client, t := range ta.tokens {
+		if subtle.ConstantTimeCompare(token, []byte(t)) == 1 {
+			name = client
+		}
+	}
+	if name == "" {
+		return "", errors.New("invalid bearer token")
+	}
+	return name, nil
+}
+
+// ClientCertAuthenticator authenticates clients by TLS client certificates,
+// using the common name of the certificate as the client name. The
+// certificates are verified by the server against Config.ClientCAs.
+type ClientCertAuthenticator struct{}
+
+var _ Authenticator = ClientCertAuthenticator{}
+
+// Authenticate implements Authenticator.
+func (ClientCertAuthenticator) Authenticate(r *http.Request) (string, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return "", nil
+	}
+	name := r.TLS.VerifiedChains[0][0].Subject.CommonName
+	if name == "" {
+		return "", errors.New("client certificate has no common name")
+	}
+	return name, nil
+}
+
+// AccessConfig configures the access control of the RPC server.
+type AccessConfig struct {
+	// Authenticators are tried in order, until one of them returns a client
+	// name.
+	Authenticators []Authenticator
+	// Routes anonymous clients may call. "*" matches every route.
+	AnonymousRoutes []string
+	// Routes authenticated clients may call, keyed by client names. Clients,
+	// which aren't listed, may call every route.
+	ClientRoutes map[string][]string
+	// Requests per second allowed for every IP address of anonymous clients.
+	// 0 - unlimited.
+	RateLimit float64
+	// Requests per second allowed for every authenticated client.
+	// 0 - unlimited.
+	AuthRateLimit float64
+	// Maximum number of requests, which a client may send at once, before it
+	// is limited to the rate. Values below 1 are treated as 1.
+	RateLimitBurst int
+}
+
+// AccessControl authenticates clients of the RPC server and checks whether
+// they may call routes, both over HTTP and WebSocket.
+type AccessControl struct {
+	config        AccessConfig
+	anonLimiter   *rateLimiter
+	clientLimiter *rateLimiter
+}
+
+// NewAccessControl returns an AccessControl with the given configuration.
+func NewAccessControl(config AccessConfig) *AccessControl {
+	return &AccessControl{
+		config:        config,
+		anonLimiter:   newRateLimiter(config.RateLimit, config.RateLimitBurst),
+		clientLimiter: newRateLimiter(config.AuthRateLimit, config.RateLimitBurst),
+	}
+}
+
+type clientKey struct{}
+
+// client is a client of the RPC server.
+type client struct {
+	ac   *AccessControl
+	name string // empty for anonymous clients
+	ip   string
+}
+
+// handler returns a handler, which authenticates requests before passing them
+// to next. Requests with invalid credentials are rejected. A nil
+// AccessControl returns next.
+func (ac *AccessControl) handler(next http.Handler, logger log.Logger) http.Handler {
+	if ac == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := &client{ac: ac, ip: remoteIP(r)}
+		for _, auth := range ac.config.Authenticators {
+			name, err := auth.Authenticate(r)
+			if err != nil {
+				res := types.RPCInvalidRequestError(nil, fmt.Errorf("authentication failed: %w", err))
+				if wErr := WriteRPCResponseHTTPError(w, http.StatusUnauthorized, res); wErr != nil {
+					logger.Error("failed to write response", "res", res, "err", wErr)
+				}
+				return
+			}
+			if name != "" {
+				c.name = name
+				break
+			}
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientKey{}, c)))
+	})
+}
+
+// clientFromContext returns the client of a request, which passed through
+// AccessControl.handler, or nil.
+func clientFromContext(ctx context.Context) *client {
+	c, _ := ctx.Value(clientKey{}).(*client)
+	return c
+}
+
+// authorize returns an error if the client may not call the route now. A nil
+// client may call every route.
+func (c *client) authorize(route string) error {
+	if c == nil {
+		return nil
+	}
+
+	var routes []string
+	if c.name == "" {
+		routes = c.ac.config.AnonymousRoutes
+	} else if clientRoutes, ok := c.ac.config.ClientRoutes[c.name]; ok {
+		routes = clientRoutes
+	} else {
+		routes = []string{"*"}
+	}
+	if !containsRoute(routes, route) {
+		return fmt.Errorf("%w: %s", ErrRouteForbidden, route)
+	}
+
+	if c.name == "" {
+		if !c.ac.anonLimiter.allow(c.ip) {
+			return ErrRateLimited
+		}
+	} else if !c.ac.clientLimiter.allow(c.name) {
+		return ErrRateLimited
+	}
+	return nil
+}
+
+// authorizeStatus returns the HTTP status code for an error returned by
+// authorize.
+func authorizeStatus(err error) int {
+	if errors.Is(err, ErrRateLimited) {
+		return http.StatusTooManyRequests
+	}
+	return http.StatusForbidden
+}
+
+func containsRoute(routes []string, route string) bool {
+	for _, r := range routes {
+		if r == "*" || r == route {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		// e.g. a unix socket
+		return r.RemoteAddr
+	}
+	return host
+}
+
+//----------------------------------------------------------------------------
+// Rate limiting
+
+// how often the buckets, which are full again, are removed
+const rateLimiterSweepInterval = time.Minute
+
+// rateLimiter limits the rate of requests per key with token buckets.
+type rateLimiter struct {
+	rate  float64 // tokens per second
+	burst float64 // capacity of a bucket
+
+	mtx       tmsync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a limiter, which allows rate requests per second
+// with the given burst, or nil if the rate isn't positive.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+		now:     time.Now,
+	}
+}
+
+// allow takes a token from the bucket of the key and returns true, or returns
+// false if the bucket is empty. A nil limiter allows every request.
+func (rl *rateLimiter) allow(key string) bool {
+	if rl == nil {
+		return true
+	}
+
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	now := rl.now()
+	if now.Sub(rl.lastSweep) >= rateLimiterSweepInterval {
+		rl.sweep(now)
+	}
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: rl.burst, last: now}
+		rl.buckets[key] = b
+	} else {
+		b.tokens += now.Sub(b.last).Seconds() * rl.rate
+		if b.tokens > rl.burst {
+			b.tokens = rl.burst
+		}
+		b.last = now
+	}
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep removes the buckets, which are full again, so that the limiter
+// doesn't grow with every client it has ever seen.
+func (rl *rateLimiter) sweep(now time.Time) {
+	for key, b := range rl.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*rl.rate >= rl.burst {
+			delete(rl.buckets, key)
+		}
+	}
+	rl.lastSweep = now
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/log"
+	types "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+)
+
+func newAccessControlServer(config AccessConfig) *httptest.Server {
+	funcMap := map[string]*RPCFunc{
+		"status": NewRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+		"block":  NewRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+	}
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(log.TestingLogger())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+
+	serverConfig := DefaultConfig()
+	serverConfig.AccessControl = NewAccessControl(config)
+	return httptest.NewServer(wrapHandler(mux, log.TestingLogger(), serverConfig))
+}
+
+func getStatus(t *testing.T, url, path, token string) int {
+	req, err := http.NewRequest(http.MethodGet, url+path, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+	return res.StatusCode
+}
+
+func TestAccessControlRoutes(t *testing.T) {
+	s := newAccessControlServer(AccessConfig{
+		Authenticators: []Authenticator{NewTokenAuthenticator(map[string]string{
+			"alice":    "alice-token",
+			"explorer": "explorer-token",
+		})},
+		AnonymousRoutes: []string{"status"},
+		ClientRoutes:    map[string][]string{"explorer": {"block"}},
+	})
+	defer s.Close()
+
+	testCases := []struct {
+		path   string
+		token  string
+		status int
+	}{
+		{"/status", "", http.StatusOK},
+		{"/block", "", http.StatusForbidden},
+		{"/block", "alice-token", http.StatusOK},
+		{"/status", "alice-token", http.StatusOK},
+		{"/block", "explorer-token", http.StatusOK},
+		{"/status", "explorer-token", http.StatusForbidden},
+		{"/status", "invalid-token", http.StatusUnauthorized},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.status, getStatus(t, s.URL, tc.path, tc.token), tc)
+	}
+
+	// JSON-RPC requests are checked one by one
+	res, err := http.Post(s.URL, "application/json", strings.NewReader(
+		`[{"jsonrpc":"2.0","id":1,"method":"status"},{"jsonrpc":"2.0","id":2,"method":"block"}]`))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	var responses []types.RPCResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&responses))
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	require.NotNil(t, responses[1].Error)
+	assert.Contains(t, responses[1].Error.Data, ErrRouteForbidden.Error())
+}
+
+func TestAccessControlWebsocket(t *testing.T) {
+	s := newAccessControlServer(AccessConfig{
+		AnonymousRoutes: []string{"status"},
+		RateLimit:       0.001,
+		RateLimitBurst:  2,
+	})
+	defer s.Close()
+
+	c, dialResp, err := websocket.DefaultDialer.Dial("ws://"+s.Listener.Addr().String()+"/websocket", nil)
+	require.NoError(t, err)
+	defer c.Close()
+	dialResp.Body.Close()
+
+	call := func(method string) *types.RPCError {
+		req, err := types.MapToRequest(types.JSONRPCStringID("ws"), method, map[string]interface{}{})
+		require.NoError(t, err)
+		require.NoError(t, c.WriteJSON(req))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		return resp.Error
+	}
+
+	assert.Nil(t, call("status"))
+	if err := call("block"); assert.NotNil(t, err) {
+		assert.Contains(t, err.Data, ErrRouteForbidden.Error())
+	}
+	assert.Nil(t, call("status"))
+	if err := call("status"); assert.NotNil(t, err) {
+		assert.Contains(t, err.Data, ErrRateLimited.Error())
+	}
+
+	// the limit applies to the IP address, not the connection
+	assert.Equal(t, http.StatusTooManyRequests, getStatus(t, s.URL, "/status", ""))
+}
+
+func TestClientCertAuthenticator(t *testing.T) {
+	auth := ClientCertAuthenticator{}
+
+	name, err := auth.Authenticate(&http.Request{})
+	require.NoError(t, err)
+	assert.Empty(t, name)
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "alice"}}
+	name, err = auth.Authenticate(&http.Request{
+		TLS: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", name)
+
+	cert.Subject.CommonName = ""
+	_, err = auth.Authenticate(&http.Request{
+		TLS: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	})
+	assert.Error(t, err)
+}
+
+func TestRateLimiter(t *testing.T) {
+	assert.Nil(t, newRateLimiter(0, 10))
+
+	rl := newRateLimiter(2, 3)
+	now := time.Unix(1000, 0)
+	rl.now = func() time.Time { return now }
+
+	// the burst is allowed at once
+	for i := 0; i < 3; i++ {
+		assert.True(t, rl.allow("a"), i)
+	}
+	assert.False(t, rl.allow("a"))
+	// keys have separate buckets
+	assert.True(t, rl.allow("b"))
+
+	// tokens are refilled at the rate
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, rl.allow("a"))
+	assert.False(t, rl.allow("a"))
+
+	// full buckets are removed
+	now = now.Add(rateLimiterSweepInterval)
+	assert.True(t, rl.allow("a"))
+	assert.Len(t, rl.buckets, 1)
+}
@@ -79,6 +79,10 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				responses = append(responses, types.RPCMethodNotFoundError(request.ID))
 				continue
 			}
+			if err := clientFromContext(r.Context()).authorize(request.Method); err != nil {
+				responses = append(responses, types.RPCInvalidRequestError(request.ID, err))
+				continue
+			}
 			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -99,7 +103,9 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				responses = append(responses, types.RPCInternalError(request.ID, err))
 				continue
 			}
-			responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
+			res := types.NewRPCSuccessResponse(request.ID, result)
+			res.Unverified = rpcFunc.unverified
+			responses = append(responses, res)
 		}
 
 		if len(responses) > 0 {
@@ -227,3 +227,37 @@ func TestUnknownRPCPath(t *testing.T) {
 	require.Equal(t, http.StatusNotFound, res.StatusCode, "should always return 404")
 	res.Body.Close()
 }
+
+func TestUnverifiedRPCFunc(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"verified":   NewRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, ""),
+		"unverified": NewRPCFunc(func(ctx *types.Context) (string, error) { return "foo", nil }, "", Unverified()),
+	}
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+
+	get := func(req *http.Request) []byte {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		res := rec.Result()
+		defer res.Body.Close()
+		blob, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		return blob
+	}
+
+	for _, method := range []string{"verified", "unverified"} {
+		// JSON-RPC
+		req, _ := http.NewRequest("POST", "http://localhost/",
+			strings.NewReader(`{"jsonrpc": "2.0", "method": "`+method+`", "id": "0"}`))
+		recv := new(types.RPCResponse)
+		require.NoError(t, json.Unmarshal(get(req), recv))
+		assert.Equal(t, method == "unverified", recv.Unverified, method)
+
+		// URI
+		req, _ = http.NewRequest("GET", "http://localhost/"+method, nil)
+		recv = new(types.RPCResponse)
+		require.NoError(t, json.Unmarshal(get(req), recv))
+		assert.Equal(t, method == "unverified", recv.Unverified, method)
+	}
+}
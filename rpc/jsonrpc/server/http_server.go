@@ -3,6 +3,8 @@ package server
 
 import (
 	"bufio"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -32,6 +34,15 @@ type Config struct {
 	MaxBodyBytes int64
 	// mirrors http.Server#MaxHeaderBytes
 	MaxHeaderBytes int
+	// AccessControl authenticates clients and limits the routes they may call
+	// and the rate of their requests. nil - every request is allowed.
+	AccessControl *AccessControl
+	// ClientCAs are used by ServeTLS to verify client certificates, which
+	// are optional. nil - client certificates aren't requested.
+	ClientCAs *x509.CertPool
+	// RequireClientCert makes ServeTLS reject clients, which don't present a
+	// certificate signed by ClientCAs.
+	RequireClientCert bool
 }
 
 // DefaultConfig returns a default configuration.
@@ -46,14 +57,14 @@ func DefaultConfig() *Config {
 }
 
 // Serve creates a http.Server and calls Serve with the given listener. It
-// wraps handler with RecoverAndLogHandler and a handler, which limits the max
-// body size to config.MaxBodyBytes.
+// wraps handler with RecoverAndLogHandler, a handler, which limits the max
+// body size to config.MaxBodyBytes, and config.AccessControl.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func Serve(listener net.Listener, handler http.Handler, logger log.Logger, config *Config) error {
 	logger.Info(fmt.Sprintf("Starting RPC HTTP server on %s", listener.Addr()))
 	s := &http.Server{
-		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: config.MaxBodyBytes}, logger),
+		Handler:        wrapHandler(handler, logger, config),
 		ReadTimeout:    config.ReadTimeout,
 		WriteTimeout:   config.WriteTimeout,
 		MaxHeaderBytes: config.MaxHeaderBytes,
@@ -64,8 +75,10 @@ func Serve(listener net.Listener, handler http.Handler, logger log.Logger, confi
 }
 
 // Serve creates a http.Server and calls ServeTLS with the given listener,
-// certFile and keyFile. It wraps handler with RecoverAndLogHandler and a
-// handler, which limits the max body size to config.MaxBodyBytes.
+// certFile and keyFile. It wraps handler with RecoverAndLogHandler, a handler,
+// which limits the max body size to config.MaxBodyBytes, and
+// config.AccessControl. If config.ClientCAs are set, clients may present
+// certificates signed by them, or must if config.RequireClientCert is set.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func ServeTLS(
@@ -78,17 +91,33 @@ func ServeTLS(
 	logger.Info(fmt.Sprintf("Starting RPC HTTPS server on %s (cert: %q, key: %q)",
 		listener.Addr(), certFile, keyFile))
 	s := &http.Server{
-		Handler:        RecoverAndLogHandler(maxBytesHandler{h: handler, n: config.MaxBodyBytes}, logger),
+		Handler:        wrapHandler(handler, logger, config),
 		ReadTimeout:    config.ReadTimeout,
 		WriteTimeout:   config.WriteTimeout,
 		MaxHeaderBytes: config.MaxHeaderBytes,
 	}
+	if config.ClientCAs != nil {
+		s.TLSConfig = &tls.Config{
+			ClientAuth: tls.VerifyClientCertIfGiven,
+			ClientCAs:  config.ClientCAs,
+		}
+		if config.RequireClientCert {
+			s.TLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
 	err := s.ServeTLS(listener, certFile, keyFile)
 
 	logger.Error("RPC HTTPS server stopped", "err", err)
 	return err
 }
 
+func wrapHandler(handler http.Handler, logger log.Logger, config *Config) http.Handler {
+	return RecoverAndLogHandler(
+		config.AccessControl.handler(maxBytesHandler{h: handler, n: config.MaxBodyBytes}, logger),
+		logger,
+	)
+}
+
 // WriteRPCResponseHTTPError marshals res as JSON (with indent) and writes it
 // to w.
 //
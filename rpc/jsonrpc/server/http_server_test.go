@@ -2,6 +2,7 @@ package server
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -182,3 +183,36 @@ func TestWriteRPCResponseHTTPError(t *testing.T) {
   }
 }`, string(body))
 }
+
+func TestServeTLSRequireClientCert(t *testing.T) {
+	ln, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "some body")
+	})
+
+	pem, err := ioutil.ReadFile("test.crt")
+	require.NoError(t, err)
+	config := DefaultConfig()
+	config.ClientCAs = x509.NewCertPool()
+	require.True(t, config.ClientCAs.AppendCertsFromPEM(pem))
+	config.RequireClientCert = true
+
+	go func() {
+		_ = ServeTLS(ln, mux, "test.crt", "test.key", log.TestingLogger(), config)
+	}()
+
+	// a client without a certificate is rejected during the handshake
+	tr := &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	c := &http.Client{Transport: tr}
+	res, err := c.Get("https://" + ln.Addr().String())
+	if err == nil {
+		res.Body.Close()
+	}
+	require.Error(t, err)
+}
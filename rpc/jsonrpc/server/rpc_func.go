@@ -16,7 +16,7 @@ import (
 func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, logger log.Logger) {
 	// HTTP endpoints
 	for funcName, rpcFunc := range funcMap {
-		mux.HandleFunc("/"+funcName, makeHTTPHandler(rpcFunc, logger))
+		mux.HandleFunc("/"+funcName, makeHTTPHandler(funcName, rpcFunc, logger))
 	}
 
 	// JSONRPC endpoints
@@ -32,31 +32,49 @@ type RPCFunc struct {
 	returns  []reflect.Type // type of each return arg
 	argNames []string       // name of each argument
 	ws       bool           // websocket only
+
+	unverified bool // results are marked as unverified
+}
+
+// Option is an option of RPCFunc.
+type Option func(*RPCFunc)
+
+// Unverified marks the results of the function as unverified, so that the
+// responses with them have the "unverified" field set. It is used e.g. by the
+// light client proxy for the routes, whose results it can't verify.
+func Unverified() Option {
+	return func(f *RPCFunc) {
+		f.unverified = true
+	}
 }
 
 // NewRPCFunc wraps a function for introspection.
 // f is the function, args are comma separated argument names
-func NewRPCFunc(f interface{}, args string) *RPCFunc {
-	return newRPCFunc(f, args, false)
+func NewRPCFunc(f interface{}, args string, options ...Option) *RPCFunc {
+	return newRPCFunc(f, args, false, options...)
 }
 
 // NewWSRPCFunc wraps a function for introspection and use in the websockets.
-func NewWSRPCFunc(f interface{}, args string) *RPCFunc {
-	return newRPCFunc(f, args, true)
+func NewWSRPCFunc(f interface{}, args string, options ...Option) *RPCFunc {
+	return newRPCFunc(f, args, true, options...)
 }
 
-func newRPCFunc(f interface{}, args string, ws bool) *RPCFunc {
+func newRPCFunc(f interface{}, args string, ws bool, options ...Option) *RPCFunc {
 	var argNames []string
 	if args != "" {
 		argNames = strings.Split(args, ",")
 	}
-	return &RPCFunc{
+	rf := &RPCFunc{
 		f:        reflect.ValueOf(f),
 		args:     funcArgTypes(f),
 		returns:  funcReturnTypes(f),
 		argNames: argNames,
 		ws:       ws,
 	}
+	for _, opt := range options {
+		opt(rf)
+	}
+	return rf
 }
 
 // return a function's argument types
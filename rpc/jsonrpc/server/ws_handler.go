@@ -86,6 +86,7 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := newWSConnection(wsConn, wm.funcMap, wm.wsConnOptions...)
+	con.client = clientFromContext(r.Context())
 	con.SetLogger(wm.logger.With("remote", wsConn.RemoteAddr()))
 	wm.logger.Info("New websocket connection", "remote", con.remoteAddr)
 	err = con.Start() // BLOCKING
@@ -118,6 +119,9 @@ type wsConnection struct {
 
 	funcMap map[string]*RPCFunc
 
+	// authorizes requests; nil if there is no access control
+	client *client
+
 	// write channel capacity
 	writeChanCapacity int
 
@@ -362,6 +366,12 @@ func (wsc *wsConnection) readRoutine() {
 				}
 				continue
 			}
+			if err := wsc.client.authorize(request.Method); err != nil {
+				if err := wsc.WriteRPCResponse(writeCtx, types.RPCInvalidRequestError(request.ID, err)); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
 
 			ctx := &types.Context{JSONReq: &request, WSConn: wsc}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
@@ -391,7 +401,9 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
-			if err := wsc.WriteRPCResponse(writeCtx, types.NewRPCSuccessResponse(request.ID, result)); err != nil {
+			res := types.NewRPCSuccessResponse(request.ID, result)
+			res.Unverified = rpcFunc.unverified
+			if err := wsc.WriteRPCResponse(writeCtx, res); err != nil {
 				wsc.Logger.Error("Error writing RPC response", "err", err)
 			}
 		}
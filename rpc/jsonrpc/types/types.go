@@ -156,6 +156,9 @@ type RPCResponse struct {
 	ID      jsonrpcid       `json:"id,omitempty"`
 	Result  json.RawMessage `json:"result,omitempty"`
 	Error   *RPCError       `json:"error,omitempty"`
+	// Unverified is set if the server couldn't verify the result, e.g. when
+	// a light client proxy passes it through from a full node.
+	Unverified bool `json:"unverified,omitempty"`
 }
 
 // UnmarshalJSON custom JSON unmarshalling due to jsonrpcid being string or int
@@ -165,6 +168,8 @@ func (resp *RPCResponse) UnmarshalJSON(data []byte) error {
 		ID      interface{}     `json:"id,omitempty"`
 		Result  json.RawMessage `json:"result,omitempty"`
 		Error   *RPCError       `json:"error,omitempty"`
+
+		Unverified bool `json:"unverified,omitempty"`
 	}{}
 	err := json.Unmarshal(data, &unsafeResp)
 	if err != nil {
@@ -173,6 +178,7 @@ func (resp *RPCResponse) UnmarshalJSON(data []byte) error {
 	resp.JSONRPC = unsafeResp.JSONRPC
 	resp.Error = unsafeResp.Error
 	resp.Result = unsafeResp.Result
+	resp.Unverified = unsafeResp.Unverified
 	if unsafeResp.ID == nil {
 		return nil
 	}
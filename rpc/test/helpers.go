@@ -114,6 +114,11 @@ func GetGRPCClient() core_grpc.BroadcastAPIClient {
 	return core_grpc.StartGRPCClient(grpcAddr)
 }
 
+func GetGRPCFullClient() *core_grpc.Client {
+	grpcAddr := globalConfig.RPC.GRPCListenAddress
+	return core_grpc.StartGRPCFullClient(grpcAddr)
+}
+
 // StartTendermint starts a test tendermint server in a go routine and returns when it is initialized
 func StartTendermint(app abci.Application, opts ...func(*Options)) *nm.Node {
 	nodeOpts := defaultOptions
@@ -48,8 +48,24 @@ type (
 	ErrNoABCIResponsesForHeight struct {
 		Height int64
 	}
+
+	// ErrInvalidChainLock is returned when the chain lock of a block is
+	// invalid, which the proposer of the block can be punished for.
+	ErrInvalidChainLock struct {
+		Reason error
+	}
+
+	// ErrHalted is returned when a block is validated after the height the
+	// chain is halted at, as requested by the application.
+	ErrHalted struct {
+		Height int64
+	}
 )
 
+func (e ErrHalted) Error() string {
+	return fmt.Sprintf("the chain is halted after height %d", e.Height)
+}
+
 func (e ErrUnknownBlock) Error() string {
 	return fmt.Sprintf("could not find block #%d", e.Height)
 }
@@ -99,3 +115,11 @@ func (e ErrNoConsensusParamsForHeight) Error() string {
 func (e ErrNoABCIResponsesForHeight) Error() string {
 	return fmt.Sprintf("could not find results for height #%d", e.Height)
 }
+
+func (e ErrInvalidChainLock) Error() string {
+	return fmt.Sprintf("invalid chain lock: %v", e.Reason)
+}
+
+func (e ErrInvalidChainLock) Unwrap() error {
+	return e.Reason
+}
@@ -49,6 +49,9 @@ type BlockExecutor struct {
 	metrics *Metrics
 
 	appHashSize int
+
+	// deliver the txs of a block to the app in a single DeliverBatch call
+	deliverBatch bool
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -66,6 +69,15 @@ func BlockExecutorWithAppHashSize(size int) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithDeliverBatch makes the block executor deliver the txs of
+// a block in a single DeliverBatch call, instead of a DeliverTx call per tx.
+// The app must support it, see ResponseInfo.DeliverBatch.
+func BlockExecutorWithDeliverBatch(deliverBatch bool) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.deliverBatch = deliverBatch
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -216,7 +228,7 @@ func (blockExec *BlockExecutor) ApplyBlockWithLogger(
 
 	startTime := time.Now().UnixNano()
 	abciResponses, err := execBlockOnProxyApp(
-		logger, blockExec.proxyApp, block, blockExec.store, state.InitialHeight,
+		logger, blockExec.proxyApp, block, blockExec.store, state.InitialHeight, blockExec.deliverBatch,
 	)
 	endTime := time.Now().UnixNano()
 	blockExec.metrics.BlockProcessingTime.Observe(float64(endTime-startTime) / 1000000)
@@ -370,13 +382,16 @@ func (blockExec *BlockExecutor) Commit(
 // Helper functions for executing blocks and updating state
 
 // Executes block's transactions on proxyAppConn.
-// Returns a list of transaction results and updates to the validator set
+// Returns a list of transaction results and updates to the validator set.
+// If deliverBatch is set, the transactions are delivered in a single
+// DeliverBatch call.
 func execBlockOnProxyApp(
 	logger log.Logger,
 	proxyAppConn proxy.AppConnConsensus,
 	block *types.Block,
 	store Store,
 	initialHeight int64,
+	deliverBatch bool,
 ) (*tmstate.ABCIResponses, error) {
 	var validTxs, invalidTxs = 0, 0
 
@@ -386,21 +401,23 @@ func execBlockOnProxyApp(
 	abciResponses.DeliverTxs = dtxs
 
 	// Execute transactions and get hash.
+	recordTx := func(txRes *abci.ResponseDeliverTx) {
+		// TODO: make use of res.Log
+		// TODO: make use of this info
+		// Blocks may include invalid txs.
+		if txRes.Code == abci.CodeTypeOK {
+			validTxs++
+		} else {
+			logger.Debug("invalid tx", "code", txRes.Code, "log", txRes.Log)
+			invalidTxs++
+		}
+
+		abciResponses.DeliverTxs[txIndex] = txRes
+		txIndex++
+	}
 	proxyCb := func(req *abci.Request, res *abci.Response) {
 		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
-			// TODO: make use of res.Log
-			// TODO: make use of this info
-			// Blocks may include invalid txs.
-			txRes := r.DeliverTx
-			if txRes.Code == abci.CodeTypeOK {
-				validTxs++
-			} else {
-				logger.Debug("invalid tx", "code", txRes.Code, "log", txRes.Log)
-				invalidTxs++
-			}
-
-			abciResponses.DeliverTxs[txIndex] = txRes
-			txIndex++
+			recordTx(r.DeliverTx)
 		}
 	}
 	proxyAppConn.SetResponseCallback(proxyCb)
@@ -436,11 +453,35 @@ func execBlockOnProxyApp(
 	}
 
 	// run txs of block
-	for _, tx := range block.Txs {
-		proxyAppConn.DeliverTxAsync(abci.RequestDeliverTx{Tx: tx})
-		if err := proxyAppConn.Error(); err != nil {
+	if deliverBatch {
+		req := abci.RequestDeliverBatch{Txs: make([]abci.RequestDeliverTx, len(block.Txs))}
+		for i, tx := range block.Txs {
+			req.Txs[i] = abci.RequestDeliverTx{Tx: tx}
+		}
+		res, err := proxyAppConn.DeliverBatchSync(req)
+		if err != nil {
+			logger.Error("error in proxyAppConn.DeliverBatch", "err", err)
 			return nil, err
 		}
+		if res == nil {
+			return nil, errors.New("nil response from DeliverBatch")
+		}
+		if len(res.Txs) != len(block.Txs) {
+			return nil, fmt.Errorf("expected %d tx results from DeliverBatch, got %d", len(block.Txs), len(res.Txs))
+		}
+		for i, txRes := range res.Txs {
+			if txRes == nil {
+				return nil, fmt.Errorf("nil result of tx %d from DeliverBatch", i)
+			}
+			recordTx(txRes)
+		}
+	} else {
+		for _, tx := range block.Txs {
+			proxyAppConn.DeliverTxAsync(abci.RequestDeliverTx{Tx: tx})
+			if err := proxyAppConn.Error(); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// End block.
@@ -581,7 +622,11 @@ func updateState(
 			return state, fmt.Errorf("error updating consensus params: %v", err)
 		}
 
-		state.Version.Consensus.App = nextParams.Version.AppVersion
+		// the app version activated by the validators or by resuming the halted
+		// chain is only overridden by the application explicitly
+		if abciResponses.EndBlock.ConsensusParamUpdates.Version != nil {
+			state.Version.Consensus.App = nextParams.Version.AppVersion
+		}
 
 		// Change results from this height but only applies to the next height.
 		lastHeightParamsChanged = header.Height + 1
@@ -589,6 +634,22 @@ func updateState(
 
 	nextVersion := state.Version
 
+	// Activate the app version proposed by enough validators.
+	appVersionProposals, appVersion := updateAppVersionProposals(
+		state.AppVersionProposals, header, nextVersion.Consensus.App, state.Validators)
+	if appVersion > 0 {
+		nextVersion.Consensus.App = appVersion
+	}
+
+	// Halt after the height requested by the application.
+	haltHeight := state.HaltHeight
+	if h := abciResponses.EndBlock.HaltHeight; h != 0 {
+		if h < header.Height {
+			return state, fmt.Errorf("halt height %d is below the height %d of the block", h, header.Height)
+		}
+		haltHeight = h
+	}
+
 	// NOTE: the AppHash has not been populated.
 	// It will be filled on state.Save.
 	return State{
@@ -608,6 +669,8 @@ func updateState(
 		LastHeightConsensusParamsChanged: lastHeightParamsChanged,
 		LastResultsHash:                  ABCIResponsesResultsHash(abciResponses),
 		AppHash:                          nil,
+		HaltHeight:                       haltHeight,
+		AppVersionProposals:              appVersionProposals,
 	}, nil
 }
 
@@ -679,8 +742,9 @@ func ExecCommitBlock(
 	logger log.Logger,
 	store Store,
 	initialHeight int64,
+	deliverBatch bool,
 ) ([]byte, error) {
-	_, err := execBlockOnProxyApp(logger, appConnConsensus, block, store, initialHeight)
+	_, err := execBlockOnProxyApp(logger, appConnConsensus, block, store, initialHeight, deliverBatch)
 	if err != nil {
 		logger.Error("failed executing block on proxy app", "height", block.Height, "err", err)
 		return nil, err
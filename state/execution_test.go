@@ -13,13 +13,18 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/abci/example/kvstore"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
 
 	"github.com/tendermint/tendermint/libs/log"
 	mmock "github.com/tendermint/tendermint/mempool/mock"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/proxy"
+	proxymocks "github.com/tendermint/tendermint/proxy/mocks"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/state/mocks"
 	"github.com/tendermint/tendermint/types"
@@ -68,6 +73,141 @@ func TestApplyBlock(t *testing.T) {
 	assert.EqualValues(t, 1, state.Version.Consensus.App, "App version wasn't updated")
 }
 
+// TestApplyBlockDeliverBatch ensures the txs delivered in a batch have the
+// same results as the txs delivered one by one.
+func TestApplyBlockDeliverBatch(t *testing.T) {
+	state, _, _ := makeState(1, 1)
+	nodeProTxHash := &state.Validators.Validators[0].ProTxHash
+	block := makeBlock(state, 1)
+	require.Len(t, block.Txs, nTxsPerBlock)
+	blockID := types.BlockID{
+		Hash:          block.Hash(),
+		PartSetHeader: block.MakePartSet(testPartSize).Header(),
+	}
+
+	var (
+		responses   = make(map[bool]*tmstate.ABCIResponses)
+		resultsHash = make(map[bool][]byte)
+	)
+	for _, deliverBatch := range []bool{false, true} {
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(&batchKVStore{Application: kvstore.NewApplication()}))
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		stateStore := sm.NewStore(dbm.NewMemDB())
+		require.NoError(t, stateStore.Save(state))
+		blockExec := sm.NewBlockExecutor(
+			stateStore,
+			log.TestingLogger(),
+			proxyApp.Consensus(),
+			proxyApp.Query(),
+			mmock.Mempool{},
+			sm.EmptyEvidencePool{},
+			nil,
+			sm.BlockExecutorWithDeliverBatch(deliverBatch),
+		)
+
+		newState, _, err := blockExec.ApplyBlock(state, nodeProTxHash, blockID, block)
+		require.NoError(t, err)
+		responses[deliverBatch], err = stateStore.LoadABCIResponses(1)
+		require.NoError(t, err)
+		resultsHash[deliverBatch] = newState.LastResultsHash
+	}
+
+	require.Len(t, responses[true].DeliverTxs, nTxsPerBlock)
+	assert.Equal(t, responses[false], responses[true])
+	assert.Equal(t, resultsHash[false], resultsHash[true])
+}
+
+// TestApplyBlockDeliverBatchInvalidResults ensures the block is rejected if
+// the results of DeliverBatch don't match the txs of the block.
+func TestApplyBlockDeliverBatchInvalidResults(t *testing.T) {
+	testCases := map[string]func(res *abci.ResponseDeliverBatch){
+		"missing result": func(res *abci.ResponseDeliverBatch) {
+			res.Txs = res.Txs[:len(res.Txs)-1]
+		},
+		"extra result": func(res *abci.ResponseDeliverBatch) {
+			res.Txs = append(res.Txs, &abci.ResponseDeliverTx{})
+		},
+		"nil result": func(res *abci.ResponseDeliverBatch) {
+			res.Txs[1] = nil
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			app := &batchKVStore{Application: kvstore.NewApplication(), mutate: tc}
+			proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+			require.NoError(t, proxyApp.Start())
+			defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+			state, stateDB, _ := makeState(1, 1)
+			nodeProTxHash := &state.Validators.Validators[0].ProTxHash
+			stateStore := sm.NewStore(stateDB)
+			blockExec := sm.NewBlockExecutor(
+				stateStore,
+				log.TestingLogger(),
+				proxyApp.Consensus(),
+				proxyApp.Query(),
+				mmock.Mempool{},
+				sm.EmptyEvidencePool{},
+				nil,
+				sm.BlockExecutorWithDeliverBatch(true),
+			)
+
+			block := makeBlock(state, 1)
+			blockID := types.BlockID{
+				Hash:          block.Hash(),
+				PartSetHeader: block.MakePartSet(testPartSize).Header(),
+			}
+			_, _, err := blockExec.ApplyBlock(state, nodeProTxHash, blockID, block)
+			require.Error(t, err)
+		})
+	}
+}
+
+// TestExecCommitBlockDeliverBatch ensures a block replayed with DeliverBatch
+// results in the same app hash as a block replayed with DeliverTx.
+func TestExecCommitBlockDeliverBatch(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	block := makeBlock(state, 1)
+
+	appHashes := make(map[bool][]byte)
+	for _, deliverBatch := range []bool{false, true} {
+		proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(&batchKVStore{Application: kvstore.NewApplication()}))
+		require.NoError(t, proxyApp.Start())
+		defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+		appHash, err := sm.ExecCommitBlock(
+			proxyApp.Consensus(), block, log.TestingLogger(), stateStore, state.InitialHeight, deliverBatch,
+		)
+		require.NoError(t, err)
+		appHashes[deliverBatch] = appHash
+	}
+
+	assert.NotEmpty(t, appHashes[true])
+	assert.Equal(t, appHashes[false], appHashes[true])
+}
+
+// TestExecCommitBlockDeliverBatchNilResponse ensures a nil response of
+// DeliverBatch is rejected.
+func TestExecCommitBlockDeliverBatchNilResponse(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	block := makeBlock(state, 1)
+
+	conn := &proxymocks.AppConnConsensus{}
+	conn.On("SetResponseCallback", mock.Anything).Return()
+	conn.On("BeginBlockSync", mock.Anything).Return(&abci.ResponseBeginBlock{}, nil)
+	conn.On("DeliverBatchSync", mock.Anything).Return(nil, nil)
+
+	_, err := sm.ExecCommitBlock(conn, block, log.TestingLogger(), stateStore, state.InitialHeight, true)
+	require.Error(t, err)
+	conn.AssertNotCalled(t, "EndBlockSync", mock.Anything)
+	conn.AssertNotCalled(t, "CommitSync")
+}
+
 // TestBeginBlockByzantineValidators ensures we send byzantine validators list.
 func TestBeginBlockByzantineValidators(t *testing.T) {
 	app := &testApp{}
@@ -473,3 +613,25 @@ func makeStateID(lastAppHash []byte) types.StateID {
 		LastAppHash: h,
 	}
 }*/
+
+// batchKVStore is a kvstore, which delivers the txs of a block at once. The
+// results may be altered by mutate.
+type batchKVStore struct {
+	*kvstore.Application
+
+	mutate func(res *abci.ResponseDeliverBatch)
+}
+
+var _ abci.BatchApplication = (*batchKVStore)(nil)
+
+func (app *batchKVStore) DeliverBatch(req abci.RequestDeliverBatch) abci.ResponseDeliverBatch {
+	res := abci.ResponseDeliverBatch{Txs: make([]*abci.ResponseDeliverTx, len(req.Txs))}
+	for i, tx := range req.Txs {
+		txRes := app.Application.DeliverTx(tx)
+		res.Txs[i] = &txRes
+	}
+	if app.mutate != nil {
+		app.mutate(&res)
+	}
+	return res
+}
@@ -19,4 +19,8 @@ type BlockIndexer interface {
 	// Search performs a query for block heights that match a given BeginBlock
 	// and Endblock event search criteria.
 	Search(ctx context.Context, q *query.Query) ([]int64, error)
+
+	// Prune removes the blocks below retainHeight and their events, and
+	// returns the number of removed blocks.
+	Prune(retainHeight int64) (uint64, error)
 }
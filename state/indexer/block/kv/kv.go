@@ -77,11 +77,142 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 	return batch.WriteSync()
 }
 
+// Prune removes the blocks below retainHeight and their events, and returns
+// the number of removed blocks. The lowest height which isn't pruned yet is
+// stored, so that only the heights in [base, retainHeight) are visited, using
+// the keys indexing the events by height.
+func (idx *BlockerIndexer) Prune(retainHeight int64) (uint64, error) {
+	bz, err := idx.store.Get(retainHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if bz == nil {
+		return idx.pruneAll(retainHeight)
+	}
+	base := int64FromBytes(bz)
+	if base >= retainHeight {
+		return 0, nil
+	}
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	// the events of the pruned heights
+	start, err := heightEventsKey(base, "")
+	if err != nil {
+		return 0, err
+	}
+	end, err := heightEventsKey(retainHeight, "")
+	if err != nil {
+		return 0, err
+	}
+	if err := idx.deleteRange(batch, start, end, func(key []byte) error {
+		_, eventKey, err := parseHeightEventsKey(key)
+		if err != nil {
+			return err
+		}
+		return batch.Delete([]byte(eventKey))
+	}); err != nil {
+		return 0, err
+	}
+
+	// and the pruned heights themselves
+	if start, err = heightKey(base); err != nil {
+		return 0, err
+	}
+	if end, err = heightKey(retainHeight); err != nil {
+		return 0, err
+	}
+	var pruned uint64
+	if err := idx.deleteRange(batch, start, end, func([]byte) error {
+		pruned++
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := batch.Set(retainHeightKey, int64ToBytes(retainHeight)); err != nil {
+		return 0, err
+	}
+	return pruned, batch.WriteSync()
+}
+
+// deleteRange deletes the keys in [start, end) with the batch, calling fn for
+// every key.
+func (idx *BlockerIndexer) deleteRange(batch dbm.Batch, start, end []byte, fn func(key []byte) error) error {
+	it, err := idx.store.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key()); err != nil {
+			return err
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// pruneAll prunes an index, which has never been pruned, by scanning it
+// whole; every key stores the height it belongs to. The events indexed before
+// the events were indexed by height are indexed by height as well, so that
+// this is only needed once.
+func (idx *BlockerIndexer) pruneAll(retainHeight int64) (uint64, error) {
+	it, err := idx.store.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	var pruned uint64
+	for ; it.Valid(); it.Next() {
+		height := int64FromBytes(it.Value())
+		switch {
+		case height < retainHeight:
+			if err := batch.Delete(it.Key()); err != nil {
+				return 0, err
+			}
+			if isHeightKey(it.Key()) {
+				pruned++
+			}
+
+		case !isHeightKey(it.Key()) && !isHeightEventsKey(it.Key()):
+			key, err := heightEventsKey(height, string(it.Key()))
+			if err != nil {
+				return 0, err
+			}
+			if err := batch.Set(key, it.Value()); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return 0, err
+	}
+
+	if err := batch.Set(retainHeightKey, int64ToBytes(retainHeight)); err != nil {
+		return 0, err
+	}
+	return pruned, batch.WriteSync()
+}
+
 // Search performs a query for block heights that match a given BeginBlock
 // and Endblock event search criteria. The given query can match against zero,
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// Conditions combined with OR are matched separately and their results are
+// merged. Conditions under NOT are matched as usual and their results are
+// removed from the results of the other operands of the enclosing AND, or from
+// all indexed heights if there are none.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -91,11 +222,153 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions, err := q.Conditions()
+	expr, err := q.Expression()
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
 	}
 
+	filteredHeights, err := idx.matchExpr(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			results = append(results, h)
+		}
+
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// matchExpr returns all heights that match the given query expression.
+func (idx *BlockerIndexer) matchExpr(ctx context.Context, expr *query.Expr) (map[string][]byte, error) {
+	switch expr.Op {
+	case query.ExprCondition:
+		return idx.matchConditions(ctx, []query.Condition{expr.Condition})
+
+	case query.ExprAnd:
+		// Match all conditions of the conjunction at once, so that ranges and
+		// heights are handled efficiently, then narrow the result down with the
+		// remaining operands.
+		var (
+			conditions []query.Condition
+			operands   []*query.Expr
+			negations  []*query.Expr
+		)
+		for _, operand := range expr.Operands {
+			switch operand.Op {
+			case query.ExprCondition:
+				conditions = append(conditions, operand.Condition)
+			case query.ExprNot:
+				negations = append(negations, operand.Operands[0])
+			default:
+				operands = append(operands, operand)
+			}
+		}
+
+		var (
+			filteredHeights map[string][]byte
+			err             error
+		)
+		if len(conditions) > 0 {
+			filteredHeights, err = idx.matchConditions(ctx, conditions)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, operand := range operands {
+			if filteredHeights != nil && len(filteredHeights) == 0 {
+				return filteredHeights, nil
+			}
+
+			tmpHeights, err := idx.matchExpr(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+			if filteredHeights == nil {
+				filteredHeights = tmpHeights
+				continue
+			}
+			for k := range filteredHeights {
+				if tmpHeights[k] == nil {
+					delete(filteredHeights, k)
+				}
+			}
+		}
+
+		if filteredHeights == nil {
+			filteredHeights, err = idx.matchAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, operand := range negations {
+			if len(filteredHeights) == 0 {
+				break
+			}
+
+			excluded, err := idx.matchExpr(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+			for k := range excluded {
+				delete(filteredHeights, k)
+			}
+		}
+		return filteredHeights, nil
+
+	case query.ExprOr:
+		filteredHeights := make(map[string][]byte)
+		for _, operand := range expr.Operands {
+			tmpHeights, err := idx.matchExpr(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range tmpHeights {
+				filteredHeights[k] = v
+			}
+		}
+		return filteredHeights, nil
+
+	case query.ExprNot:
+		filteredHeights, err := idx.matchAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		excluded, err := idx.matchExpr(ctx, expr.Operands[0])
+		if err != nil {
+			return nil, err
+		}
+		for k := range excluded {
+			delete(filteredHeights, k)
+		}
+		return filteredHeights, nil
+	}
+
+	return nil, fmt.Errorf("unknown query expression operator %v", expr.Op)
+}
+
+// matchConditions returns all heights that match all of the given conditions.
+func (idx *BlockerIndexer) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
 	// If there is an exact height query, return the result immediately
 	// (if it exists).
 	height, ok := lookForHeight(conditions)
@@ -105,11 +378,13 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 			return nil, err
 		}
 
+		heights := make(map[string][]byte)
 		if ok {
-			return []int64{height}, nil
+			heightBz := int64ToBytes(height)
+			heights[string(heightBz)] = heightBz
 		}
 
-		return results, nil
+		return heights, nil
 	}
 
 	var heightsInitialized bool
@@ -184,30 +459,37 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
+	return filteredHeights, nil
+}
 
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			results = append(results, h)
-		}
+// matchAll returns all indexed heights. It is used to evaluate NOT
+// expressions which are not narrowed down by any other operand.
+func (idx *BlockerIndexer) matchAll(ctx context.Context) (map[string][]byte, error) {
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
 
-		select {
-		case <-ctx.Done():
-			break
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix iterator: %w", err)
+	}
+	defer it.Close()
 
-		default:
+	heights := make(map[string][]byte)
+	for ; it.Valid(); it.Next() {
+		heights[string(it.Value())] = it.Value()
+
+		if err := ctx.Err(); err != nil {
+			break
 		}
 	}
 
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
 
-	return results, nil
+	return heights, nil
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
@@ -482,6 +764,15 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, typ
 				if err := batch.Set(key, heightBz); err != nil {
 					return err
 				}
+
+				// index the event by height too, for pruning
+				key, err = heightEventsKey(height, string(key))
+				if err != nil {
+					return fmt.Errorf("failed to create block index key: %w", err)
+				}
+				if err := batch.Set(key, heightBz); err != nil {
+					return err
+				}
 			}
 		}
 	}
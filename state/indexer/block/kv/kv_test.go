@@ -128,6 +128,22 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustParse("begin_event.proposer CONTAINS 'FCAA001'"),
 			results: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
 		},
+		"end_event.foo <= 5 OR end_event.foo >= 100": {
+			q:       query.MustParse("end_event.foo <= 5 OR end_event.foo >= 100"),
+			results: []int64{1, 2, 4},
+		},
+		"begin_event.proposer = 'FCAA001' AND NOT end_event.foo EXISTS": {
+			q:       query.MustParse("begin_event.proposer = 'FCAA001' AND NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"NOT (end_event.foo > 2 OR block.height = 3)": {
+			q:       query.MustParse("NOT (end_event.foo > 2 OR block.height = 3)"),
+			results: []int64{2, 5, 7, 9, 11},
+		},
+		"block.height < 5 AND (end_event.foo = 4 OR NOT end_event.foo EXISTS)": {
+			q:       query.MustParse("block.height < 5 AND (end_event.foo = 4 OR NOT end_event.foo EXISTS)"),
+			results: []int64{3, 4},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -139,3 +155,87 @@ func TestBlockIndexer(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockIndexerPrune(t *testing.T) {
+	store := &scanCountingDB{DB: db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))}
+	indexer := blockidxkv.New(store)
+
+	index := func(from, to int) {
+		for i := from; i <= to; i++ {
+			require.NoError(t, indexer.Index(types.EventDataNewBlockHeader{
+				Header: types.Header{Height: int64(i)},
+				ResultEndBlock: abci.ResponseEndBlock{
+					Events: []abci.Event{
+						{
+							Type: "end_event",
+							Attributes: []abci.EventAttribute{
+								{
+									Key:   []byte("foo"),
+									Value: []byte(fmt.Sprintf("%d", i)),
+									Index: true,
+								},
+							},
+						},
+					},
+				},
+			}))
+		}
+	}
+	index(1, 5)
+
+	pruned, err := indexer.Prune(4)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+
+	for i := int64(1); i <= 5; i++ {
+		ok, err := indexer.Has(i)
+		require.NoError(t, err)
+		require.Equal(t, i >= 4, ok, i)
+	}
+
+	results, err := indexer.Search(context.Background(), query.MustParse("end_event.foo >= 1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5}, results)
+
+	// only the index which has never been pruned is scanned whole
+	require.Equal(t, 1, store.scans)
+	index(6, 8)
+	pruned, err = indexer.Prune(7)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+	require.Equal(t, 1, store.scans)
+
+	// lower retain heights are ignored
+	pruned, err = indexer.Prune(5)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	results, err = indexer.Search(context.Background(), query.MustParse("end_event.foo >= 1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 8}, results)
+
+	// the event keys of pruned blocks are removed as well: 2 blocks are left,
+	// with their height key, an event key and its key by height, along with
+	// the retain height
+	it, err := store.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	var keys int
+	for ; it.Valid(); it.Next() {
+		keys++
+	}
+	require.Equal(t, 2*3+1, keys)
+}
+
+// scanCountingDB counts the iterations over the whole store.
+type scanCountingDB struct {
+	db.DB
+	scans int
+}
+
+func (s *scanCountingDB) Iterator(start, end []byte) (db.Iterator, error) {
+	if start == nil && end == nil {
+		s.scans++
+	}
+	return s.DB.Iterator(start, end)
+}
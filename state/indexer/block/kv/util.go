@@ -39,6 +39,54 @@ func heightKey(height int64) ([]byte, error) {
 	)
 }
 
+func isHeightKey(key []byte) bool {
+	var (
+		compositeKey string
+		height       int64
+	)
+
+	remaining, err := orderedcode.Parse(string(key), &compositeKey, &height)
+	return err == nil && len(remaining) == 0 && compositeKey == types.BlockHeightKey
+}
+
+// retainHeightKey stores the lowest height which hasn't been pruned. Unlike
+// other keys, it isn't encoded with orderedcode.
+var retainHeightKey = []byte("block.retain_height")
+
+// heightEventsPrefix prefixes the keys indexing the event keys by height. It
+// contains a space, so that it can't be the composite key of an event.
+const heightEventsPrefix = "block.height events"
+
+func heightEventsKey(height int64, eventKey string) ([]byte, error) {
+	return orderedcode.Append(
+		nil,
+		heightEventsPrefix,
+		height,
+		eventKey,
+	)
+}
+
+func parseHeightEventsKey(key []byte) (int64, string, error) {
+	var (
+		prefix, eventKey string
+		height           int64
+	)
+
+	remaining, err := orderedcode.Parse(string(key), &prefix, &height, &eventKey)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to parse height events key: %w", err)
+	}
+	if len(remaining) != 0 || prefix != heightEventsPrefix {
+		return 0, "", fmt.Errorf("unexpected height events key: %X", key)
+	}
+	return height, eventKey, nil
+}
+
+func isHeightEventsKey(key []byte) bool {
+	_, _, err := parseHeightEventsKey(key)
+	return err == nil
+}
+
 func eventKey(compositeKey, typ, eventValue string, height int64) ([]byte, error) {
 	return orderedcode.Append(
 		nil,
@@ -0,0 +1,57 @@
+package sqlsink
+
+// schema creates the tables of the event sink. It only uses statements and
+// types supported by both PostgreSQL and SQLite, and can be applied to a
+// database which already contains the tables.
+//
+// Block events are stored with tx_index 0 and source "begin_block" or
+// "end_block", transaction events with source "tx". Attribute values are
+// stored as text and, depending on value_type, also as numeric_value ("int"
+// and "float") or time_value ("time").
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS blocks (
+		height BIGINT NOT NULL PRIMARY KEY,
+		chain_id VARCHAR NOT NULL,
+		time TIMESTAMP NOT NULL,
+		num_txs BIGINT NOT NULL,
+		proposer_pro_tx_hash VARCHAR NOT NULL,
+		core_chain_locked_height BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS tx_results (
+		height BIGINT NOT NULL,
+		tx_index BIGINT NOT NULL,
+		tx_hash VARCHAR NOT NULL,
+		code BIGINT NOT NULL,
+		codespace VARCHAR NOT NULL,
+		gas_wanted BIGINT NOT NULL,
+		gas_used BIGINT NOT NULL,
+		tx_result BYTEA NOT NULL,
+		PRIMARY KEY (height, tx_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_tx_results_tx_hash ON tx_results (tx_hash)`,
+	`CREATE TABLE IF NOT EXISTS events (
+		height BIGINT NOT NULL,
+		source VARCHAR NOT NULL,
+		tx_index BIGINT NOT NULL,
+		event_index BIGINT NOT NULL,
+		type VARCHAR NOT NULL,
+		PRIMARY KEY (height, source, tx_index, event_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_events_type ON events (type)`,
+	`CREATE TABLE IF NOT EXISTS attributes (
+		height BIGINT NOT NULL,
+		source VARCHAR NOT NULL,
+		tx_index BIGINT NOT NULL,
+		event_index BIGINT NOT NULL,
+		attribute_index BIGINT NOT NULL,
+		key VARCHAR NOT NULL,
+		composite_key VARCHAR NOT NULL,
+		value VARCHAR NOT NULL,
+		value_type VARCHAR NOT NULL,
+		numeric_value DOUBLE PRECISION,
+		time_value TIMESTAMP,
+		indexed BOOLEAN NOT NULL,
+		PRIMARY KEY (height, source, tx_index, event_index, attribute_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS idx_attributes_composite_key ON attributes (composite_key, value)`,
+}
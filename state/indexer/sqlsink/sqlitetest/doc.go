@@ -0,0 +1,6 @@
+// Package sqlitetest tests the SQL event sink against SQLite.
+//
+// It's a separate module, so that the SQLite driver, which only the tests
+// use, isn't a dependency of Tendermint. Run the tests from this directory
+// with "go test ./...".
+package sqlitetest
@@ -0,0 +1,12 @@
+module github.com/tendermint/tendermint/state/indexer/sqlsink/sqlitetest
+
+go 1.15
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.7
+	github.com/stretchr/testify v1.7.0
+	github.com/tendermint/tendermint v0.0.0
+	github.com/tendermint/tm-db v0.6.4
+)
+
+replace github.com/tendermint/tendermint => ../../../../
@@ -0,0 +1,214 @@
+package sqlitetest_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
+	"github.com/tendermint/tendermint/state/indexer/sqlsink"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/types"
+)
+
+const chainID = "test-chain"
+
+func openSink(t *testing.T) *sqlsink.EventSink {
+	sink, err := sqlsink.Open("sqlite3", filepath.Join(t.TempDir(), "events.db"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { sink.Close() })
+	return sink
+}
+
+func makeBlockHeader(height int64) types.EventDataNewBlockHeader {
+	return types.EventDataNewBlockHeader{
+		Header: types.Header{
+			Height:            height,
+			Time:              time.Date(2021, 7, 1, 12, 0, 0, 0, time.UTC),
+			ProposerProTxHash: make([]byte, 32),
+		},
+		NumTxs: 1,
+		ResultBeginBlock: abci.ResponseBeginBlock{
+			Events: []abci.Event{
+				{Type: "begin_event", Attributes: []abci.EventAttribute{
+					{Key: []byte("proposer"), Value: []byte("FCAA001"), Index: true},
+				}},
+			},
+		},
+		ResultEndBlock: abci.ResponseEndBlock{
+			Events: []abci.Event{
+				{Type: "end_event", Attributes: []abci.EventAttribute{
+					{Key: []byte("foo"), Value: []byte("100"), Index: true},
+				}},
+			},
+		},
+	}
+}
+
+func makeTxResult(height int64, index uint32, tx string) *abci.TxResult {
+	return &abci.TxResult{
+		Height: height,
+		Index:  index,
+		Tx:     types.Tx(tx),
+		Result: abci.ResponseDeliverTx{
+			Code:    abci.CodeTypeOK,
+			GasUsed: 10,
+			Events: []abci.Event{
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: []byte("sender"), Value: []byte("Ivan"), Index: true},
+					{Key: []byte("amount"), Value: []byte("1.5"), Index: true},
+					{Key: []byte("time"), Value: []byte("2021-07-01T12:00:00Z"), Index: false},
+					{Key: []byte("fee"), Value: []byte("7"), Index: false},
+				}},
+				{Type: "", Attributes: []abci.EventAttribute{
+					{Key: []byte("ignored"), Value: []byte("1")},
+				}},
+			},
+		},
+	}
+}
+
+func count(t *testing.T, sink *sqlsink.EventSink, q string, args ...interface{}) int {
+	var n int
+	require.NoError(t, sink.DB().QueryRow(q, args...).Scan(&n))
+	return n
+}
+
+func TestEventSink(t *testing.T) {
+	sink := openSink(t)
+
+	// indexing is idempotent
+	for i := 0; i < 2; i++ {
+		require.NoError(t, sink.IndexBlock(makeBlockHeader(1)))
+		require.NoError(t, sink.IndexTxs([]*abci.TxResult{makeTxResult(1, 0, "foo"), makeTxResult(1, 1, "bar")}))
+	}
+
+	ok, err := sink.HasBlock(1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = sink.HasBlock(2)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.Equal(t, 1, count(t, sink, `SELECT COUNT(*) FROM blocks WHERE chain_id = $1`, chainID))
+	assert.Equal(t, 2, count(t, sink, `SELECT COUNT(*) FROM tx_results WHERE height = $1`, 1))
+	assert.Equal(t, 1, count(t, sink, `SELECT COUNT(*) FROM tx_results WHERE tx_hash = $1`,
+		fmt.Sprintf("%X", types.Tx("foo").Hash())))
+	assert.Equal(t, 4, count(t, sink, `SELECT COUNT(*) FROM events`))
+	assert.Equal(t, 1, count(t, sink, `SELECT COUNT(*) FROM events WHERE source = 'begin_block'`))
+	assert.Equal(t, 0, count(t, sink, `SELECT COUNT(*) FROM attributes WHERE key = 'ignored'`))
+
+	// attributes are typed
+	testCases := []struct {
+		compositeKey string
+		valueType    string
+		indexed      bool
+	}{
+		{"transfer.sender", sqlsink.ValueTypeString, true},
+		{"transfer.amount", sqlsink.ValueTypeFloat, true},
+		{"transfer.time", sqlsink.ValueTypeTime, false},
+		{"transfer.fee", sqlsink.ValueTypeInt, false},
+		{"end_event.foo", sqlsink.ValueTypeInt, true},
+	}
+	for _, tc := range testCases {
+		var (
+			valueType string
+			indexed   bool
+		)
+		err := sink.DB().QueryRow(`SELECT value_type, indexed FROM attributes WHERE composite_key = $1 LIMIT 1`,
+			tc.compositeKey).Scan(&valueType, &indexed)
+		require.NoError(t, err, tc.compositeKey)
+		assert.Equal(t, tc.valueType, valueType, tc.compositeKey)
+		assert.Equal(t, tc.indexed, indexed, tc.compositeKey)
+	}
+
+	assert.Equal(t, 2, count(t, sink,
+		`SELECT COUNT(*) FROM attributes WHERE composite_key = 'transfer.amount' AND numeric_value > 1`))
+	assert.Equal(t, 2, count(t, sink,
+		`SELECT COUNT(*) FROM attributes WHERE value_type = 'time' AND time_value IS NOT NULL`))
+
+	// searching is not supported
+	_, err = sink.TxIndexer().Get(types.Tx("foo").Hash())
+	assert.ErrorIs(t, err, sqlsink.ErrSearchNotSupported)
+	_, err = sink.TxIndexer().Search(context.Background(), query.MustParse("transfer.sender = 'Ivan'"))
+	assert.ErrorIs(t, err, sqlsink.ErrSearchNotSupported)
+	_, err = sink.BlockIndexer().Search(context.Background(), query.MustParse("block.height = 1"))
+	assert.ErrorIs(t, err, sqlsink.ErrSearchNotSupported)
+}
+
+func TestEventSinkPrune(t *testing.T) {
+	sink := openSink(t)
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, sink.IndexBlock(makeBlockHeader(height)))
+		require.NoError(t, sink.IndexTxs([]*abci.TxResult{makeTxResult(height, 0, fmt.Sprintf("tx%d", height))}))
+	}
+
+	pruned, err := sink.PruneTxs(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+	assert.Equal(t, 1, count(t, sink, `SELECT COUNT(*) FROM tx_results`))
+	assert.Equal(t, 0, count(t, sink, `SELECT COUNT(*) FROM events WHERE source = 'tx' AND height < 3`))
+	assert.Equal(t, 3, count(t, sink, `SELECT COUNT(*) FROM blocks`))
+
+	pruned, err = sink.BlockIndexer().Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+	assert.Equal(t, 1, count(t, sink, `SELECT COUNT(*) FROM blocks`))
+	assert.Equal(t, 0, count(t, sink, `SELECT COUNT(*) FROM events WHERE height < 3`))
+	assert.Equal(t, 0, count(t, sink, `SELECT COUNT(*) FROM attributes WHERE height < 3`))
+	assert.Equal(t, 3, count(t, sink, `SELECT COUNT(*) FROM events`))
+}
+
+func TestEventSinkWithIndexerService(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+	sink := openSink(t)
+
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus,
+		txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name:         "sql",
+			TxIndexer:    sink.TxIndexer(),
+			BlockIndexer: sink.BlockIndexer(),
+		}))
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	require.NoError(t, eventBus.PublishEventNewBlockHeader(makeBlockHeader(1)))
+	require.NoError(t, eventBus.PublishEventTx(types.EventDataTx{TxResult: *makeTxResult(1, 0, "foo")}))
+
+	require.Eventually(t, func() bool {
+		ok, err := sink.HasBlock(1)
+		return err == nil && ok && count(t, sink, `SELECT COUNT(*) FROM tx_results`) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// the kv indexer is written as well
+	res, err := txIndexer.Get(types.Tx("foo").Hash())
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
@@ -0,0 +1,331 @@
+// Package sqlsink implements an event sink, which writes blocks, transaction
+// results and their events into a relational database through database/sql.
+//
+// The sink only writes data; it is meant to be queried directly with SQL
+// (e.g. by analytics tools) and doesn't support searching through the RPC.
+// The schema is compatible with PostgreSQL, whose "postgres" driver is
+// registered by this package, and SQLite.
+package sqlsink
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	_ "github.com/lib/pq" // register the "postgres" driver
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/state/indexer"
+	"github.com/tendermint/tendermint/state/txindex"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	sourceBeginBlock = "begin_block"
+	sourceEndBlock   = "end_block"
+	sourceTx         = "tx"
+)
+
+// Types of attribute values, stored in the value_type column.
+const (
+	ValueTypeString = "string"
+	ValueTypeInt    = "int"
+	ValueTypeFloat  = "float"
+	ValueTypeBool   = "bool"
+	ValueTypeTime   = "time"
+)
+
+// ErrSearchNotSupported is returned by the search methods of the sink.
+var ErrSearchNotSupported = errors.New("the sql event sink does not support searching; query the database directly")
+
+// EventSink writes blocks, transaction results and events into an SQL
+// database. Writes are idempotent, so the same block can be indexed again.
+type EventSink struct {
+	db      *sql.DB
+	chainID string
+}
+
+// New returns an event sink writing into the given database. The schema must
+// be created with CreateSchema first.
+func New(db *sql.DB, chainID string) *EventSink {
+	return &EventSink{db: db, chainID: chainID}
+}
+
+// Open connects to the database with the given database/sql driver and data
+// source name, and creates the schema if it doesn't exist.
+func Open(driver, conn, chainID string) (*EventSink, error) {
+	db, err := sql.Open(driver, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	es := New(db, chainID)
+	if err := es.CreateSchema(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return es, nil
+}
+
+// CreateSchema creates the tables and indexes of the sink, unless they exist.
+func (es *EventSink) CreateSchema() error {
+	for _, stmt := range schema {
+		if _, err := es.db.Exec(stmt); err != nil {
+			return fmt.Errorf("failed to create schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// DB returns the underlying database.
+func (es *EventSink) DB() *sql.DB {
+	return es.db
+}
+
+// Close closes the underlying database.
+func (es *EventSink) Close() error {
+	return es.db.Close()
+}
+
+// IndexBlock writes the block header and its BeginBlock and EndBlock events.
+func (es *EventSink) IndexBlock(bh types.EventDataNewBlockHeader) error {
+	return es.runTx(func(tx *sql.Tx) error {
+		h := bh.Header
+		_, err := tx.Exec(`INSERT INTO blocks
+			(height, chain_id, time, num_txs, proposer_pro_tx_hash, core_chain_locked_height)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT DO NOTHING`,
+			h.Height, es.chainID, h.Time.UTC(), bh.NumTxs, h.ProposerProTxHash.String(), int64(h.CoreChainLockedHeight))
+		if err != nil {
+			return fmt.Errorf("failed to insert block %d: %w", h.Height, err)
+		}
+
+		if err := insertEvents(tx, h.Height, sourceBeginBlock, 0, bh.ResultBeginBlock.Events); err != nil {
+			return fmt.Errorf("failed to insert BeginBlock events: %w", err)
+		}
+		if err := insertEvents(tx, h.Height, sourceEndBlock, 0, bh.ResultEndBlock.Events); err != nil {
+			return fmt.Errorf("failed to insert EndBlock events: %w", err)
+		}
+		return nil
+	})
+}
+
+// IndexTxs writes the given transaction results and their events.
+func (es *EventSink) IndexTxs(results []*abci.TxResult) error {
+	return es.runTx(func(tx *sql.Tx) error {
+		for _, r := range results {
+			rawBytes, err := proto.Marshal(r)
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Exec(`INSERT INTO tx_results
+				(height, tx_index, tx_hash, code, codespace, gas_wanted, gas_used, tx_result)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+				ON CONFLICT DO NOTHING`,
+				r.Height, int64(r.Index), fmt.Sprintf("%X", types.Tx(r.Tx).Hash()), int64(r.Result.Code),
+				r.Result.Codespace, r.Result.GasWanted, r.Result.GasUsed, rawBytes)
+			if err != nil {
+				return fmt.Errorf("failed to insert tx %d/%d: %w", r.Height, r.Index, err)
+			}
+
+			if err := insertEvents(tx, r.Height, sourceTx, int64(r.Index), r.Result.Events); err != nil {
+				return fmt.Errorf("failed to insert events of tx %d/%d: %w", r.Height, r.Index, err)
+			}
+		}
+		return nil
+	})
+}
+
+// HasBlock returns true if the block at the given height has been written.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var n int
+	err := es.db.QueryRow(`SELECT COUNT(*) FROM blocks WHERE height = $1`, height).Scan(&n)
+	return n > 0, err
+}
+
+// PruneTxs removes the transaction results and events of heights below
+// retainHeight, and returns the number of removed transactions.
+func (es *EventSink) PruneTxs(retainHeight int64) (uint64, error) {
+	return es.prune(retainHeight, "tx_results", sourceTx)
+}
+
+// PruneBlocks removes the blocks below retainHeight and their events, and
+// returns the number of removed blocks.
+func (es *EventSink) PruneBlocks(retainHeight int64) (uint64, error) {
+	return es.prune(retainHeight, "blocks", sourceBeginBlock, sourceEndBlock)
+}
+
+// prune removes the rows of the table and the events of the given sources
+// below retainHeight, returning the number of removed rows of the table.
+func (es *EventSink) prune(retainHeight int64, table string, sources ...string) (uint64, error) {
+	var pruned uint64
+	err := es.runTx(func(tx *sql.Tx) error {
+		for _, source := range sources {
+			for _, events := range []string{"attributes", "events"} {
+				_, err := tx.Exec(`DELETE FROM `+events+` WHERE height < $1 AND source = $2`, retainHeight, source)
+				if err != nil {
+					return fmt.Errorf("failed to prune %s: %w", events, err)
+				}
+			}
+		}
+
+		res, err := tx.Exec(`DELETE FROM `+table+` WHERE height < $1`, retainHeight)
+		if err != nil {
+			return fmt.Errorf("failed to prune %s: %w", table, err)
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		pruned = uint64(n)
+		return nil
+	})
+	return pruned, err
+}
+
+// TxIndexer returns the sink as a txindex.TxIndexer, which can be driven by
+// txindex.IndexerService. Get and Search are not supported.
+func (es *EventSink) TxIndexer() txindex.TxIndexer {
+	return txIndexer{es}
+}
+
+// BlockIndexer returns the sink as an indexer.BlockIndexer, which can be
+// driven by txindex.IndexerService. Search is not supported.
+func (es *EventSink) BlockIndexer() indexer.BlockIndexer {
+	return blockIndexer{es}
+}
+
+// runTx runs fn in a database transaction, which is committed if fn succeeds
+// and rolled back otherwise.
+func (es *EventSink) runTx(fn func(tx *sql.Tx) error) error {
+	tx, err := es.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func insertEvents(tx *sql.Tx, height int64, source string, txIndex int64, events []abci.Event) error {
+	for i, event := range events {
+		// only write events with a non-empty type, like the KV indexer
+		if len(event.Type) == 0 {
+			continue
+		}
+
+		_, err := tx.Exec(`INSERT INTO events
+			(height, source, tx_index, event_index, type)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT DO NOTHING`,
+			height, source, txIndex, i, event.Type)
+		if err != nil {
+			return err
+		}
+
+		for j, attr := range event.Attributes {
+			if len(attr.Key) == 0 {
+				continue
+			}
+
+			value := string(attr.Value)
+			valueType, numeric, t := parseValue(value)
+			_, err := tx.Exec(`INSERT INTO attributes
+				(height, source, tx_index, event_index, attribute_index, key, composite_key,
+				 value, value_type, numeric_value, time_value, indexed)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+				ON CONFLICT DO NOTHING`,
+				height, source, txIndex, i, j, string(attr.Key), event.Type+"."+string(attr.Key),
+				value, valueType, numeric, t, attr.Index)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseValue detects the type of the attribute value. Numbers are also
+// returned as float64 and times (RFC3339 or dates) as time.Time, so that they
+// can be compared in SQL.
+func parseValue(value string) (valueType string, numeric sql.NullFloat64, t sql.NullTime) {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ValueTypeInt, sql.NullFloat64{Float64: float64(i), Valid: true}, t
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil && !strings.ContainsAny(value, "xXpPnN") {
+		return ValueTypeFloat, sql.NullFloat64{Float64: f, Valid: true}, t
+	}
+	if value == "true" || value == "false" {
+		return ValueTypeBool, numeric, t
+	}
+	if v, err := time.Parse(query.TimeLayout, value); err == nil {
+		return ValueTypeTime, numeric, sql.NullTime{Time: v.UTC(), Valid: true}
+	}
+	if v, err := time.Parse(query.DateLayout, value); err == nil {
+		return ValueTypeTime, numeric, sql.NullTime{Time: v, Valid: true}
+	}
+	return ValueTypeString, numeric, t
+}
+
+//-----------------------------------------------------------------------------
+
+var _ txindex.TxIndexer = txIndexer{}
+
+type txIndexer struct {
+	es *EventSink
+}
+
+func (txi txIndexer) AddBatch(b *txindex.Batch) error {
+	return txi.es.IndexTxs(b.Ops)
+}
+
+func (txi txIndexer) Index(result *abci.TxResult) error {
+	return txi.es.IndexTxs([]*abci.TxResult{result})
+}
+
+func (txi txIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return nil, ErrSearchNotSupported
+}
+
+func (txi txIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return nil, ErrSearchNotSupported
+}
+
+func (txi txIndexer) Prune(retainHeight int64) (uint64, error) {
+	return txi.es.PruneTxs(retainHeight)
+}
+
+var _ indexer.BlockIndexer = blockIndexer{}
+
+type blockIndexer struct {
+	es *EventSink
+}
+
+func (bi blockIndexer) Has(height int64) (bool, error) {
+	return bi.es.HasBlock(height)
+}
+
+func (bi blockIndexer) Index(bh types.EventDataNewBlockHeader) error {
+	return bi.es.IndexBlock(bh)
+}
+
+func (bi blockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return nil, ErrSearchNotSupported
+}
+
+func (bi blockIndexer) Prune(retainHeight int64) (uint64, error) {
+	return bi.es.PruneBlocks(retainHeight)
+}
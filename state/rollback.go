@@ -0,0 +1,141 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/types"
+)
+
+// Rollback overwrites the persisted state with the state as it was right after
+// the block at the given height was committed. The state is rebuilt from the
+// validators, consensus params, ABCI responses and block headers kept in the
+// stores. The block following the target height must still be in the block
+// store, as it holds the app hash, results hash and app version of the target
+// height.
+//
+// Rollback does not remove any blocks; the caller is expected to remove blocks
+// above the target height once the state has been saved. Rollback is
+// idempotent, so it can be repeated if that step fails.
+func Rollback(bs BlockStore, ss Store, height int64) (State, error) {
+	invalidState, err := ss.Load()
+	if err != nil {
+		return State{}, err
+	}
+	if invalidState.IsEmpty() {
+		return State{}, errors.New("no state found")
+	}
+
+	switch {
+	case height < invalidState.InitialHeight:
+		return State{}, fmt.Errorf("height %d is below the initial height %d", height, invalidState.InitialHeight)
+	case height < bs.Base():
+		return State{}, fmt.Errorf("height %d is below the block store base %d", height, bs.Base())
+	case height > invalidState.LastBlockHeight:
+		return State{}, fmt.Errorf("height %d is above the state height %d", height, invalidState.LastBlockHeight)
+	case height >= bs.Height():
+		return State{}, fmt.Errorf("height %d is not below the block store height %d", height, bs.Height())
+	}
+
+	rollbackBlock := bs.LoadBlockMeta(height)
+	if rollbackBlock == nil {
+		return State{}, fmt.Errorf("block at height %d not found", height)
+	}
+	// The app hash and results hash of a height are only agreed upon in the
+	// following block.
+	nextBlock := bs.LoadBlockMeta(height + 1)
+	if nextBlock == nil {
+		return State{}, fmt.Errorf("block at height %d not found", height+1)
+	}
+
+	lastValidators, err := ss.LoadValidators(height)
+	if err != nil {
+		return State{}, err
+	}
+	validators, err := ss.LoadValidators(height + 1)
+	if err != nil {
+		return State{}, err
+	}
+	nextValidators, err := ss.LoadValidators(height + 2)
+	if err != nil {
+		return State{}, err
+	}
+	params, err := ss.LoadConsensusParams(height + 1)
+	if err != nil {
+		return State{}, err
+	}
+
+	// The exact heights of the last changes are not known. Pointing them at the
+	// earliest height which could have been affected makes the store persist
+	// the full validator set and params again, which is always correct.
+	valChangeHeight := invalidState.LastHeightValidatorsChanged
+	if valChangeHeight > height+2 {
+		valChangeHeight = height + 2
+	}
+	paramsChangeHeight := invalidState.LastHeightConsensusParamsChanged
+	if paramsChangeHeight > height+1 {
+		paramsChangeHeight = height + 1
+	}
+
+	// The app version may be activated by the proposals of the validators, so
+	// it's taken from the header of the next block instead of the params.
+	version := invalidState.Version
+	version.Consensus.App = nextBlock.Header.Version.App
+
+	// The halt height is kept, as the application requested it at the height
+	// at the latest, or requests it again when the rolled back block is
+	// executed again.
+	var haltHeight int64
+	if h := invalidState.HaltHeight; h >= height {
+		haltHeight = h
+	}
+
+	appVersionProposals, err := RebuildAppVersionProposals(height, bs.Base(), version.Consensus.App,
+		func(h int64) (*types.Header, *types.ValidatorSet, error) {
+			meta := bs.LoadBlockMeta(h)
+			if meta == nil {
+				return nil, nil, fmt.Errorf("block at height %d not found", h)
+			}
+			vals, err := ss.LoadValidators(h)
+			if err != nil {
+				return nil, nil, err
+			}
+			return &meta.Header, vals, nil
+		})
+	if err != nil {
+		return State{}, fmt.Errorf("failed to rebuild app version proposals: %w", err)
+	}
+
+	rolledBackState := State{
+		Version:       version,
+		ChainID:       invalidState.ChainID,
+		InitialHeight: invalidState.InitialHeight,
+
+		LastBlockHeight: rollbackBlock.Header.Height,
+		LastBlockID:     rollbackBlock.BlockID,
+		LastBlockTime:   rollbackBlock.Header.Time,
+
+		LastStateID: types.StateID{LastAppHash: rollbackBlock.Header.AppHash},
+
+		LastCoreChainLockedBlockHeight: rollbackBlock.Header.CoreChainLockedHeight,
+
+		NextValidators:              nextValidators,
+		Validators:                  validators,
+		LastValidators:              lastValidators,
+		LastHeightValidatorsChanged: valChangeHeight,
+
+		ConsensusParams:                  params,
+		LastHeightConsensusParamsChanged: paramsChangeHeight,
+
+		LastResultsHash: nextBlock.Header.LastResultsHash,
+		AppHash:         nextBlock.Header.AppHash,
+
+		HaltHeight:          haltHeight,
+		AppVersionProposals: appVersionProposals,
+	}
+
+	if err := ss.Save(rolledBackState); err != nil {
+		return State{}, fmt.Errorf("failed to save rolled back state: %w", err)
+	}
+	return rolledBackState, nil
+}
@@ -0,0 +1,77 @@
+package state_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRollback(t *testing.T) {
+	state, stateDB, _ := makeState(2, 1)
+	stateStore := sm.NewStore(stateDB)
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	states := make(map[int64]sm.State)
+	for h := int64(1); h <= 3; h++ {
+		block := makeBlock(state, h)
+		partSet := block.MakePartSet(types.BlockPartSizeBytes)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		blockStore.SaveBlock(block, partSet, new(types.Commit))
+
+		state.LastBlockHeight = h
+		state.LastBlockID = blockID
+		state.LastBlockTime = block.Time
+		state.LastStateID = types.StateID{LastAppHash: state.AppHash}
+		state.LastValidators = state.Validators.Copy()
+		state.LastResultsHash = tmhash.Sum([]byte{byte(h), 'r'})
+		state.AppHash = tmhash.Sum([]byte{byte(h), 'a'})
+		// the application schedules the halt at height 5
+		state.HaltHeight = 5
+		require.NoError(t, stateStore.Save(state))
+		states[h] = state.Copy()
+	}
+
+	// the block following the target height must be available
+	_, err := sm.Rollback(blockStore, stateStore, 3)
+	require.Error(t, err)
+	_, err = sm.Rollback(blockStore, stateStore, 0)
+	require.Error(t, err)
+
+	rolledBack, err := sm.Rollback(blockStore, stateStore, 1)
+	require.NoError(t, err)
+
+	expected := states[1]
+	assert.EqualValues(t, 1, rolledBack.LastBlockHeight)
+	assert.Equal(t, expected.LastBlockID, rolledBack.LastBlockID)
+	assert.True(t, expected.LastBlockTime.Equal(rolledBack.LastBlockTime))
+	assert.True(t, bytes.Equal(expected.LastStateID.LastAppHash, rolledBack.LastStateID.LastAppHash))
+	assert.Equal(t, expected.AppHash, rolledBack.AppHash)
+	assert.Equal(t, expected.LastResultsHash, rolledBack.LastResultsHash)
+	assert.Equal(t, expected.ConsensusParams, rolledBack.ConsensusParams)
+	assert.Equal(t, expected.Validators.Hash(), rolledBack.Validators.Hash())
+	assert.Equal(t, expected.NextValidators.Hash(), rolledBack.NextValidators.Hash())
+	assert.Equal(t, expected.Version, rolledBack.Version)
+	assert.EqualValues(t, 5, rolledBack.HaltHeight)
+	assert.Empty(t, rolledBack.AppVersionProposals)
+
+	loaded, err := stateStore.Load()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, loaded.LastBlockHeight)
+	assert.Equal(t, expected.AppHash, loaded.AppHash)
+
+	// rollback can be repeated until the blocks are deleted
+	_, err = sm.Rollback(blockStore, stateStore, 1)
+	require.NoError(t, err)
+	_, err = blockStore.DeleteBlocksAfter(1)
+	require.NoError(t, err)
+	_, err = sm.Rollback(blockStore, stateStore, 1)
+	require.Error(t, err)
+}
@@ -85,6 +85,15 @@ type State struct {
 
 	// the latest AppHash we've received from calling abci.Commit()
 	AppHash []byte
+
+	// HaltHeight is the height after which the chain halts, as requested by
+	// the application in EndBlock, 0 if none.
+	HaltHeight int64
+
+	// AppVersionProposals are the app versions proposed by the validators in
+	// their latest blocks, which are higher than the current app version,
+	// sorted by pro tx hash.
+	AppVersionProposals []tmstate.AppVersionProposal
 }
 
 // Copy makes a copy of the State for mutating.
@@ -114,6 +123,9 @@ func (state State) Copy() State {
 		AppHash: state.AppHash,
 
 		LastResultsHash: state.LastResultsHash,
+
+		HaltHeight:          state.HaltHeight,
+		AppVersionProposals: append([]tmstate.AppVersionProposal(nil), state.AppVersionProposals...),
 	}
 }
 
@@ -137,6 +149,12 @@ func (state State) Bytes() []byte {
 	return bz
 }
 
+// IsHalted returns true if the chain is halted after the last block, as
+// requested by the application.
+func (state State) IsHalted() bool {
+	return state.HaltHeight > 0 && state.LastBlockHeight >= state.HaltHeight
+}
+
 // IsEmpty returns true if the State is equal to the empty State.
 func (state State) IsEmpty() bool {
 	return state.Validators == nil // XXX can't compare to Empty
@@ -186,6 +204,8 @@ func (state *State) ToProto() (*tmstate.State, error) {
 	sm.LastHeightConsensusParamsChanged = state.LastHeightConsensusParamsChanged
 	sm.LastResultsHash = state.LastResultsHash
 	sm.AppHash = state.AppHash
+	sm.HaltHeight = state.HaltHeight
+	sm.AppVersionProposals = state.AppVersionProposals
 
 	return sm, nil
 }
@@ -243,9 +263,13 @@ func StateFromProto(pb *tmstate.State) (*State, error) { //nolint:golint
 
 	state.LastHeightValidatorsChanged = pb.LastHeightValidatorsChanged
 	state.ConsensusParams = pb.ConsensusParams
+	// states saved before the synchrony params were introduced don't have them
+	types.CompleteSynchronyParams(&state.ConsensusParams)
 	state.LastHeightConsensusParamsChanged = pb.LastHeightConsensusParamsChanged
 	state.LastResultsHash = pb.LastResultsHash
 	state.AppHash = pb.AppHash
+	state.HaltHeight = pb.HaltHeight
+	state.AppVersionProposals = pb.AppVersionProposals
 
 	return state, nil
 }
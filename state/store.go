@@ -546,6 +546,8 @@ func (store dbStore) LoadConsensusParams(height int64) (tmproto.ConsensusParams,
 
 		paramsInfo = paramsInfo2
 	}
+	// params saved before the synchrony params were introduced don't have them
+	types.CompleteSynchronyParams(&paramsInfo.ConsensusParams)
 
 	return paramsInfo.ConsensusParams, nil
 }
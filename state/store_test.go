@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/bls12381"
 
@@ -19,6 +20,7 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	sm "github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 func TestStoreLoadValidators(t *testing.T) {
@@ -223,6 +225,26 @@ func TestABCIResponsesResultsHash(t *testing.T) {
 	assert.NoError(t, proof.Verify(root, bz))
 }
 
+// TestStoreLoadPreUpgradeSynchronyParams ensures the states saved before the
+// synchrony params were introduced accept timely proposals once loaded.
+func TestStoreLoadPreUpgradeSynchronyParams(t *testing.T) {
+	state, stateDB, _ := makeState(1, 1)
+	stateStore := sm.NewStore(stateDB)
+	state.ConsensusParams.Synchrony = tmproto.SynchronyParams{}
+	require.NoError(t, stateStore.Save(state))
+
+	loaded, err := stateStore.Load()
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultSynchronyParams(), loaded.ConsensusParams.Synchrony)
+	params, err := stateStore.LoadConsensusParams(1)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultSynchronyParams(), params.Synchrony)
+
+	now := tmtime.Now()
+	proposal := &types.Proposal{Timestamp: now}
+	assert.True(t, proposal.IsTimely(now.Add(time.Second), loaded.ConsensusParams.Synchrony))
+}
+
 func sliceToMap(s []int64) map[int64]bool {
 	m := make(map[int64]bool, len(s))
 	for _, i := range s {
@@ -24,6 +24,10 @@ type TxIndexer interface {
 
 	// Search allows you to query for transactions.
 	Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error)
+
+	// Prune removes the transactions of heights below retainHeight and
+	// returns the number of removed transactions.
+	Prune(retainHeight int64) (uint64, error)
 }
 
 // Batch groups together multiple Index operations to be performed at the same time.
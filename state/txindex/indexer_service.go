@@ -2,6 +2,9 @@ package txindex
 
 import (
 	"context"
+	"io"
+	"sync/atomic"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/service"
 	"github.com/tendermint/tendermint/state/indexer"
@@ -12,16 +15,121 @@ import (
 
 const (
 	subscriber = "IndexerService"
+
+	// PrimarySinkName is the name of the sink formed by the indexers passed to
+	// NewIndexerService.
+	PrimarySinkName = "primary"
 )
 
+// EventSink is a named pair of transaction and block indexers, which receive
+// the blocks and transactions indexed by the IndexerService.
+type EventSink struct {
+	Name         string
+	TxIndexer    TxIndexer
+	BlockIndexer indexer.BlockIndexer
+	// Config of the sink; DefaultSinkConfig if nil.
+	Config *SinkConfig
+	// Closer, if set, is closed when the service is stopped (e.g. to close the
+	// database of the sink).
+	Closer io.Closer
+}
+
+// SinkConfig defines how blocks are buffered and retried for an event sink.
+type SinkConfig struct {
+	// Number of blocks buffered for the sink.
+	BufferSize int
+	// Number of times indexing a block is retried before it's dropped.
+	MaxRetries int
+	// Time to wait between retries.
+	RetryInterval time.Duration
+	// If the buffer of the sink is full, the service waits for the sink if
+	// Block is true, which delays the event bus, or drops the block otherwise.
+	Block bool
+}
+
+// DefaultSinkConfig returns the default configuration of an event sink, which
+// drops the blocks it can't keep up with.
+func DefaultSinkConfig() SinkConfig {
+	return SinkConfig{
+		BufferSize:    100,
+		MaxRetries:    3,
+		RetryInterval: time.Second,
+	}
+}
+
+// blockData is a block and its transactions, to be indexed by a sink.
+type blockData struct {
+	header types.EventDataNewBlockHeader
+	batch  *Batch
+}
+
+// sinkWorker indexes the blocks queued for a single sink.
+type sinkWorker struct {
+	EventSink
+	config SinkConfig
+	queue  chan blockData
+
+	// height of the last block processed by the worker
+	lastHeight int64
+}
+
 // IndexerService connects event bus, transaction and block indexers together in
 // order to index transactions and blocks coming from the event bus.
+//
+// Every event sink is written by its own goroutine from its own buffer, so
+// that a slow or failing sink does not delay the others. Only the sinks
+// configured to block on a full buffer, like the primary sink by default, may
+// slow down the event bus.
 type IndexerService struct {
 	service.BaseService
 
-	txIdxr    TxIndexer
-	blockIdxr indexer.BlockIndexer
-	eventBus  *types.EventBus
+	sinks        []EventSink
+	workers      []*sinkWorker
+	latestHeight int64
+	eventBus     *types.EventBus
+	metrics      *Metrics
+
+	// number of recent heights kept when the indexes are pruned (0 - all
+	// heights requested by Prune are pruned)
+	retainBlocks int64
+	retainHeight int64
+	pruneCh      chan struct{}
+}
+
+// IndexerServiceOption sets an optional parameter on the IndexerService.
+type IndexerServiceOption func(*IndexerService)
+
+// IndexerServiceWithSink adds an event sink, which receives the same blocks and
+// transactions as the primary indexers (e.g. an SQL event sink next to the KV
+// indexer).
+func IndexerServiceWithSink(sink EventSink) IndexerServiceOption {
+	return func(is *IndexerService) {
+		is.sinks = append(is.sinks, sink)
+	}
+}
+
+// IndexerServiceWithPrimarySinkConfig sets the configuration of the primary
+// sink, formed by the indexers passed to NewIndexerService. By default, it
+// blocks on a full buffer, as it is used to serve the RPC.
+func IndexerServiceWithPrimarySinkConfig(config SinkConfig) IndexerServiceOption {
+	return func(is *IndexerService) {
+		is.sinks[0].Config = &config
+	}
+}
+
+// IndexerServiceWithRetainBlocks sets the minimum number of recent heights,
+// which are kept in the indexes when they are pruned.
+func IndexerServiceWithRetainBlocks(retainBlocks int64) IndexerServiceOption {
+	return func(is *IndexerService) {
+		is.retainBlocks = retainBlocks
+	}
+}
+
+// IndexerServiceWithMetrics sets the metrics.
+func IndexerServiceWithMetrics(metrics *Metrics) IndexerServiceOption {
+	return func(is *IndexerService) {
+		is.metrics = metrics
+	}
 }
 
 // NewIndexerService returns a new service instance.
@@ -29,10 +137,26 @@ func NewIndexerService(
 	txIdxr TxIndexer,
 	blockIdxr indexer.BlockIndexer,
 	eventBus *types.EventBus,
+	options ...IndexerServiceOption,
 ) *IndexerService {
 
-	is := &IndexerService{txIdxr: txIdxr, blockIdxr: blockIdxr, eventBus: eventBus}
+	primaryConfig := DefaultSinkConfig()
+	primaryConfig.Block = true
+	is := &IndexerService{
+		sinks: []EventSink{{
+			Name:         PrimarySinkName,
+			TxIndexer:    txIdxr,
+			BlockIndexer: blockIdxr,
+			Config:       &primaryConfig,
+		}},
+		eventBus: eventBus,
+		metrics:  NopMetrics(),
+		pruneCh:  make(chan struct{}, 1),
+	}
 	is.BaseService = *service.NewBaseService(nil, "IndexerService", is)
+	for _, option := range options {
+		option(is)
+	}
 	return is
 }
 
@@ -55,18 +179,49 @@ func (is *IndexerService) OnStart() error {
 		return err
 	}
 
+	is.workers = make([]*sinkWorker, 0, len(is.sinks))
+	for _, sink := range is.sinks {
+		config := DefaultSinkConfig()
+		if sink.Config != nil {
+			config = *sink.Config
+		}
+		w := &sinkWorker{
+			EventSink: sink,
+			config:    config,
+			queue:     make(chan blockData, config.BufferSize),
+		}
+		is.workers = append(is.workers, w)
+		go is.runWorker(w)
+	}
+	go is.runPruner()
+
 	go func() {
 		for {
-			msg := <-blockHeadersSub.Out()
-			eventDataHeader := msg.Data().(types.EventDataNewBlockHeader)
-			height := eventDataHeader.Header.Height
-			batch := NewBatch(eventDataHeader.NumTxs)
+			var msg types.EventDataNewBlockHeader
+			select {
+			case m := <-blockHeadersSub.Out():
+				msg = m.Data().(types.EventDataNewBlockHeader)
+			case <-blockHeadersSub.Cancelled():
+				return
+			case <-is.Quit():
+				return
+			}
 
-			for i := int64(0); i < eventDataHeader.NumTxs; i++ {
-				msg2 := <-txsSub.Out()
-				txResult := msg2.Data().(types.EventDataTx).TxResult
+			height := msg.Header.Height
+			batch := NewBatch(msg.NumTxs)
 
-				if err = batch.Add(&txResult); err != nil {
+			for i := int64(0); i < msg.NumTxs; i++ {
+				var txResult types.EventDataTx
+				select {
+				case m := <-txsSub.Out():
+					txResult = m.Data().(types.EventDataTx)
+				case <-txsSub.Cancelled():
+					return
+				case <-is.Quit():
+					return
+				}
+
+				if err = batch.Add(&txResult.TxResult); err != nil {
 					is.Logger.Error(
 						"failed to add tx to batch",
 						"height", height,
@@ -76,16 +231,9 @@ func (is *IndexerService) OnStart() error {
 				}
 			}
 
-			if err := is.blockIdxr.Index(eventDataHeader); err != nil {
-				is.Logger.Error("failed to index block", "height", height, "err", err)
-			} else {
-				is.Logger.Info("indexed block", "height", height)
-			}
-
-			if err = is.txIdxr.AddBatch(batch); err != nil {
-				is.Logger.Error("failed to index block txs", "height", height, "err", err)
-			} else {
-				is.Logger.Debug("indexed block txs", "height", height, "num_txs", eventDataHeader.NumTxs)
+			atomic.StoreInt64(&is.latestHeight, height)
+			for _, w := range is.workers {
+				is.enqueue(w, blockData{header: msg, batch: batch})
 			}
 		}
 	}()
@@ -97,4 +245,166 @@ func (is *IndexerService) OnStop() {
 	if is.eventBus.IsRunning() {
 		_ = is.eventBus.UnsubscribeAll(context.Background(), subscriber)
 	}
+	for _, sink := range is.sinks {
+		if sink.Closer == nil {
+			continue
+		}
+		if err := sink.Closer.Close(); err != nil {
+			is.Logger.Error("failed to close event sink", "sink", sink.Name, "err", err)
+		}
+	}
+}
+
+// Prune requests the blocks and transactions below retainHeight to be pruned
+// from every sink, keeping at least the configured number of recent heights.
+// Pruning is done in the background, so Prune doesn't block; if it's called
+// again before pruning is done, the sinks are pruned to the latest height.
+func (is *IndexerService) Prune(retainHeight int64) {
+	if is.retainBlocks > 0 {
+		if limit := atomic.LoadInt64(&is.latestHeight) - is.retainBlocks + 1; limit < retainHeight {
+			retainHeight = limit
+		}
+	}
+
+	for {
+		current := atomic.LoadInt64(&is.retainHeight)
+		if retainHeight <= current {
+			return
+		}
+		if atomic.CompareAndSwapInt64(&is.retainHeight, current, retainHeight) {
+			break
+		}
+	}
+
+	select {
+	case is.pruneCh <- struct{}{}:
+	default:
+	}
+}
+
+// runPruner prunes the sinks whenever the retain height increases, until the
+// service stops.
+func (is *IndexerService) runPruner() {
+	for {
+		select {
+		case <-is.pruneCh:
+		case <-is.Quit():
+			return
+		}
+
+		retainHeight := atomic.LoadInt64(&is.retainHeight)
+		for _, w := range is.workers {
+			txs, err := w.TxIndexer.Prune(retainHeight)
+			if err != nil {
+				is.Logger.Error("failed to prune txs", "retain_height", retainHeight, "sink", w.Name, "err", err)
+				continue
+			}
+			blocks, err := w.BlockIndexer.Prune(retainHeight)
+			if err != nil {
+				is.Logger.Error("failed to prune blocks", "retain_height", retainHeight, "sink", w.Name, "err", err)
+				continue
+			}
+			is.Logger.Debug("pruned indexes", "retain_height", retainHeight, "sink", w.Name,
+				"blocks", blocks, "txs", txs)
+		}
+	}
+}
+
+// enqueue queues the block for the sink. If the buffer of the sink is full,
+// it waits for the sink or drops the block, depending on its configuration.
+func (is *IndexerService) enqueue(w *sinkWorker, data blockData) {
+	// the lag is counted from the first block received by the service
+	atomic.CompareAndSwapInt64(&w.lastHeight, 0, data.header.Header.Height-1)
+	is.updateLag(w)
+
+	if w.config.Block {
+		select {
+		case w.queue <- data:
+		case <-is.Quit():
+		}
+		return
+	}
+
+	select {
+	case w.queue <- data:
+	default:
+		is.metrics.SinkDroppedBlocks.With("sink", w.Name).Add(1)
+		is.Logger.Error("event sink buffer is full, dropping block; reindex it with the reindex-event command",
+			"sink", w.Name, "height", data.header.Header.Height, "buffer_size", w.config.BufferSize)
+	}
+}
+
+// runWorker indexes the blocks queued for the sink until the service stops.
+func (is *IndexerService) runWorker(w *sinkWorker) {
+	for {
+		select {
+		case data := <-w.queue:
+			is.index(w, data)
+			atomic.StoreInt64(&w.lastHeight, data.header.Header.Height)
+			is.updateLag(w)
+		case <-is.Quit():
+			return
+		}
+	}
+}
+
+// index indexes the block and its transactions, retrying the failed part
+// according to the retry policy of the sink.
+func (is *IndexerService) index(w *sinkWorker, data blockData) {
+	var (
+		height             = data.header.Header.Height
+		blockDone, txsDone bool
+		blockErr, txsErr   error
+	)
+
+	for attempt := 0; ; attempt++ {
+		if !blockDone {
+			if blockErr = w.BlockIndexer.Index(data.header); blockErr == nil {
+				blockDone = true
+				is.Logger.Info("indexed block", "height", height, "sink", w.Name)
+			}
+		}
+
+		if !txsDone {
+			if txsErr = w.TxIndexer.AddBatch(data.batch); txsErr == nil {
+				txsDone = true
+				is.Logger.Debug("indexed block txs", "height", height, "sink", w.Name,
+					"num_txs", data.header.NumTxs)
+			}
+		}
+
+		if blockDone && txsDone {
+			return
+		}
+
+		if attempt >= w.config.MaxRetries {
+			is.metrics.SinkFailedBlocks.With("sink", w.Name).Add(1)
+			if !blockDone {
+				is.Logger.Error("failed to index block", "height", height, "sink", w.Name, "err", blockErr)
+			}
+			if !txsDone {
+				is.Logger.Error("failed to index block txs", "height", height, "sink", w.Name, "err", txsErr)
+			}
+			return
+		}
+
+		is.metrics.SinkRetries.With("sink", w.Name).Add(1)
+		is.Logger.Debug("failed to index block, retrying", "height", height, "sink", w.Name,
+			"attempt", attempt+1, "block_err", blockErr, "txs_err", txsErr)
+
+		select {
+		case <-time.After(w.config.RetryInterval):
+		case <-is.Quit():
+			return
+		}
+	}
+}
+
+// updateLag updates the lag metric of the sink.
+func (is *IndexerService) updateLag(w *sinkWorker) {
+	lag := atomic.LoadInt64(&is.latestHeight) - atomic.LoadInt64(&w.lastHeight)
+	if lag < 0 {
+		lag = 0
+	}
+	is.metrics.SinkLag.With("sink", w.Name).Set(float64(lag))
 }
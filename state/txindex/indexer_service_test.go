@@ -1,17 +1,24 @@
 package txindex_test
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	db "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
 	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
+	blockidxnull "github.com/tendermint/tendermint/state/indexer/block/null"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
+	"github.com/tendermint/tendermint/state/txindex/null"
 	"github.com/tendermint/tendermint/types"
 )
 
@@ -79,3 +86,260 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, txResult2, res)
 }
+
+// mockSink is a block indexer, which fails the first failures calls of Index
+// and blocks every call until unblock is closed.
+type mockSink struct {
+	mtx           sync.Mutex
+	failures      int
+	heights       []int64
+	retainHeights []int64
+	unblock       chan struct{}
+	closed        bool
+}
+
+func (s *mockSink) Has(height int64) (bool, error) { return false, nil }
+
+func (s *mockSink) Index(bh types.EventDataNewBlockHeader) error {
+	<-s.unblock
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("mock failure")
+	}
+	s.heights = append(s.heights, bh.Header.Height)
+	return nil
+}
+
+func (s *mockSink) Search(ctx context.Context, q *query.Query) ([]int64, error) { return nil, nil }
+
+func (s *mockSink) Prune(retainHeight int64) (uint64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.retainHeights = append(s.retainHeights, retainHeight)
+	return 0, nil
+}
+
+func (s *mockSink) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *mockSink) pruned() []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]int64{}, s.retainHeights...)
+}
+
+func (s *mockSink) indexed() []int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]int64{}, s.heights...)
+}
+
+func TestIndexerServiceSinks(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	store := db.NewMemDB()
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	// the slow sink is blocked and drops blocks once its buffer is full
+	slow := &mockSink{unblock: make(chan struct{})}
+	// the failing sink fails twice and is retried; its buffer fits all blocks
+	failing := &mockSink{failures: 2, unblock: make(chan struct{})}
+	close(failing.unblock)
+
+	service := txindex.NewIndexerService(kv.NewTxIndex(store), blockIndexer, eventBus,
+		txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name: "slow", TxIndexer: &null.TxIndex{}, BlockIndexer: slow,
+			Config: &txindex.SinkConfig{BufferSize: 2, MaxRetries: 2, RetryInterval: time.Millisecond},
+		}),
+		txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name: "failing", TxIndexer: &null.TxIndex{}, BlockIndexer: failing,
+			Config: &txindex.SinkConfig{BufferSize: 10, MaxRetries: 2, RetryInterval: time.Millisecond},
+		}),
+	)
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	const numBlocks = 10
+	for h := int64(1); h <= numBlocks; h++ {
+		require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+		}))
+	}
+
+	// the primary sink isn't stalled by the slow one
+	require.Eventually(t, func() bool {
+		ok, err := blockIndexer.Has(numBlocks)
+		return err == nil && ok
+	}, time.Second, 10*time.Millisecond)
+
+	// the first block is retried until it succeeds
+	require.Eventually(t, func() bool {
+		return len(failing.indexed()) == numBlocks
+	}, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 1, failing.indexed()[0])
+
+	// one block is being indexed and two are buffered; the rest were dropped
+	close(slow.unblock)
+	require.Eventually(t, func() bool {
+		return len(slow.indexed()) == 3
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int64{1, 2, 3}, slow.indexed())
+}
+
+func TestIndexerServiceClosesSinks(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	sink := &mockSink{unblock: make(chan struct{})}
+	close(sink.unblock)
+	service := txindex.NewIndexerService(&null.TxIndex{}, &blockidxnull.BlockerIndexer{}, eventBus,
+		txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name: "closer", TxIndexer: &null.TxIndex{}, BlockIndexer: sink, Closer: sink,
+		}),
+	)
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	require.NoError(t, service.Stop())
+
+	sink.mtx.Lock()
+	defer sink.mtx.Unlock()
+	assert.True(t, sink.closed)
+}
+
+func TestIndexerServiceBlockingSink(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// the slow sink is waited for once its buffer is full, so it misses no block
+	slow := &mockSink{unblock: make(chan struct{})}
+	service := txindex.NewIndexerService(&null.TxIndex{}, &blockidxnull.BlockerIndexer{}, eventBus,
+		txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name: "slow", TxIndexer: &null.TxIndex{}, BlockIndexer: slow,
+			Config: &txindex.SinkConfig{BufferSize: 1, Block: true},
+		}),
+	)
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	const numBlocks = 5
+	published := make(chan struct{})
+	go func() {
+		defer close(published)
+		for h := int64(1); h <= numBlocks; h++ {
+			if err := eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+				Header: types.Header{Height: h},
+			}); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+
+	// publishing is delayed by the slow sink
+	select {
+	case <-published:
+		t.Fatal("expected publishing to wait for the slow sink")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(slow.unblock)
+	require.Eventually(t, func() bool {
+		return len(slow.indexed()) == numBlocks
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, slow.indexed())
+	<-published
+}
+
+func TestIndexerServicePrune(t *testing.T) {
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	store := db.NewMemDB()
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+	sink := &mockSink{unblock: make(chan struct{})}
+	close(sink.unblock)
+
+	service := txindex.NewIndexerService(kv.NewTxIndex(store), blockIndexer, eventBus,
+		txindex.IndexerServiceWithRetainBlocks(3),
+		txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name: "mock", TxIndexer: &null.TxIndex{}, BlockIndexer: sink,
+		}),
+	)
+	service.SetLogger(log.TestingLogger())
+	require.NoError(t, service.Start())
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	const numBlocks = 5
+	for h := int64(1); h <= numBlocks; h++ {
+		require.NoError(t, eventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+			Header: types.Header{Height: h},
+		}))
+	}
+	require.Eventually(t, func() bool {
+		ok, err := blockIndexer.Has(numBlocks)
+		return err == nil && ok
+	}, time.Second, 10*time.Millisecond)
+
+	// the last 3 heights are kept, although height 5 is requested
+	service.Prune(numBlocks)
+	require.Eventually(t, func() bool {
+		return len(sink.pruned()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int64{3}, sink.pruned())
+
+	for h := int64(1); h <= numBlocks; h++ {
+		ok, err := blockIndexer.Has(h)
+		require.NoError(t, err)
+		assert.Equal(t, h >= 3, ok, h)
+	}
+
+	// lower retain heights are ignored
+	service.Prune(2)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []int64{3}, sink.pruned())
+}
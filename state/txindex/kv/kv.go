@@ -3,7 +3,9 @@ package kv
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,6 +24,15 @@ const (
 	tagKeySeparator = "/"
 )
 
+// retainHeightKey stores the lowest height which hasn't been pruned. Unlike
+// event keys, it has no separator, and it's shorter than tx hashes.
+var retainHeightKey = []byte("tx.retain_height")
+
+// eventKeysPrefix prefixes the keys, which store the event keys of the tx at
+// a height and index, so that they can be pruned after the tx was included
+// again at a later height. Unlike event keys, they have two separators.
+const eventKeysPrefix = "tx.event_keys"
+
 var _ txindex.TxIndexer = (*TxIndex)(nil)
 
 // TxIndex is the simplest possible indexer, backed by key-value storage (levelDB).
@@ -133,6 +144,7 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 }
 
 func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Batch) error {
+	var eventKeys [][]byte
 	for _, event := range result.Result.Events {
 		// only index events with a non-empty type
 		if len(event.Type) == 0 {
@@ -147,15 +159,158 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 			// index if `index: true` is set
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
 			if attr.GetIndex() {
-				err := store.Set(keyForEvent(compositeTag, attr.Value, result), hash)
+				key := keyForEvent(compositeTag, attr.Value, result)
+				err := store.Set(key, hash)
 				if err != nil {
 					return err
 				}
+				eventKeys = append(eventKeys, key)
 			}
 		}
 	}
 
-	return nil
+	if len(eventKeys) == 0 {
+		return nil
+	}
+	return store.Set(keyForEventKeys(result.Height, result.Index), encodeKeys(eventKeys))
+}
+
+// Prune removes the transactions of heights below retainHeight, along with
+// their events, and returns the number of removed transactions. The lowest
+// height which isn't pruned yet is stored, so that only the newly pruned
+// heights are visited next time.
+func (txi *TxIndex) Prune(retainHeight int64) (uint64, error) {
+	base, err := txi.pruneBase()
+	if err != nil {
+		return 0, err
+	}
+	if base >= retainHeight {
+		return 0, nil
+	}
+
+	batch := txi.store.NewBatch()
+	defer batch.Close()
+
+	var pruned uint64
+	for height := base; height < retainHeight; height++ {
+		n, err := txi.pruneHeight(batch, height)
+		if err != nil {
+			return 0, err
+		}
+		pruned += n
+	}
+
+	if err := batch.Set(retainHeightKey, []byte(strconv.FormatInt(retainHeight, 10))); err != nil {
+		return 0, err
+	}
+	return pruned, batch.WriteSync()
+}
+
+// pruneBase returns the lowest height which may still be indexed.
+func (txi *TxIndex) pruneBase() (int64, error) {
+	bz, err := txi.store.Get(retainHeightKey)
+	if err != nil {
+		return 0, err
+	}
+	if bz != nil {
+		return strconv.ParseInt(string(bz), 10, 64)
+	}
+
+	// never pruned, find the lowest indexed height
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	base := int64(-1)
+	for ; it.Valid(); it.Next() {
+		height, err := strconv.ParseInt(extractValueFromKey(it.Key()), 10, 64)
+		if err != nil {
+			continue
+		}
+		if base == -1 || height < base {
+			base = height
+		}
+	}
+	if base == -1 {
+		base = 0
+	}
+	return base, it.Error()
+}
+
+// pruneHeight removes the transactions at the height from the index.
+func (txi *TxIndex) pruneHeight(batch dbm.Batch, height int64) (uint64, error) {
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey, height, height))
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var pruned uint64
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return 0, err
+		}
+		pruned++
+
+		key := string(it.Key())
+		index, err := strconv.ParseUint(key[strings.LastIndex(key, tagKeySeparator)+1:], 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("invalid tx height key %q: %w", it.Key(), err)
+		}
+		eventKeysKey := keyForEventKeys(height, uint32(index))
+		bz, err := txi.store.Get(eventKeysKey)
+		if err != nil {
+			return 0, err
+		}
+		legacy := bz == nil
+		if !legacy {
+			eventKeys, err := decodeKeys(bz)
+			if err != nil {
+				return 0, fmt.Errorf("invalid event keys of tx %d at height %d: %w", index, height, err)
+			}
+			for _, key := range append(eventKeys, eventKeysKey) {
+				if err := batch.Delete(key); err != nil {
+					return 0, err
+				}
+			}
+		}
+
+		result, err := txi.Get(it.Value())
+		if err != nil {
+			return 0, err
+		}
+		// the same tx may have been included again at a later height, which
+		// overwrote the result stored under its hash
+		if result == nil || result.Height != height {
+			continue
+		}
+
+		if err := batch.Delete(it.Value()); err != nil {
+			return 0, err
+		}
+		if !legacy {
+			continue
+		}
+		// the event keys of txs indexed before they were stored separately are
+		// only known from the result
+		for _, event := range result.Result.Events {
+			if len(event.Type) == 0 {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if len(attr.Key) == 0 || !attr.GetIndex() {
+					continue
+				}
+				compositeTag := fmt.Sprintf("%s.%s", event.Type, string(attr.Key))
+				if err := batch.Delete(keyForEvent(compositeTag, attr.Value, result)); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return pruned, it.Error()
 }
 
 // Search performs a search using the given query.
@@ -167,6 +322,11 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 // performing a full scan. Results from querying indexes are then intersected
 // and returned to the caller, in no particular order.
 //
+// Conditions combined with OR are matched separately and their results are
+// merged. Conditions under NOT are matched as usual and their results are
+// removed from the results of the other operands of the enclosing AND, or from
+// all indexed transactions if there are none.
+//
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
 func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
@@ -177,15 +337,150 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
-
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+	expr, err := q.Expression()
 	if err != nil {
 		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
 	}
 
+	filteredHashes, err := txi.matchExpr(ctx, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	for _, h := range filteredHashes {
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		results = append(results, res)
+
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// matchExpr returns all txs by hash that match the given query expression.
+func (txi *TxIndex) matchExpr(ctx context.Context, expr *query.Expr) (map[string][]byte, error) {
+	switch expr.Op {
+	case query.ExprCondition:
+		return txi.matchConditions(ctx, []query.Condition{expr.Condition})
+
+	case query.ExprAnd:
+		// Match all conditions of the conjunction at once, so that ranges and
+		// heights are handled efficiently, then narrow the result down with the
+		// remaining operands.
+		var (
+			conditions []query.Condition
+			operands   []*query.Expr
+			negations  []*query.Expr
+		)
+		for _, operand := range expr.Operands {
+			switch operand.Op {
+			case query.ExprCondition:
+				conditions = append(conditions, operand.Condition)
+			case query.ExprNot:
+				negations = append(negations, operand.Operands[0])
+			default:
+				operands = append(operands, operand)
+			}
+		}
+
+		var (
+			filteredHashes map[string][]byte
+			err            error
+		)
+		if len(conditions) > 0 {
+			filteredHashes, err = txi.matchConditions(ctx, conditions)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, operand := range operands {
+			if filteredHashes != nil && len(filteredHashes) == 0 {
+				return filteredHashes, nil
+			}
+
+			tmpHashes, err := txi.matchExpr(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+			if filteredHashes == nil {
+				filteredHashes = tmpHashes
+				continue
+			}
+			for k := range filteredHashes {
+				if tmpHashes[k] == nil {
+					delete(filteredHashes, k)
+				}
+			}
+		}
+
+		if filteredHashes == nil {
+			filteredHashes, err = txi.matchAll(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, operand := range negations {
+			if len(filteredHashes) == 0 {
+				break
+			}
+
+			excluded, err := txi.matchExpr(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+			for k := range excluded {
+				delete(filteredHashes, k)
+			}
+		}
+		return filteredHashes, nil
+
+	case query.ExprOr:
+		filteredHashes := make(map[string][]byte)
+		for _, operand := range expr.Operands {
+			tmpHashes, err := txi.matchExpr(ctx, operand)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range tmpHashes {
+				filteredHashes[k] = v
+			}
+		}
+		return filteredHashes, nil
+
+	case query.ExprNot:
+		filteredHashes, err := txi.matchAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		excluded, err := txi.matchExpr(ctx, expr.Operands[0])
+		if err != nil {
+			return nil, err
+		}
+		for k := range excluded {
+			delete(filteredHashes, k)
+		}
+		return filteredHashes, nil
+	}
+
+	return nil, fmt.Errorf("unknown query expression operator %v", expr.Op)
+}
+
+// matchConditions returns all txs by hash that match all of the given
+// conditions.
+func (txi *TxIndex) matchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
+
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
@@ -194,12 +489,11 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		res, err := txi.Get(hash)
 		switch {
 		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+			return nil, fmt.Errorf("error while retrieving the result: %w", err)
+		case res != nil:
+			filteredHashes[string(hash)] = hash
 		}
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -251,23 +545,29 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		results = append(results, res)
+	return filteredHashes, nil
+}
 
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
+// matchAll returns all indexed txs by hash. It is used to evaluate NOT
+// expressions which are not narrowed down by any other operand.
+func (txi *TxIndex) matchAll(ctx context.Context) (map[string][]byte, error) {
+	hashes := make(map[string][]byte)
+
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		hashes[string(it.Value())] = it.Value()
+
+		if err := ctx.Err(); err != nil {
 			break
-		default:
 		}
 	}
 
-	return results, nil
+	return hashes, it.Error()
 }
 
 func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
@@ -547,6 +847,39 @@ func keyForHeight(result *abci.TxResult) []byte {
 	))
 }
 
+func keyForEventKeys(height int64, index uint32) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d", eventKeysPrefix, height, index))
+}
+
+// encodeKeys encodes the keys, each prefixed by its length.
+func encodeKeys(keys [][]byte) []byte {
+	var (
+		buf    []byte
+		length [binary.MaxVarintLen64]byte
+	)
+	for _, key := range keys {
+		n := binary.PutUvarint(length[:], uint64(len(key)))
+		buf = append(buf, length[:n]...)
+		buf = append(buf, key...)
+	}
+	return buf
+}
+
+// decodeKeys decodes the keys encoded by encodeKeys.
+func decodeKeys(bz []byte) ([][]byte, error) {
+	var keys [][]byte
+	for len(bz) > 0 {
+		n, size := binary.Uvarint(bz)
+		if size <= 0 || uint64(len(bz)-size) < n {
+			return nil, errors.New("malformed key length")
+		}
+		bz = bz[size:]
+		keys = append(keys, bz[:n])
+		bz = bz[n:]
+	}
+	return keys, nil
+}
+
 func startKeyForCondition(c query.Condition, height int64) []byte {
 	if height > 0 {
 		return startKey(c.CompositeKey, c.Operand, height)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -313,6 +314,115 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchExpressions(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	for i, owner := range []string{"Ivan", "Igor", "Eric"} {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: []byte("number"), Value: []byte(fmt.Sprintf("%d", i+1)), Index: true},
+				{Key: []byte("owner"), Value: []byte(owner), Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(owner + "'s account")
+		txResult.Height = int64(i + 1)
+		require.NoError(t, indexer.Index(txResult))
+	}
+
+	testCases := []struct {
+		q      string
+		owners []string
+	}{
+		{"account.owner = 'Ivan' OR account.owner = 'Eric'", []string{"Ivan", "Eric"}},
+		{"account.number >= 2 AND (account.owner = 'Ivan' OR account.owner = 'Eric')", []string{"Eric"}},
+		{"account.number >= 1 AND NOT account.owner = 'Igor'", []string{"Ivan", "Eric"}},
+		{"NOT account.owner = 'Igor'", []string{"Ivan", "Eric"}},
+		{"NOT (account.owner = 'Igor' OR tx.height = 3)", []string{"Ivan"}},
+		{"NOT account.owner EXISTS", []string{}},
+		{"(account.owner = 'Ivan' OR account.number > 2) AND NOT account.number = 1", []string{"Eric"}},
+		{"account.owner = 'Ivan' AND (account.number = 2 OR account.number = 3)", []string{}},
+	}
+
+	ctx := context.Background()
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustParse(tc.q))
+			require.NoError(t, err)
+
+			owners := make([]string, 0, len(results))
+			for _, res := range results {
+				owners = append(owners, strings.TrimSuffix(string(res.Tx), "'s account"))
+			}
+			assert.ElementsMatch(t, tc.owners, owners)
+		})
+	}
+}
+
+func TestTxIndexPrune(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+
+	events := []abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{{Key: []byte("number"), Value: []byte("1"), Index: true}}},
+	}
+	for height := int64(1); height <= 5; height++ {
+		txResult := txResultWithEvents(events)
+		txResult.Tx = types.Tx(fmt.Sprintf("tx%d", height))
+		txResult.Height = height
+		require.NoError(t, indexer.Index(txResult))
+	}
+	// the tx of height 1 is included again at height 5
+	again := txResultWithEvents(events)
+	again.Tx = types.Tx("tx1")
+	again.Height = 5
+	again.Index = 1
+	require.NoError(t, indexer.Index(again))
+
+	pruned, err := indexer.Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+
+	res, err := indexer.Get(types.Tx("tx2").Hash())
+	require.NoError(t, err)
+	assert.Nil(t, res)
+	res, err = indexer.Get(types.Tx("tx1").Hash())
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.EqualValues(t, 5, res.Height)
+
+	results, err := indexer.Search(context.Background(), query.MustParse("account.number = 1"))
+	require.NoError(t, err)
+	heights := make([]int64, 0, len(results))
+	for _, r := range results {
+		heights = append(heights, r.Height)
+	}
+	assert.ElementsMatch(t, []int64{3, 4, 5, 5}, heights)
+
+	// the events of the tx at height 1 are pruned, although it was included
+	// again at height 5
+	for height := int64(1); height < 3; height++ {
+		ok, err := store.Has(keyForEvent("account.number", []byte("1"), &abci.TxResult{Height: height}))
+		require.NoError(t, err)
+		assert.False(t, ok, "height %d", height)
+		ok, err = store.Has(keyForEventKeys(height, 0))
+		require.NoError(t, err)
+		assert.False(t, ok, "height %d", height)
+	}
+	ok, err := store.Has(keyForEvent("account.number", []byte("1"), again))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// pruned heights are skipped
+	pruned, err = indexer.Prune(3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+	pruned, err = indexer.Prune(5)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, pruned)
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
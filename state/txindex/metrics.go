@@ -0,0 +1,75 @@
+package txindex
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "indexer"
+)
+
+// Metrics contains metrics exposed by this package. All metrics are labeled
+// with the name of the event sink.
+type Metrics struct {
+	// Number of blocks received by the indexer service, but not yet indexed
+	// by the sink.
+	SinkLag metrics.Gauge
+	// Number of blocks dropped because the buffer of the sink was full.
+	SinkDroppedBlocks metrics.Counter
+	// Number of failed attempts to index a block, which were retried.
+	SinkRetries metrics.Counter
+	// Number of blocks which failed to be indexed after all retries.
+	SinkFailedBlocks metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+// Optionally, labels can be provided along with their values ("foo",
+// "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	labels = append(labels, "sink")
+	return &Metrics{
+		SinkLag: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sink_lag",
+			Help:      "Number of blocks not yet indexed by the event sink.",
+		}, labels).With(labelsAndValues...),
+		SinkDroppedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sink_dropped_blocks",
+			Help:      "Number of blocks dropped because the buffer of the event sink was full.",
+		}, labels).With(labelsAndValues...),
+		SinkRetries: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sink_retries",
+			Help:      "Number of retried attempts to index a block by the event sink.",
+		}, labels).With(labelsAndValues...),
+		SinkFailedBlocks: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sink_failed_blocks",
+			Help:      "Number of blocks the event sink failed to index after all retries.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SinkLag:           discard.NewGauge(),
+		SinkDroppedBlocks: discard.NewCounter(),
+		SinkRetries:       discard.NewCounter(),
+		SinkFailedBlocks:  discard.NewCounter(),
+	}
+}
@@ -0,0 +1,131 @@
+package state
+
+import (
+	"bytes"
+	"sort"
+
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+// AppVersionProposalMaxAge is the number of blocks an app version proposal
+// counts for. The proposals made in older blocks expire, so that the proposals
+// are rebuilt from the latest blocks only.
+const AppVersionProposalMaxAge int64 = 10000
+
+// updateAppVersionProposals records the app version proposed in the block by
+// its proposer, and returns the app version proposals of the validators along
+// with the app version they activate, 0 if none.
+//
+// An app version is activated once validators with more than 2/3 of the voting
+// power propose it or a higher one in their latest blocks. The proposals are
+// then cleared.
+// Only the proposals of the validators of the block, for app versions higher
+// than the current one, made in the latest AppVersionProposalMaxAge blocks,
+// are kept.
+func updateAppVersionProposals(
+	proposals []tmstate.AppVersionProposal,
+	header *types.Header,
+	appVersion uint64,
+	vals *types.ValidatorSet,
+) ([]tmstate.AppVersionProposal, uint64) {
+	var next []tmstate.AppVersionProposal
+	for _, p := range proposals {
+		if p.AppVersion > appVersion && vals.HasProTxHash(p.ProTxHash) &&
+			!bytes.Equal(p.ProTxHash, header.ProposerProTxHash) &&
+			p.Height > header.Height-AppVersionProposalMaxAge {
+			next = append(next, p)
+		}
+	}
+	if header.ProposedAppVersion > appVersion && vals.HasProTxHash(header.ProposerProTxHash) {
+		next = append(next, tmstate.AppVersionProposal{
+			ProTxHash:  header.ProposerProTxHash,
+			AppVersion: header.ProposedAppVersion,
+			Height:     header.Height,
+		})
+		sort.Slice(next, func(i, j int) bool {
+			return bytes.Compare(next[i].ProTxHash, next[j].ProTxHash) < 0
+		})
+	}
+
+	// sum the voting power of the proposals from the highest app version down,
+	// so that the highest app version proposed by enough validators is
+	// activated
+	byVersion := append([]tmstate.AppVersionProposal(nil), next...)
+	sort.SliceStable(byVersion, func(i, j int) bool { return byVersion[i].AppVersion > byVersion[j].AppVersion })
+	var proposedPower int64
+	for _, p := range byVersion {
+		_, val := vals.GetByProTxHash(p.ProTxHash)
+		proposedPower += val.VotingPower
+		if proposedPower*3 > vals.TotalVotingPower()*2 {
+			return nil, p.AppVersion
+		}
+	}
+
+	return next, 0
+}
+
+// RebuildAppVersionProposals rebuilds the app version proposals of the state
+// after the block at the height, whose next app version is appVersion, from
+// the headers and validator sets of the blocks up to the height, which
+// loadBlock returns. It is used when the state is not built by executing the
+// blocks, i.e. by state sync and rollback.
+//
+// The latest block of every validator of the block at the height is looked up,
+// down to the base height at most, within the latest AppVersionProposalMaxAge
+// blocks, as the older proposals are expired. The lookup stops at the latest
+// change of the app version, as the proposals are cleared then. A validator's
+// proposal is only kept if it was a validator of every block since.
+func RebuildAppVersionProposals(
+	height, base int64,
+	appVersion uint64,
+	loadBlock func(height int64) (*types.Header, *types.ValidatorSet, error),
+) ([]tmstate.AppVersionProposal, error) {
+	header, vals, err := loadBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the validators, whose latest block isn't found yet
+	pending := make(map[string]struct{}, vals.Size())
+	for _, val := range vals.Validators {
+		pending[string(val.ProTxHash)] = struct{}{}
+	}
+
+	var proposals []tmstate.AppVersionProposal
+	for h := height; ; {
+		if header.Version.App != appVersion {
+			break
+		}
+		// the proposals of the validators were dropped when they left the
+		// validator set
+		for proTxHash := range pending {
+			if !vals.HasProTxHash([]byte(proTxHash)) {
+				delete(pending, proTxHash)
+			}
+		}
+		if _, ok := pending[string(header.ProposerProTxHash)]; ok {
+			delete(pending, string(header.ProposerProTxHash))
+			if header.ProposedAppVersion > appVersion {
+				proposals = append(proposals, tmstate.AppVersionProposal{
+					ProTxHash:  header.ProposerProTxHash,
+					AppVersion: header.ProposedAppVersion,
+					Height:     h,
+				})
+			}
+		}
+
+		h--
+		if len(pending) == 0 || h < base || h <= height-AppVersionProposalMaxAge {
+			break
+		}
+		if header, vals, err = loadBlock(h); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(proposals, func(i, j int) bool {
+		return bytes.Compare(proposals[i].ProTxHash, proposals[j].ProTxHash) < 0
+	})
+	return proposals, nil
+}
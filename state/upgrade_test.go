@@ -0,0 +1,281 @@
+package state_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestUpdateStateHaltHeight(t *testing.T) {
+	state, proTxHashes := makeUpgradeState(4)
+
+	// the halt height can't be below the height of the block
+	_, err := updateUpgradeState(state, proTxHashes[0], 0, 1)
+	require.Error(t, err)
+
+	state, err = updateUpgradeState(state, proTxHashes[0], 0, 3)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, state.HaltHeight)
+	assert.False(t, state.IsHalted())
+
+	// the halt height is kept until reached
+	state, err = updateUpgradeState(state, proTxHashes[1], 0, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, state.HaltHeight)
+	assert.True(t, state.IsHalted())
+
+	// and survives the persistence
+	pb, err := state.ToProto()
+	require.NoError(t, err)
+	restored, err := sm.StateFromProto(pb)
+	require.NoError(t, err)
+	assert.True(t, restored.IsHalted())
+}
+
+func TestUpdateStateAppVersionUpgrade(t *testing.T) {
+	state, proTxHashes := makeUpgradeState(4)
+
+	// 3 out of 4 validators are needed to activate app version 2, as 2 out of
+	// 4 is not more than 2/3
+	state, err := updateUpgradeState(state, proTxHashes[0], 3, 0)
+	require.NoError(t, err)
+	state, err = updateUpgradeState(state, proTxHashes[1], 2, 0)
+	require.NoError(t, err)
+	// the latest proposal of a validator replaces its previous one
+	state, err = updateUpgradeState(state, proTxHashes[1], 2, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, state.Version.Consensus.App)
+	assert.Len(t, state.AppVersionProposals, 2)
+
+	state, err = updateUpgradeState(state, proTxHashes[2], 2, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, state.Version.Consensus.App)
+	assert.Empty(t, state.AppVersionProposals)
+
+	// the proposals of unknown validators are ignored
+	state, err = updateUpgradeState(state, crypto.RandProTxHash(), 3, 0)
+	require.NoError(t, err)
+	assert.Empty(t, state.AppVersionProposals)
+}
+
+func TestUpdateStateAppVersionParamsUpdate(t *testing.T) {
+	state, proTxHashes := makeUpgradeState(4)
+
+	var err error
+	for _, proTxHash := range proTxHashes[:3] {
+		state, err = updateUpgradeState(state, proTxHash, 2, 0)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 2, state.Version.Consensus.App)
+
+	// updating other params doesn't reset the activated app version
+	responses := &tmstate.ABCIResponses{
+		BeginBlock: &abci.ResponseBeginBlock{},
+		EndBlock: &abci.ResponseEndBlock{
+			ConsensusParamUpdates: &abci.ConsensusParams{
+				Block: &abci.BlockParams{MaxBytes: 1024 * 1024, MaxGas: -1},
+			},
+		},
+	}
+	header := makeUpgradeHeader(state, proTxHashes[3], 0)
+	state, err = sm.UpdateState(state, nil, types.BlockID{}, header, responses, nil, nil, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1024*1024, state.ConsensusParams.Block.MaxBytes)
+	assert.EqualValues(t, 2, state.Version.Consensus.App)
+
+	// but the application may still set it explicitly
+	responses.EndBlock.ConsensusParamUpdates = &abci.ConsensusParams{
+		Version: &tmproto.VersionParams{AppVersion: 3},
+	}
+	header = makeUpgradeHeader(state, proTxHashes[0], 0)
+	state, err = sm.UpdateState(state, nil, types.BlockID{}, header, responses, nil, nil, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, state.Version.Consensus.App)
+}
+
+func TestUpdateStateAppVersionProposalExpiry(t *testing.T) {
+	state, proTxHashes := makeUpgradeState(4)
+
+	var err error
+	for _, proTxHash := range proTxHashes[:2] {
+		state, err = updateUpgradeState(state, proTxHash, 2, 0)
+		require.NoError(t, err)
+	}
+	require.Len(t, state.AppVersionProposals, 2)
+
+	// the proposal made at height 2 expires at the height 2+max age, so that 2
+	// out of 4 validators propose app version 2
+	state.LastBlockHeight = 1 + sm.AppVersionProposalMaxAge
+	state, err = updateUpgradeState(state, proTxHashes[2], 2, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, state.Version.Consensus.App)
+	require.Len(t, state.AppVersionProposals, 2)
+	for _, p := range state.AppVersionProposals {
+		assert.NotEqual(t, proTxHashes[0], p.ProTxHash)
+	}
+}
+
+func TestUpdateStateAppVersionVotingPower(t *testing.T) {
+	proTxHashes := []crypto.ProTxHash{
+		crypto.RandProTxHash(), crypto.RandProTxHash(), crypto.RandProTxHash(), crypto.RandProTxHash(),
+	}
+	powers := map[string]int64{
+		string(proTxHashes[0]): 400,
+		string(proTxHashes[1]): 100,
+		string(proTxHashes[2]): 100,
+		string(proTxHashes[3]): 100,
+	}
+	heavy := proTxHashes[0]
+	valSet, _ := types.GenerateTestValidatorSetWithProTxHashes(
+		append([]crypto.ProTxHash(nil), proTxHashes...),
+		[]int64{400, 100, 100, 100},
+	)
+	for _, val := range valSet.Validators {
+		require.Equal(t, powers[string(val.ProTxHash)], val.VotingPower)
+	}
+	state := makeUpgradeStateWithValidators(valSet)
+
+	// 3 out of 4 validators hold 300 out of 700 of the voting power, which is
+	// not more than 2/3
+	var err error
+	for _, proTxHash := range proTxHashes[1:] {
+		state, err = updateUpgradeState(state, proTxHash, 2, 0)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 1, state.Version.Consensus.App)
+	assert.Len(t, state.AppVersionProposals, 3)
+
+	state, err = updateUpgradeState(state, heavy, 2, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, state.Version.Consensus.App)
+	assert.Empty(t, state.AppVersionProposals)
+}
+
+func TestRebuildAppVersionProposals(t *testing.T) {
+	state, proTxHashes := makeUpgradeState(4)
+
+	var (
+		headers = make(map[int64]*types.Header)
+		states  = make(map[int64]sm.State)
+	)
+	blocks := []struct {
+		proposer           crypto.ProTxHash
+		proposedAppVersion uint64
+	}{
+		{proTxHashes[0], 2},
+		{proTxHashes[1], 3},
+		// the proposer withdraws its proposal
+		{proTxHashes[0], 0},
+		{proTxHashes[2], 2},
+		// app version 2 is activated
+		{proTxHashes[3], 2},
+		{proTxHashes[0], 3},
+		{proTxHashes[1], 0},
+	}
+	for _, block := range blocks {
+		header := makeUpgradeHeader(state, block.proposer, block.proposedAppVersion)
+		var err error
+		state, err = updateUpgradeState(state, block.proposer, block.proposedAppVersion, 0)
+		require.NoError(t, err)
+		headers[header.Height] = header
+		states[header.Height] = state
+	}
+	require.EqualValues(t, 2, state.Version.Consensus.App)
+	require.Len(t, state.AppVersionProposals, 1)
+
+	loadBlock := func(height int64) (*types.Header, *types.ValidatorSet, error) {
+		header, ok := headers[height]
+		if !ok {
+			return nil, nil, fmt.Errorf("block at height %d not found", height)
+		}
+		return header, states[height].LastValidators, nil
+	}
+	for height, state := range states {
+		proposals, err := sm.RebuildAppVersionProposals(height, 2, state.Version.Consensus.App, loadBlock)
+		require.NoError(t, err)
+		assert.Equal(t, state.AppVersionProposals, proposals, "height %d", height)
+	}
+}
+
+func TestRebuildAppVersionProposalsMaxAge(t *testing.T) {
+	state, proTxHashes := makeUpgradeState(4)
+
+	// the other validators never propose, but the blocks are only looked up
+	// within the max age of the proposals
+	var loaded int64
+	loadBlock := func(height int64) (*types.Header, *types.ValidatorSet, error) {
+		loaded++
+		return makeUpgradeHeader(state, proTxHashes[0], 0), state.Validators, nil
+	}
+	height := 3 * sm.AppVersionProposalMaxAge
+	proposals, err := sm.RebuildAppVersionProposals(height, 1, 1, loadBlock)
+	require.NoError(t, err)
+	assert.Empty(t, proposals)
+	assert.Equal(t, sm.AppVersionProposalMaxAge, loaded)
+}
+
+// makeUpgradeState returns a state at height 1 and app version 1 with n
+// validators.
+func makeUpgradeState(n int) (sm.State, []crypto.ProTxHash) {
+	valSet, _ := types.GenerateValidatorSet(n)
+	proTxHashes := make([]crypto.ProTxHash, n)
+	for i, val := range valSet.Validators {
+		proTxHashes[i] = val.ProTxHash
+	}
+	return makeUpgradeStateWithValidators(valSet), proTxHashes
+}
+
+// makeUpgradeStateWithValidators returns a state at height 1 and app version 1
+// with the validators.
+func makeUpgradeStateWithValidators(valSet *types.ValidatorSet) sm.State {
+
+	state := sm.State{
+		Version:         sm.InitStateVersion,
+		ChainID:         "upgrade",
+		InitialHeight:   1,
+		LastBlockHeight: 1,
+		NextValidators:  valSet,
+		Validators:      valSet.Copy(),
+		LastValidators:  valSet.Copy(),
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}
+	state.Version.Consensus.App = 1
+	return state
+}
+
+// updateUpgradeState updates the state with the next block proposed by the
+// proposer, which advertises the app version, and the halt height returned by
+// EndBlock.
+func updateUpgradeState(
+	state sm.State,
+	proposer crypto.ProTxHash,
+	proposedAppVersion uint64,
+	haltHeight int64,
+) (sm.State, error) {
+	responses := &tmstate.ABCIResponses{
+		BeginBlock: &abci.ResponseBeginBlock{},
+		EndBlock:   &abci.ResponseEndBlock{HaltHeight: haltHeight},
+	}
+	header := makeUpgradeHeader(state, proposer, proposedAppVersion)
+	return sm.UpdateState(state, nil, types.BlockID{}, header, responses, nil, nil, nil)
+}
+
+// makeUpgradeHeader returns the header of the next block proposed by the
+// proposer, which advertises the app version.
+func makeUpgradeHeader(state sm.State, proposer crypto.ProTxHash, proposedAppVersion uint64) *types.Header {
+	return &types.Header{
+		Version:            state.Version.Consensus,
+		Height:             state.LastBlockHeight + 1,
+		ProposerProTxHash:  proposer,
+		ProposedAppVersion: proposedAppVersion,
+	}
+}
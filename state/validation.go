@@ -18,6 +18,10 @@ import (
 // Validate block
 
 func validateBlock(state State, block *types.Block) error {
+	if state.IsHalted() {
+		return ErrHalted{Height: state.HaltHeight}
+	}
+
 	// Validate internal consistency.
 	if err := block.ValidateBasic(); err != nil {
 		return err
@@ -159,18 +163,20 @@ func validateBlock(state State, block *types.Block) error {
 	if block.CoreChainLock != nil {
 		// If there is a new Chain Lock we need to make sure the height in the header is the same as the chain lock
 		if block.Header.CoreChainLockedHeight != block.CoreChainLock.CoreBlockHeight {
-			return fmt.Errorf("wrong Block.Header.CoreChainLockedHeight. CoreChainLock CoreBlockHeight %d, got %d",
+			return ErrInvalidChainLock{fmt.Errorf(
+				"wrong Block.Header.CoreChainLockedHeight. CoreChainLock CoreBlockHeight %d, got %d",
 				block.CoreChainLock.CoreBlockHeight,
 				block.Header.CoreChainLockedHeight,
-			)
+			)}
 		}
 
 		// We also need to make sure that the new height is superior to the old height
 		if block.Header.CoreChainLockedHeight <= state.LastCoreChainLockedBlockHeight {
-			return fmt.Errorf("wrong Block.Header.CoreChainLockedHeight. Previous CoreChainLockedHeight %d, got %d",
+			return ErrInvalidChainLock{fmt.Errorf(
+				"wrong Block.Header.CoreChainLockedHeight. Previous CoreChainLockedHeight %d, got %d",
 				state.LastCoreChainLockedBlockHeight,
 				block.Header.CoreChainLockedHeight,
-			)
+			)}
 		}
 
 		// If there is no new Chain Lock we need to make sure the height has stayed the same
@@ -212,40 +218,25 @@ func validateBlockChainLock(proxyAppQueryConn proxy.AppConnQuery, state State, b
 	if block.CoreChainLock != nil {
 		// If there is a new Chain Lock we need to make sure the height in the header is the same as the chain lock
 		if block.Header.CoreChainLockedHeight != block.CoreChainLock.CoreBlockHeight {
-			return fmt.Errorf("wrong Block.Header.CoreChainLockedHeight. CoreChainLock CoreBlockHeight %d, got %d",
+			return ErrInvalidChainLock{fmt.Errorf(
+				"wrong Block.Header.CoreChainLockedHeight. CoreChainLock CoreBlockHeight %d, got %d",
 				block.CoreChainLock.CoreBlockHeight,
 				block.Header.CoreChainLockedHeight,
-			)
+			)}
 		}
 
 		// We also need to make sure that the new height is superior to the old height
 		if block.Header.CoreChainLockedHeight <= state.LastCoreChainLockedBlockHeight {
-			return fmt.Errorf("wrong Block.Header.CoreChainLockedHeight. Previous CoreChainLockedHeight %d, got %d",
+			return ErrInvalidChainLock{fmt.Errorf(
+				"wrong Block.Header.CoreChainLockedHeight. Previous CoreChainLockedHeight %d, got %d",
 				state.LastCoreChainLockedBlockHeight,
 				block.Header.CoreChainLockedHeight,
-			)
+			)}
 		}
-		coreChainLocksBytes, err := block.CoreChainLock.ToProto().Marshal()
-		if err != nil {
-			panic(err)
-		}
-
-		verifySignatureQueryRequest := abci.RequestQuery{
-			Data: coreChainLocksBytes,
-			Path: "/verify-chainlock",
-		}
-
-		// We need to query our abci application to make sure the chain lock signature is valid
-
-		checkQuorumSignatureResponse, err := proxyAppQueryConn.QuerySync(verifySignatureQueryRequest)
-		if err != nil {
+		if err := VerifyChainLockSignature(proxyAppQueryConn, block.CoreChainLock); err != nil {
 			return err
 		}
 
-		if checkQuorumSignatureResponse.Code != 0 {
-			return fmt.Errorf("chain Lock signature deemed invalid by abci application")
-		}
-
 		// If there is no new Chain Lock we need to make sure the height has stayed the same
 	} else if block.Header.CoreChainLockedHeight != state.LastCoreChainLockedBlockHeight {
 		return fmt.Errorf("wrong Block.Header.CoreChainLockedHeight when no new Chain Lock. "+
@@ -255,3 +246,28 @@ func validateBlockChainLock(proxyAppQueryConn proxy.AppConnQuery, state State, b
 
 	return nil
 }
+
+// VerifyChainLockSignature queries the app to make sure the signature of the
+// chain lock is valid. It returns ErrInvalidChainLock if the app deems it
+// invalid.
+func VerifyChainLockSignature(proxyAppQueryConn proxy.AppConnQuery, chainLock *types.CoreChainLock) error {
+	coreChainLocksBytes, err := chainLock.ToProto().Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	verifySignatureQueryRequest := abci.RequestQuery{
+		Data: coreChainLocksBytes,
+		Path: "/verify-chainlock",
+	}
+
+	checkQuorumSignatureResponse, err := proxyAppQueryConn.QuerySync(verifySignatureQueryRequest)
+	if err != nil {
+		return err
+	}
+
+	if checkQuorumSignatureResponse.Code != 0 {
+		return ErrInvalidChainLock{errors.New("chain Lock signature deemed invalid by abci application")}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -197,11 +198,83 @@ func (s *clientStateProvider) State(ctx context.Context, height uint64) (sm.Stat
 			nextLightBlock.Height, err)
 	}
 	state.ConsensusParams = result.ConsensusParams
+	// nodes, which haven't been upgraded yet, don't return the synchrony params
+	types.CompleteSynchronyParams(&state.ConsensusParams)
 	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
 
+	// The app version proposals are rebuilt from the headers of the latest
+	// blocks up to the snapshot height. The halt height isn't in the headers,
+	// and is taken from the application once the snapshot is restored.
+	state.AppVersionProposals, err = sm.RebuildAppVersionProposals(lastLightBlock.Height, state.InitialHeight,
+		currentLightBlock.Version.App, s.blockLoader(ctx, primaryRPC, lastLightBlock))
+	if err != nil {
+		return sm.State{}, fmt.Errorf("unable to rebuild app version proposals: %w", err)
+	}
+
 	return state, nil
 }
 
+// blockLoader returns a loader of the headers and validator sets of the blocks
+// up to the verified light block, from the highest height down. The headers
+// are fetched from the primary in batches and verified to be ancestors of the
+// light block by their hashes. The validator sets are verified by the light
+// client whenever they change.
+func (s *clientStateProvider) blockLoader(
+	ctx context.Context,
+	client *rpchttp.HTTP,
+	lightBlock *types.LightBlock,
+) func(height int64) (*types.Header, *types.ValidatorSet, error) {
+	var (
+		headers = map[int64]*types.Header{lightBlock.Height: lightBlock.Header}
+		vals    = lightBlock.ValidatorSet
+	)
+	return func(height int64) (*types.Header, *types.ValidatorSet, error) {
+		header, ok := headers[height]
+		if !ok {
+			// the blockchain info returns the headers of 20 blocks at most
+			minHeight := height - 19
+			if minHeight < 1 {
+				minHeight = 1
+			}
+			res, err := client.BlockchainInfo(ctx, minHeight, height)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, meta := range res.BlockMetas {
+				meta := meta
+				headers[meta.Header.Height] = &meta.Header
+			}
+			if header, ok = headers[height]; !ok {
+				return nil, nil, fmt.Errorf("header at height %d not found", height)
+			}
+		}
+
+		if height != lightBlock.Height {
+			child, ok := headers[height+1]
+			if !ok {
+				return nil, nil, fmt.Errorf("header at height %d not verified", height+1)
+			}
+			if !bytes.Equal(header.Hash(), child.LastBlockID.Hash) {
+				return nil, nil, fmt.Errorf("header at height %d doesn't match the last block ID %X of the next one",
+					height, child.LastBlockID.Hash)
+			}
+		}
+
+		if !bytes.Equal(header.ValidatorsHash, vals.Hash()) {
+			lb, err := s.lc.VerifyLightBlockAtHeight(ctx, height, time.Now())
+			if err != nil {
+				return nil, nil, err
+			}
+			if !bytes.Equal(lb.Hash(), header.Hash()) {
+				return nil, nil, fmt.Errorf("light block %X doesn't match the header %X at height %d",
+					lb.Hash(), header.Hash(), height)
+			}
+			vals = lb.ValidatorSet
+		}
+		return header, vals, nil
+	}
+}
+
 // rpcClient sets up a new RPC client
 func rpcClient(server string) (*rpchttp.HTTP, error) {
 	if !strings.Contains(server, "://") {
@@ -292,12 +292,16 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 		return sm.State{}, nil, err
 	}
 
-	// Verify app and update app version
-	appVersion, err := s.verifyApp(snapshot)
+	// Verify app and update app version and halt height, which are part of
+	// the restored app state
+	info, err := s.verifyApp(snapshot)
 	if err != nil {
 		return sm.State{}, nil, err
 	}
-	state.Version.Consensus.App = appVersion
+	state.Version.Consensus.App = info.AppVersion
+	if info.HaltHeight >= int64(snapshot.Height) {
+		state.HaltHeight = info.HaltHeight
+	}
 
 	// Done! 🎉
 	s.logger.Info("Snapshot restored", "height", snapshot.Height, "format", snapshot.Format,
@@ -469,28 +473,29 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 }
 
 // verifyApp verifies the sync, checking the app hash and last block height. It returns the
-// app version, which should be returned as part of the initial state.
-func (s *syncer) verifyApp(snapshot *snapshot) (uint64, error) {
+// info response, whose app version and halt height should be returned as part of the initial
+// state.
+func (s *syncer) verifyApp(snapshot *snapshot) (*abci.ResponseInfo, error) {
 	resp, err := s.connQuery.InfoSync(proxy.RequestInfo)
 	if err != nil {
-		return 0, fmt.Errorf("failed to query ABCI app for appHash: %w", err)
+		return nil, fmt.Errorf("failed to query ABCI app for appHash: %w", err)
 	}
 	if !bytes.Equal(snapshot.trustedAppHash, resp.LastBlockAppHash) {
 		s.logger.Error("appHash verification failed",
 			"expected", snapshot.trustedAppHash,
 			"actual", resp.LastBlockAppHash)
-		return 0, errVerifyFailed
+		return nil, errVerifyFailed
 	}
 	if uint64(resp.LastBlockHeight) != snapshot.Height {
 		s.logger.Error("ABCI app reported unexpected last block height",
 			"expected", snapshot.Height, "actual", resp.LastBlockHeight)
-		return 0, errVerifyFailed
+		return nil, errVerifyFailed
 	}
 	if snapshot.CoreChainLockedHeight != resp.LastCoreChainLockedHeight {
 		s.logger.Error("last core chain locked height verification failed",
 			"expected", fmt.Sprintf("%d", snapshot.CoreChainLockedHeight),
 			"actual", fmt.Sprintf("%d", resp.LastCoreChainLockedHeight))
-		return 0, errVerifyFailed
+		return nil, errVerifyFailed
 	}
 
 	s.logger.Info(
@@ -500,5 +505,5 @@ func (s *syncer) verifyApp(snapshot *snapshot) (uint64, error) {
 		"appHash",
 		snapshot.trustedAppHash,
 	)
-	return resp.AppVersion, nil
+	return resp, nil
 }
@@ -200,6 +200,7 @@ func TestSyncer_SyncAny(t *testing.T) {
 		LastBlockHeight:           1,
 		LastCoreChainLockedHeight: 1,
 		LastBlockAppHash:          []byte("app_hash"),
+		HaltHeight:                5,
 	}, nil)
 
 	newState, lastCommit, err := syncer.SyncAny(0, func() {})
@@ -211,9 +212,11 @@ func TestSyncer_SyncAny(t *testing.T) {
 	assert.Equal(t, map[uint32]int{0: 1, 1: 2, 2: 1}, chunkRequests)
 	chunkRequestsMtx.Unlock()
 
-	// The syncer should have updated the state app version from the ABCI info response.
+	// The syncer should have updated the state app version and halt height from the ABCI info
+	// response.
 	expectState := state
 	expectState.Version.Consensus.App = 9
+	expectState.HaltHeight = 5
 
 	assert.Equal(t, expectState, newState)
 	assert.Equal(t, commit, lastCommit)
@@ -717,14 +720,14 @@ func TestSyncer_verifyApp(t *testing.T) {
 			)
 
 			connQuery.On("InfoSync", proxy.RequestInfo).Return(tc.response, tc.err)
-			version, err := syncer.verifyApp(s)
+			info, err := syncer.verifyApp(s)
 			unwrapped := errors.Unwrap(err)
 			if unwrapped != nil {
 				err = unwrapped
 			}
 			assert.Equal(t, tc.expectErr, err)
 			if err == nil {
-				assert.Equal(t, tc.response.AppVersion, version)
+				assert.Equal(t, tc.response, info)
 			}
 		})
 	}
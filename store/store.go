@@ -330,6 +330,59 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	return pruned, nil
 }
 
+// DeleteBlocksAfter removes all blocks above the given height, together with
+// their commits and seen commits. It returns the number of blocks deleted.
+// The seen commit of the given height is kept, since it is needed to
+// reconstruct the last commit.
+func (bs *BlockStore) DeleteBlocksAfter(height int64) (uint64, error) {
+	bs.mtx.RLock()
+	base, top := bs.base, bs.height
+	bs.mtx.RUnlock()
+	if height < base {
+		return 0, fmt.Errorf("cannot delete blocks above height %v, it is lower than base height %v",
+			height, base)
+	}
+
+	deleted := uint64(0)
+	// Delete from the top, so that the store stays contiguous if we crash midway.
+	for h := top; h > height; h-- {
+		batch := bs.db.NewBatch()
+		// delete what we can, skipping what's already missing, to ensure
+		// partial blocks get deleted fully
+		if meta := bs.LoadBlockMeta(h); meta != nil {
+			if err := batch.Delete(calcBlockHashKey(meta.BlockID.Hash)); err != nil {
+				return deleted, err
+			}
+			for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
+				if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
+					return deleted, err
+				}
+			}
+		}
+		if err := batch.Delete(calcBlockCommitKey(h)); err != nil {
+			return deleted, err
+		}
+		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
+			return deleted, err
+		}
+		// delete the meta last, so that no keys built on it are left dangling
+		if err := batch.Delete(calcBlockMetaKey(h)); err != nil {
+			return deleted, err
+		}
+		if err := batch.WriteSync(); err != nil {
+			return deleted, fmt.Errorf("failed to delete block %v: %w", h, err)
+		}
+		batch.Close()
+		deleted++
+
+		bs.mtx.Lock()
+		bs.height = h - 1
+		bs.mtx.Unlock()
+		bs.saveState()
+	}
+	return deleted, nil
+}
+
 // SaveBlock persists the given block, blockParts, and seenCommit to the underlying db.
 // blockParts: Must be parts of the block
 // seenCommit: The +2/3 precommits that were seen which committed at height.
@@ -531,6 +531,59 @@ func TestPruneBlocks(t *testing.T) {
 	assert.Nil(t, bs.LoadBlock(1501))
 }
 
+func TestDeleteBlocksAfter(t *testing.T) {
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB())
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db)
+
+	for h := int64(1); h <= 10; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, tmtime.Now())
+		bs.SaveBlock(block, partSet, seenCommit)
+	}
+	_, err = bs.PruneBlocks(3)
+	require.NoError(t, err)
+
+	// deleting below the base should error
+	_, err = bs.DeleteBlocksAfter(2)
+	require.Error(t, err)
+
+	deletedBlock := bs.LoadBlock(8)
+
+	deleted, err := bs.DeleteBlocksAfter(7)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, deleted)
+	assert.EqualValues(t, 3, bs.Base())
+	assert.EqualValues(t, 7, bs.Height())
+	assert.EqualValues(t, tmstore.BlockStoreState{
+		Base:   3,
+		Height: 7,
+	}, LoadBlockStoreState(db))
+
+	require.NotNil(t, bs.LoadBlock(7))
+	require.NotNil(t, bs.LoadSeenCommit(7))
+	require.Nil(t, bs.LoadBlock(8))
+	require.Nil(t, bs.LoadBlockByHash(deletedBlock.Hash()))
+	require.Nil(t, bs.LoadBlockMeta(8))
+	require.Nil(t, bs.LoadBlockPart(8, 0))
+	require.Nil(t, bs.LoadSeenCommit(8))
+
+	// deleting at the current height is a no-op
+	deleted, err = bs.DeleteBlocksAfter(7)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, deleted)
+
+	// blocks can be saved again after the new height
+	block := makeBlock(8, state, new(types.Commit))
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(8, tmtime.Now()))
+	assert.EqualValues(t, 8, bs.Height())
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
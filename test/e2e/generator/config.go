@@ -111,7 +111,7 @@ func defaultCfg() config {
 			abciProtocols:    uniformChoice{"unix", "tcp", "builtin"},
 			privvalProtocols: uniformChoice{"file", "unix", "tcp", "dashcore"},
 			// FIXME: v2 disabled due to flake
-			fastSyncs:         uniformChoice{"", "v0"},
+			fastSyncs:         uniformChoice{false, true},
 			stateSyncs:        uniformChoice{false, true},
 			persistIntervals:  uniformChoice{0, 1, 5},
 			snapshotIntervals: uniformChoice{0, 3},
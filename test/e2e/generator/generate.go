@@ -176,7 +176,7 @@ func generateNode(
 		Database:         n.databases.Choose(r).(string),
 		ABCIProtocol:     n.abciProtocols.Choose(r).(string),
 		PrivvalProtocol:  n.privvalProtocols.Choose(r).(string),
-		FastSync:         n.fastSyncs.Choose(r).(string),
+		FastSync:         n.fastSyncs.Choose(r).(bool),
 		StateSync:        n.stateSyncs.Choose(r).(bool) && startAt > 0,
 		PersistInterval:  ptrUint64(uint64(n.persistIntervals.Choose(r).(int))),
 		SnapshotInterval: uint64(n.snapshotIntervals.Choose(r).(int)),
@@ -106,9 +106,8 @@ type ManifestNode struct {
 	// runner will wait for the network to reach at least this block height.
 	StartAt int64 `toml:"start_at"`
 
-	// FastSync specifies the fast sync mode: "" (disable), "v0", "v1", or "v2".
-	// Defaults to disabled.
-	FastSync string `toml:"fast_sync"`
+	// FastSync specifies whether to enable fast sync. Defaults to disabled.
+	FastSync bool `toml:"fast_sync"`
 
 	// StateSync enables state sync. The runner automatically configures trusted
 	// block hashes and RPC servers. At least one node in the network must have
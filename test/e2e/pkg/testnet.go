@@ -86,7 +86,7 @@ type Node struct {
 	IP                   net.IP
 	ProxyPort            uint32
 	StartAt              int64
-	FastSync             string
+	FastSync             bool
 	StateSync            bool
 	Database             string
 	ABCIProtocol         Protocol
@@ -550,11 +550,6 @@ func (n Node) Validate(testnet Testnet) error {
 			return fmt.Errorf("validator %s must have a proTxHash of size 32 (%d)", n.Name, len(n.ProTxHash))
 		}
 	}
-	switch n.FastSync {
-	case "", "v0", "v1", "v2":
-	default:
-		return fmt.Errorf("invalid fast sync setting %q", n.FastSync)
-	}
 	switch n.Database {
 	case "goleveldb", "cleveldb", "boltdb", "rocksdb", "badgerdb":
 	default:
@@ -322,11 +322,7 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 		return nil, fmt.Errorf("unexpected mode %q", node.Mode)
 	}
 
-	if node.FastSync == "" {
-		cfg.FastSyncMode = false
-	} else {
-		cfg.FastSync.Version = node.FastSync
-	}
+	cfg.FastSyncMode = node.FastSync
 
 	if node.StateSync {
 		cfg.StateSync.Enable = true
@@ -8,6 +8,7 @@ import (
 	cstypes "github.com/tendermint/tendermint/consensus/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 // MisbehaviorList encompasses a list of all possible behaviors
@@ -194,6 +195,25 @@ func defaultEnterPrevote(cs *State, height int64, round int32) {
 		return
 	}
 
+	// The proposal must carry the time of the proposed block
+	if cs.Proposal != nil && !cs.Proposal.Timestamp.Equal(cs.ProposalBlock.Header.Time) {
+		logger.Error("enterPrevote: proposal timestamp not equal to block time",
+			"proposal", cs.Proposal.Timestamp, "block", cs.ProposalBlock.Header.Time)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
+	// A new block (one without a proof-of-lock) must be timely, except the
+	// first one, which carries the genesis time
+	if cs.Proposal != nil && cs.Proposal.POLRound == -1 && !cs.replayMode &&
+		cs.Height != cs.state.InitialHeight &&
+		!cs.Proposal.IsTimely(cs.ProposalReceiveTime, cs.state.ConsensusParams.Synchrony) {
+		logger.Error("enterPrevote: proposal is not timely",
+			"timestamp", cs.Proposal.Timestamp, "receive_time", cs.ProposalReceiveTime)
+		cs.signAddVote(tmproto.PrevoteType, nil, types.PartSetHeader{})
+		return
+	}
+
 	// Validate proposal block time if we are not proposer
 	if !bytes.Equal(cs.privValidatorProTxHash, cs.ProposalBlock.ProposerProTxHash) {
 		err = cs.blockExec.ValidateBlockTime(cs.state, cs.ProposalBlock)
@@ -433,6 +453,7 @@ func defaultReceiveProposal(cs *State, proposal *types.Proposal) error {
 
 	proposal.Signature = p.Signature
 	cs.Proposal = proposal
+	cs.ProposalReceiveTime = tmtime.Now()
 	// We don't update cs.ProposalBlockParts if it is already set.
 	// This happens if we're already in cstypes.RoundStepApplyCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
@@ -563,6 +563,7 @@ func (h *Handshaker) replayBlocks(
 			h.logger,
 			h.stateStore,
 			h.genDoc.InitialHeight,
+			false,
 		)
 		if err != nil {
 			return nil, err
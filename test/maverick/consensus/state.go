@@ -108,6 +108,10 @@ type State struct {
 	// the switch is passed to the state so that maveick misbehaviors can directly control which
 	// information they send to which nodes
 	sw *p2p.Switch
+
+	// resumed is closed on resuming the paused state, nil unless paused
+	pauseMtx sync.Mutex
+	resumed  chan struct{}
 }
 
 // StateOption sets an optional parameter on the State.
@@ -680,6 +684,30 @@ func (cs *State) OnStop() {
 	// WAL is stopped in receiveRoutine.
 }
 
+// Pause pauses processing the messages and the timeouts, e.g. while the
+// connection to the application is lost.
+func (cs *State) Pause() {
+	cs.pauseMtx.Lock()
+	defer cs.pauseMtx.Unlock()
+
+	if cs.resumed == nil {
+		cs.Logger.Info("Pausing consensus")
+		cs.resumed = make(chan struct{})
+	}
+}
+
+// Resume resumes processing the messages and the timeouts, paused with Pause.
+func (cs *State) Resume() {
+	cs.pauseMtx.Lock()
+	defer cs.pauseMtx.Unlock()
+
+	if cs.resumed != nil {
+		cs.Logger.Info("Resuming consensus")
+		close(cs.resumed)
+		cs.resumed = nil
+	}
+}
+
 // Wait waits for the the main routine to return.
 // NOTE: be sure to Stop() the event switch and drain
 // any event channels or this may deadlock
@@ -898,6 +926,7 @@ func (cs *State) updateToState(state sm.State) {
 
 	cs.Validators = validators
 	cs.Proposal = nil
+	cs.ProposalReceiveTime = time.Time{}
 	cs.ProposalBlock = nil
 	cs.ProposalBlockParts = nil
 	cs.LockedRound = -1
@@ -978,6 +1007,18 @@ func (cs *State) receiveRoutine(maxSteps int) {
 				return
 			}
 		}
+		cs.pauseMtx.Lock()
+		resumed := cs.resumed
+		cs.pauseMtx.Unlock()
+		if resumed != nil {
+			select {
+			case <-resumed:
+			case <-cs.Quit():
+				onExit(cs)
+				return
+			}
+		}
+
 		rs := cs.RoundState
 		var mi msgInfo
 
@@ -1136,6 +1177,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	} else {
 		logger.Info("Resetting Proposal info")
 		cs.Proposal = nil
+		cs.ProposalReceiveTime = time.Time{}
 		cs.ProposalBlock = nil
 		cs.ProposalBlockParts = nil
 	}
@@ -1207,7 +1249,7 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
-	proposal := types.NewProposal(height, proposedChainLockHeight, round, cs.ValidRound, propBlockID)
+	proposal := types.NewProposal(height, proposedChainLockHeight, round, cs.ValidRound, propBlockID, block.Header.Time)
 	p := proposal.ToProto()
 	if _, err := cs.privValidator.SignProposal(
 		cs.state.ChainID, cs.Validators.QuorumType, cs.Validators.QuorumHash, p,
@@ -21,10 +21,9 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	abcicli "github.com/tendermint/tendermint/abci/client"
 	abci "github.com/tendermint/tendermint/abci/types"
-	bcv0 "github.com/tendermint/tendermint/blockchain/v0"
-	bcv1 "github.com/tendermint/tendermint/blockchain/v1"
-	bcv2 "github.com/tendermint/tendermint/blockchain/v2"
+	bc "github.com/tendermint/tendermint/blockchain"
 	cfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/consensus"
 	"github.com/tendermint/tendermint/crypto"
@@ -36,6 +35,7 @@ import (
 	mempl "github.com/tendermint/tendermint/mempool"
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/p2p/pex"
+	"github.com/tendermint/tendermint/p2p/trust"
 	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	rpccore "github.com/tendermint/tendermint/rpc/core"
@@ -45,6 +45,7 @@ import (
 	"github.com/tendermint/tendermint/state/indexer"
 	blockidxkv "github.com/tendermint/tendermint/state/indexer/block/kv"
 	blockidxnull "github.com/tendermint/tendermint/state/indexer/block/null"
+	"github.com/tendermint/tendermint/state/indexer/sqlsink"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/state/txindex/kv"
 	"github.com/tendermint/tendermint/state/txindex/null"
@@ -139,10 +140,17 @@ func DefaultNewNode(
 		)
 	}
 
+	// the genesis is loaded ahead to label the metrics of the ABCI clients
+	genesisDocProvider := DefaultGenesisDocProviderFunc(config)
+	genDoc, err := genesisDocProvider()
+	if err != nil {
+		return nil, err
+	}
+
 	return NewNode(config,
 		nodeKey,
-		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
-		DefaultGenesisDocProviderFunc(config),
+		DefaultClientCreator(config, genDoc.ChainID),
+		genesisDocProvider,
 		DefaultDBProvider,
 		DefaultMetricsProvider(config.Instrumentation),
 		nil,
@@ -152,21 +160,52 @@ func DefaultNewNode(
 
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, state and indexer Metrics.
+type MetricsProvider func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics,
+	*txindex.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics) {
+	return func(chainID string) (*consensus.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *txindex.Metrics) {
 		if config.Prometheus {
 			return consensus.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				mempl.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				txindex.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return consensus.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics()
+		return consensus.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), txindex.NopMetrics()
+	}
+}
+
+// DefaultClientCreator returns the ClientCreator of the ABCI application set
+// in the config, which records the traffic with the application if enabled.
+// The metrics of the clients are labeled with the chain ID.
+func DefaultClientCreator(config *cfg.Config, chainID string) proxy.ClientCreator {
+	clientCreator := proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir(),
+		DefaultSocketClientOptions(config, chainID)...)
+	if recordPath := config.ABCIRecordPath(); recordPath != "" {
+		clientCreator = proxy.NewRecordingClientCreator(clientCreator, recordPath)
+	}
+	return clientCreator
+}
+
+// DefaultSocketClientOptions returns the options of the socket clients
+// connecting to the ABCI application, set from the config.
+func DefaultSocketClientOptions(config *cfg.Config, chainID string) []abcicli.SocketClientOption {
+	var options []abcicli.SocketClientOption
+	if config.ABCIReconnect {
+		options = append(options, abcicli.SocketClientWithReconnect(abcicli.DefaultMaxReconnectBackoff))
+	}
+	if config.ABCIMultiplex {
+		options = append(options, abcicli.SocketClientWithMultiplexing())
+	}
+	if config.Instrumentation.Prometheus {
+		options = append(options, abcicli.SocketClientWithMetrics(
+			abcicli.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)))
 	}
+	return options
 }
 
 // DefaultDashCoreRPCClient returns RPC client for the Dash Core node
@@ -274,6 +313,7 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	trustMetricStore  *trust.MetricStore
 	prometheusSrv     *http.Server
 
 	dashCoreRPCClient dashcore.Client
@@ -319,20 +359,35 @@ func createAndStartEventBus(logger log.Logger) (*types.EventBus, error) {
 	return eventBus, nil
 }
 
+// indexerSinkConfig returns the configuration of an event sink of the indexer
+// service.
+func indexerSinkConfig(sink cfg.IndexerSinkConfig) txindex.SinkConfig {
+	return txindex.SinkConfig{
+		BufferSize:    sink.BufferSize,
+		MaxRetries:    sink.MaxRetries,
+		RetryInterval: sink.RetryInterval,
+		Block:         sink.OnFullBuffer == cfg.OnFullBufferBlock,
+	}
+}
+
 func createAndStartIndexerService(
 	config *cfg.Config,
+	chainID string,
 	dbProvider DBProvider,
 	eventBus *types.EventBus,
+	metrics *txindex.Metrics,
 	logger log.Logger,
 ) (*txindex.IndexerService, txindex.TxIndexer, indexer.BlockIndexer, error) {
 
 	var (
-		txIndexer    txindex.TxIndexer
-		blockIndexer indexer.BlockIndexer
+		txIndexer    txindex.TxIndexer    = &null.TxIndex{}
+		blockIndexer indexer.BlockIndexer = &blockidxnull.BlockerIndexer{}
+		options      []txindex.IndexerServiceOption
 	)
 
-	switch config.TxIndex.Indexer {
-	case "kv":
+	// the kv indexer is the only one which supports searching through the RPC,
+	// so it's the primary sink of the indexer service, which never drops blocks
+	if config.TxIndex.IsEnabled("kv") {
 		store, err := dbProvider(&DBContext{"tx_index", config})
 		if err != nil {
 			return nil, nil, nil, err
@@ -340,12 +395,31 @@ func createAndStartIndexerService(
 
 		txIndexer = kv.NewTxIndex(store)
 		blockIndexer = blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
-	default:
-		txIndexer = &null.TxIndex{}
-		blockIndexer = &blockidxnull.BlockerIndexer{}
+		options = append(options, txindex.IndexerServiceWithPrimarySinkConfig(
+			indexerSinkConfig(config.TxIndex.SinkConfig("kv"))))
 	}
 
-	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus)
+	if config.TxIndex.IsEnabled("sql") {
+		sink, err := sqlsink.Open(config.TxIndex.SQLDriver, config.TxIndex.SQLConn, chainID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to open sql event sink: %w", err)
+		}
+		sinkConfig := indexerSinkConfig(config.TxIndex.SinkConfig("sql"))
+		options = append(options, txindex.IndexerServiceWithSink(txindex.EventSink{
+			Name:         "sql",
+			TxIndexer:    sink.TxIndexer(),
+			BlockIndexer: sink.BlockIndexer(),
+			Config:       &sinkConfig,
+			Closer:       sink,
+		}))
+	}
+
+	options = append(options,
+		txindex.IndexerServiceWithRetainBlocks(config.TxIndex.RetainBlocks),
+		txindex.IndexerServiceWithMetrics(metrics),
+	)
+
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, options...)
 	indexerService.SetLogger(logger.With("module", "txindex"))
 
 	if err := indexerService.Start(); err != nil {
@@ -425,6 +499,7 @@ func createEvidenceReactor(
 	dbProvider DBProvider,
 	stateDB dbm.DB,
 	blockStore *store.BlockStore,
+	proxyApp proxy.AppConns,
 	logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
 
@@ -437,48 +512,57 @@ func createEvidenceReactor(
 	if err != nil {
 		return nil, nil, err
 	}
+	evidencePool.SetProxyAppQuery(proxyApp.Query())
 	evidenceReactor := evidence.NewReactor(evidencePool)
 	evidenceReactor.SetLogger(evidenceLogger)
 	return evidenceReactor, evidencePool, nil
 }
 
+func createAndStartTrustMetricStore(
+	config *cfg.Config,
+	dbProvider DBProvider,
+	logger log.Logger,
+) (*trust.MetricStore, error) {
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(logger.With("module", "trust"))
+	if err := trustMetricStore.Start(); err != nil {
+		return nil, err
+	}
+	return trustMetricStore, nil
+}
+
 func createBlockchainReactor(config *cfg.Config,
 	state sm.State,
 	blockExec *sm.BlockExecutor,
 	blockStore *store.BlockStore,
 	nodeProTxHash *crypto.ProTxHash,
+	trustMetricStore *trust.MetricStore,
 	fastSync bool,
 	logger log.Logger) (bcReactor p2p.Reactor, err error) {
 
-	switch config.FastSync.Version {
-	case "v0":
-		bcReactor = bcv0.NewBlockchainReactor(
-			state.Copy(),
-			blockExec,
-			blockStore,
-			nodeProTxHash,
-			fastSync,
-		)
-	case "v1":
-		bcReactor = bcv1.NewBlockchainReactor(
-			state.Copy(),
-			blockExec,
-			blockStore,
-			nodeProTxHash,
-			fastSync,
-		)
-	case "v2":
-		bcReactor = bcv2.NewBlockchainReactor(
-			state.Copy(),
-			blockExec,
-			blockStore,
-			nodeProTxHash,
-			fastSync,
-		)
-	default:
-		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
+	options := []bc.ReactorOption{
+		bc.ReactorWithCommitVerifier(bc.CommitVerifierWithWorkers(config.FastSync.VerificationWorkers)),
+		bc.ReactorWithPeerScorer(bc.NewTrustMetricScorer(trustMetricStore)),
+	}
+	if config.Instrumentation.Prometheus {
+		options = append(options, bc.ReactorWithMetrics(
+			bc.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", state.ChainID)))
 	}
 
+	bcReactor = bc.NewBlockchainReactor(
+		state.Copy(),
+		blockExec,
+		blockStore,
+		nodeProTxHash,
+		fastSync,
+		options...,
+	)
+
 	bcReactor.SetLogger(logger.With("module", "blockchain"))
 	return bcReactor, nil
 }
@@ -779,10 +863,14 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, indexerMetrics := metricsProvider(genDoc.ChainID)
+
 	indexerService, txIndexer, blockIndexer, err := createAndStartIndexerService(
 		config,
+		genDoc.ChainID,
 		dbProvider,
 		eventBus,
+		indexerMetrics,
 		logger,
 	)
 	if err != nil {
@@ -924,8 +1012,6 @@ func NewNode(config *cfg.Config,
 
 	logNodeStartupInfo(state, proTxHashP, logger, consensusLogger)
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics := metricsProvider(genDoc.ChainID)
-
 	// Make Mempool Reactor
 	mempoolReactor, mempool := createMempoolAndMempoolReactor(
 		config,
@@ -941,6 +1027,7 @@ func NewNode(config *cfg.Config,
 		dbProvider,
 		stateDB,
 		blockStore,
+		proxyApp,
 		logger,
 	)
 	if err != nil {
@@ -965,6 +1052,12 @@ func NewNode(config *cfg.Config,
 		sm.BlockExecutorWithAppHashSize(config.Consensus.AppHashSize),
 	)
 
+	// Keep track of the trust metrics of the peers, used to score them during fast sync.
+	trustMetricStore, err := createAndStartTrustMetricStore(config, dbProvider, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
 	bcReactor, err := createBlockchainReactor(
 		config,
@@ -972,6 +1065,7 @@ func NewNode(config *cfg.Config,
 		blockExec,
 		blockStore,
 		proTxHashP,
+		trustMetricStore,
 		fastSync && !stateSync,
 		logger,
 	)
@@ -991,6 +1085,15 @@ func NewNode(config *cfg.Config,
 		privValidator, csMetrics, stateSync || fastSync, eventBus, consensusLogger, misbehaviors,
 	)
 
+	// Pause consensus while any connection to the application is lost.
+	proxyApp.SetDisconnectedCallback(func(disconnected []string) {
+		if len(disconnected) > 0 {
+			consensusState.Pause()
+		} else {
+			consensusState.Resume()
+		}
+	})
+
 	// Set up state sync reactor, and schedule a sync if requested.
 	// FIXME The way we do phased startups (e.g. replay -> fast sync -> consensus) is very messy,
 	// we should clean this whole thing up. See:
@@ -1087,6 +1190,7 @@ func NewNode(config *cfg.Config,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		trustMetricStore: trustMetricStore,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
 
@@ -1205,6 +1309,11 @@ func (n *Node) OnStop() {
 		n.Logger.Error("Error closing switch", "err", err)
 	}
 
+	// the fast sync reactor scores the peers until it is stopped
+	if err := n.trustMetricStore.Stop(); err != nil {
+		n.Logger.Error("Error closing trustMetricStore", "err", err)
+	}
+
 	// stop mempool WAL
 	if n.config.Mempool.WalEnabled() {
 		n.mempool.CloseWAL()
@@ -1243,6 +1352,7 @@ func (n *Node) ConfigureRPC() error {
 	env := rpccore.Environment{
 		ProxyAppQuery:   n.proxyApp.Query(),
 		ProxyAppMempool: n.proxyApp.Mempool(),
+		ProxyAppConns:   n.proxyApp,
 
 		StateStore:     n.stateStore,
 		BlockStore:     n.blockStore,
@@ -1520,18 +1630,6 @@ func makeNodeInfo(
 		txIndexerStatus = "off"
 	}
 
-	var bcChannel byte
-	switch config.FastSync.Version {
-	case "v0":
-		bcChannel = bcv0.BlockchainChannel
-	case "v1":
-		bcChannel = bcv1.BlockchainChannel
-	case "v2":
-		bcChannel = bcv2.BlockchainChannel
-	default:
-		return nil, fmt.Errorf("unknown fastsync version %s", config.FastSync.Version)
-	}
-
 	nodeInfo := p2p.DefaultNodeInfo{
 		ProTxHash: nodeProTxHash,
 		ProtocolVersion: p2p.NewProtocolVersion(
@@ -1543,7 +1641,7 @@ func makeNodeInfo(
 		Network:       genDoc.ChainID,
 		Version:       version.TMCoreSemVer,
 		Channels: []byte{
-			bcChannel,
+			bc.BlockchainChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
 			mempl.MempoolChannel,
 			evidence.EvidenceChannel,
@@ -33,6 +33,11 @@ test_abci_cli:
 	@ bash abci/tests/test_cli/test.sh
 .PHONY: test_abci_cli
 
+test_sqlsink:
+	# run the SQL event sink tests against SQLite, which are a separate module
+	cd state/indexer/sqlsink/sqlitetest && go test ./...
+.PHONY: test_sqlsink
+
 test_integrations:
 	make build_docker_test_image
 	make tools
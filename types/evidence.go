@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/dashevo/dashd-go/btcjson"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -17,6 +18,8 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/version"
 )
 
 // Evidence represents any provable malicious activity by a validator.
@@ -184,6 +187,485 @@ func DuplicateVoteEvidenceFromProto(pb *tmproto.DuplicateVoteEvidence) (*Duplica
 
 //------------------------------------------------------------------------------------------
 
+// the field number of the chain lock in the proto-encoded block; being the
+// highest one, the chain lock is encoded at the end of the block
+const blockCoreChainLockField = 100
+
+// InvalidChainLockEvidence contains evidence of a proposer signing a proposal,
+// which either regresses the core chain locked height, or is for a block with
+// an invalid chain lock. In the latter case, the evidence also contains the
+// header of the block, its chain lock and the last parts of the block, which
+// prove that the block contains the chain lock.
+type InvalidChainLockEvidence struct {
+	Proposal          *Proposal        `json:"proposal"`
+	ProposerProTxHash crypto.ProTxHash `json:"proposer_pro_tx_hash"`
+
+	// nil if the proposal regresses the core chain locked height
+	Header        *Header        `json:"header"`
+	CoreChainLock *CoreChainLock `json:"core_chain_lock"`
+	BlockParts    []*Part        `json:"block_parts"`
+
+	// abci specific information
+	TotalVotingPower int64
+	ValidatorPower   int64
+	Timestamp        time.Time
+}
+
+var _ Evidence = &InvalidChainLockEvidence{}
+
+// NewInvalidChainLockEvidence creates InvalidChainLockEvidence for the signed
+// proposal, and the proposed block with its parts if the chain lock of the
+// block is invalid. valSet is the validator set at the height of the proposal.
+// If the proposer isn't in the validator set, evidence returned is nil.
+func NewInvalidChainLockEvidence(
+	proposal *Proposal,
+	block *Block,
+	blockParts *PartSet,
+	blockTime time.Time,
+	valSet *ValidatorSet,
+) *InvalidChainLockEvidence {
+	if proposal == nil || valSet == nil || valSet.IsNilOrEmpty() {
+		return nil
+	}
+	proposer := valSet.CopyIncrementProposerPriority(proposal.Round).GetProposer()
+	if proposer == nil {
+		return nil
+	}
+
+	ev := &InvalidChainLockEvidence{
+		Proposal:          proposal,
+		ProposerProTxHash: proposer.ProTxHash,
+		TotalVotingPower:  valSet.TotalVotingPower(),
+		ValidatorPower:    proposer.VotingPower,
+		Timestamp:         blockTime,
+	}
+	if block != nil && block.CoreChainLock != nil {
+		parts, err := chainLockParts(blockParts, block.CoreChainLock)
+		if err != nil {
+			return nil
+		}
+		ev.Header = &block.Header
+		ev.CoreChainLock = block.CoreChainLock
+		ev.BlockParts = parts
+	}
+	return ev
+}
+
+// ABCI returns the application relevant representation of the evidence
+func (e *InvalidChainLockEvidence) ABCI() []abci.Evidence {
+	return []abci.Evidence{{
+		Type: abci.EvidenceType_INVALID_CHAIN_LOCK,
+		Validator: abci.Validator{
+			ProTxHash: e.ProposerProTxHash,
+			Power:     e.ValidatorPower,
+		},
+		Height:           e.Proposal.Height,
+		Time:             e.Timestamp,
+		TotalVotingPower: e.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (e *InvalidChainLockEvidence) Bytes() []byte {
+	pbe, err := e.ToProto()
+	if err != nil {
+		panic(err)
+	}
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (e *InvalidChainLockEvidence) Hash() []byte {
+	return tmhash.Sum(e.Bytes())
+}
+
+// Height returns the height of the infraction
+func (e *InvalidChainLockEvidence) Height() int64 {
+	return e.Proposal.Height
+}
+
+// String returns a string representation of the evidence.
+func (e *InvalidChainLockEvidence) String() string {
+	return fmt.Sprintf("InvalidChainLockEvidence{Proposal: %v, Proposer: %v, CoreChainLock: %v}",
+		e.Proposal, e.ProposerProTxHash.ShortString(), e.CoreChainLock.StringIndented(""))
+}
+
+// Time returns the time of the infraction
+func (e *InvalidChainLockEvidence) Time() time.Time {
+	return e.Timestamp
+}
+
+// ValidateBasic performs basic validation. Besides, it checks that the header
+// and the block parts are of the proposed block, and that the block contains
+// the chain lock.
+func (e *InvalidChainLockEvidence) ValidateBasic() error {
+	if e == nil {
+		return errors.New("empty invalid chain lock evidence")
+	}
+
+	if e.Proposal == nil {
+		return errors.New("empty proposal")
+	}
+	if err := e.Proposal.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid proposal: %w", err)
+	}
+	if len(e.ProposerProTxHash) != crypto.ProTxHashSize {
+		return fmt.Errorf("expected proposer proTxHash size to be %d bytes, got %d bytes",
+			crypto.ProTxHashSize, len(e.ProposerProTxHash))
+	}
+
+	if e.CoreChainLock == nil {
+		if e.Header != nil || len(e.BlockParts) > 0 {
+			return errors.New("header or block parts without a chain lock")
+		}
+		return nil
+	}
+
+	if err := e.CoreChainLock.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid chain lock: %w", err)
+	}
+	if e.Header == nil {
+		return errors.New("empty header")
+	}
+	if err := e.Header.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid header: %w", err)
+	}
+	if e.Header.Height != e.Proposal.Height {
+		return fmt.Errorf("header height %d does not match proposal height %d", e.Header.Height, e.Proposal.Height)
+	}
+	if !bytes.Equal(e.Header.Hash(), e.Proposal.BlockID.Hash) {
+		return fmt.Errorf("header hash %X does not match proposed block %X", e.Header.Hash(), e.Proposal.BlockID.Hash)
+	}
+	return e.validateBlockParts()
+}
+
+// validateBlockParts checks that the block parts are the last parts of the
+// proposed block, and that the block ends with the chain lock.
+func (e *InvalidChainLockEvidence) validateBlockParts() error {
+	if len(e.BlockParts) == 0 {
+		return errors.New("no block parts")
+	}
+	psh := e.Proposal.BlockID.PartSetHeader
+	if len(e.BlockParts) > int(psh.Total) {
+		return fmt.Errorf("%d block parts, but the block has only %d", len(e.BlockParts), psh.Total)
+	}
+
+	var tail []byte
+	first := psh.Total - uint32(len(e.BlockParts))
+	for i, part := range e.BlockParts {
+		if part == nil {
+			return fmt.Errorf("nil block part %d", i)
+		}
+		if err := part.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid block part %d: %w", i, err)
+		}
+		if part.Index != first+uint32(i) || part.Proof.Index != int64(part.Index) ||
+			part.Proof.Total != int64(psh.Total) {
+			return fmt.Errorf("block part %d has index %d, expected %d of %d",
+				i, part.Index, first+uint32(i), psh.Total)
+		}
+		if err := part.Proof.Verify(psh.Hash, part.Bytes); err != nil {
+			return fmt.Errorf("block part %d is not of the proposed block: %w", i, err)
+		}
+		tail = append(tail, part.Bytes...)
+	}
+
+	field, err := chainLockField(e.CoreChainLock)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasSuffix(tail, field) {
+		return errors.New("proposed block does not end with the chain lock")
+	}
+	return nil
+}
+
+// ToProto encodes InvalidChainLockEvidence to protobuf
+func (e *InvalidChainLockEvidence) ToProto() (*tmproto.InvalidChainLockEvidence, error) {
+	tp := &tmproto.InvalidChainLockEvidence{
+		Proposal:          e.Proposal.ToProto(),
+		ProposerProTxHash: e.ProposerProTxHash,
+		CoreChainLock:     e.CoreChainLock.ToProto(),
+		TotalVotingPower:  e.TotalVotingPower,
+		ValidatorPower:    e.ValidatorPower,
+		Timestamp:         e.Timestamp,
+	}
+	if e.Header != nil {
+		tp.Header = e.Header.ToProto()
+	}
+	for _, part := range e.BlockParts {
+		pp, err := part.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		tp.BlockParts = append(tp.BlockParts, pp)
+	}
+	return tp, nil
+}
+
+// InvalidChainLockEvidenceFromProto decodes protobuf into InvalidChainLockEvidence
+func InvalidChainLockEvidenceFromProto(pb *tmproto.InvalidChainLockEvidence) (*InvalidChainLockEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil invalid chain lock evidence")
+	}
+
+	proposal, err := ProposalFromProto(pb.Proposal)
+	if err != nil {
+		return nil, err
+	}
+	cl, err := CoreChainLockFromProto(pb.CoreChainLock)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &InvalidChainLockEvidence{
+		Proposal:          proposal,
+		ProposerProTxHash: pb.ProposerProTxHash,
+		CoreChainLock:     cl,
+		TotalVotingPower:  pb.TotalVotingPower,
+		ValidatorPower:    pb.ValidatorPower,
+		Timestamp:         pb.Timestamp,
+	}
+	if pb.Header != nil {
+		header, err := HeaderFromProto(pb.Header)
+		if err != nil {
+			return nil, err
+		}
+		e.Header = &header
+	}
+	for _, pp := range pb.BlockParts {
+		part, err := PartFromProto(pp)
+		if err != nil {
+			return nil, err
+		}
+		e.BlockParts = append(e.BlockParts, part)
+	}
+
+	return e, e.ValidateBasic()
+}
+
+// chainLockField returns the chain lock encoded as the field of the
+// proto-encoded block.
+func chainLockField(cl *CoreChainLock) ([]byte, error) {
+	bz, err := cl.ToProto().Marshal()
+	if err != nil {
+		return nil, err
+	}
+	field := proto.EncodeVarint(blockCoreChainLockField<<3 | proto.WireBytes)
+	field = append(field, proto.EncodeVarint(uint64(len(bz)))...)
+	return append(field, bz...), nil
+}
+
+// chainLockParts returns the last parts of the block, which contain the chain
+// lock.
+func chainLockParts(blockParts *PartSet, cl *CoreChainLock) ([]*Part, error) {
+	if blockParts == nil || !blockParts.IsComplete() {
+		return nil, errors.New("incomplete block parts")
+	}
+	field, err := chainLockField(cl)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		parts []*Part
+		size  int
+	)
+	for i := int(blockParts.Total()) - 1; i >= 0 && size < len(field); i-- {
+		part := blockParts.GetPart(i)
+		parts = append([]*Part{part}, parts...)
+		size += len(part.Bytes)
+	}
+	return parts, nil
+}
+
+//------------------------------------------------------------------------------------------
+
+// DuplicateProposalEvidence contains evidence of a proposer signing two
+// conflicting proposals for the same height and round.
+type DuplicateProposalEvidence struct {
+	ProposalA         *Proposal        `json:"proposal_a"`
+	ProposalB         *Proposal        `json:"proposal_b"`
+	ProposerProTxHash crypto.ProTxHash `json:"proposer_pro_tx_hash"`
+
+	// abci specific information
+	TotalVotingPower int64
+	ValidatorPower   int64
+	Timestamp        time.Time
+}
+
+var _ Evidence = &DuplicateProposalEvidence{}
+
+// NewDuplicateProposalEvidence creates DuplicateProposalEvidence with right
+// ordering given two conflicting proposals. The proposer is the proposer of the
+// round of the proposals in the validator set of their height. If one of the
+// proposals is nil, evidence returned is nil as well.
+func NewDuplicateProposalEvidence(
+	proposal1, proposal2 *Proposal,
+	blockTime time.Time,
+	valSet *ValidatorSet,
+) *DuplicateProposalEvidence {
+	if proposal1 == nil || proposal2 == nil || valSet == nil || valSet.IsNilOrEmpty() {
+		return nil
+	}
+	proposer := valSet.CopyIncrementProposerPriority(proposal1.Round).GetProposer()
+	if proposer == nil {
+		return nil
+	}
+
+	proposalA, proposalB := proposal1, proposal2
+	if compareProposals(proposal1, proposal2) != -1 {
+		proposalA, proposalB = proposal2, proposal1
+	}
+	return &DuplicateProposalEvidence{
+		ProposalA:         proposalA,
+		ProposalB:         proposalB,
+		ProposerProTxHash: proposer.ProTxHash,
+		TotalVotingPower:  valSet.TotalVotingPower(),
+		ValidatorPower:    proposer.VotingPower,
+		Timestamp:         blockTime,
+	}
+}
+
+// ABCI returns the application relevant representation of the evidence
+func (dpe *DuplicateProposalEvidence) ABCI() []abci.Evidence {
+	return []abci.Evidence{{
+		Type: abci.EvidenceType_DUPLICATE_PROPOSAL,
+		Validator: abci.Validator{
+			ProTxHash: dpe.ProposerProTxHash,
+			Power:     dpe.ValidatorPower,
+		},
+		Height:           dpe.ProposalA.Height,
+		Time:             dpe.Timestamp,
+		TotalVotingPower: dpe.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (dpe *DuplicateProposalEvidence) Bytes() []byte {
+	pbe := dpe.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (dpe *DuplicateProposalEvidence) Hash() []byte {
+	return tmhash.Sum(dpe.Bytes())
+}
+
+// Height returns the height of the infraction
+func (dpe *DuplicateProposalEvidence) Height() int64 {
+	return dpe.ProposalA.Height
+}
+
+// String returns a string representation of the evidence.
+func (dpe *DuplicateProposalEvidence) String() string {
+	return fmt.Sprintf("DuplicateProposalEvidence{ProposalA: %v, ProposalB: %v, Proposer: %X}",
+		dpe.ProposalA, dpe.ProposalB, dpe.ProposerProTxHash)
+}
+
+// Time returns the time of the infraction
+func (dpe *DuplicateProposalEvidence) Time() time.Time {
+	return dpe.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (dpe *DuplicateProposalEvidence) ValidateBasic() error {
+	if dpe == nil {
+		return errors.New("empty duplicate proposal evidence")
+	}
+
+	if dpe.ProposalA == nil || dpe.ProposalB == nil {
+		return fmt.Errorf("one or both of the proposals are empty %v, %v", dpe.ProposalA, dpe.ProposalB)
+	}
+	if err := dpe.ProposalA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalA: %w", err)
+	}
+	if err := dpe.ProposalB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalB: %w", err)
+	}
+	if len(dpe.ProposerProTxHash) != crypto.ProTxHashSize {
+		return fmt.Errorf("expected proposer proTxHash size to be %d bytes, got %d bytes",
+			crypto.ProTxHashSize, len(dpe.ProposerProTxHash))
+	}
+	// Enforce Proposals are sorted on blockID, POL round and timestamp
+	if compareProposals(dpe.ProposalA, dpe.ProposalB) >= 0 {
+		return errors.New("duplicate proposals in invalid order")
+	}
+	return nil
+}
+
+// compareProposals orders proposals of the same height and round by the other
+// fields they are signed with: lexicographically on blockID, then on POL
+// round and timestamp.
+func compareProposals(a, b *Proposal) int {
+	if c := strings.Compare(a.BlockID.Key(), b.BlockID.Key()); c != 0 {
+		return c
+	}
+	switch {
+	case a.POLRound < b.POLRound:
+		return -1
+	case a.POLRound > b.POLRound:
+		return 1
+	case a.Timestamp.Before(b.Timestamp):
+		return -1
+	case a.Timestamp.After(b.Timestamp):
+		return 1
+	}
+	return 0
+}
+
+// ToProto encodes DuplicateProposalEvidence to protobuf
+func (dpe *DuplicateProposalEvidence) ToProto() *tmproto.DuplicateProposalEvidence {
+	return &tmproto.DuplicateProposalEvidence{
+		ProposalA:         dpe.ProposalA.ToProto(),
+		ProposalB:         dpe.ProposalB.ToProto(),
+		ProposerProTxHash: dpe.ProposerProTxHash,
+		TotalVotingPower:  dpe.TotalVotingPower,
+		ValidatorPower:    dpe.ValidatorPower,
+		Timestamp:         dpe.Timestamp,
+	}
+}
+
+// DuplicateProposalEvidenceFromProto decodes protobuf into DuplicateProposalEvidence
+func DuplicateProposalEvidenceFromProto(pb *tmproto.DuplicateProposalEvidence) (*DuplicateProposalEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil duplicate proposal evidence")
+	}
+
+	pA, err := ProposalFromProto(pb.ProposalA)
+	if err != nil {
+		return nil, err
+	}
+
+	pB, err := ProposalFromProto(pb.ProposalB)
+	if err != nil {
+		return nil, err
+	}
+
+	dpe := &DuplicateProposalEvidence{
+		ProposalA:         pA,
+		ProposalB:         pB,
+		ProposerProTxHash: pb.ProposerProTxHash,
+		TotalVotingPower:  pb.TotalVotingPower,
+		ValidatorPower:    pb.ValidatorPower,
+		Timestamp:         pb.Timestamp,
+	}
+
+	return dpe, dpe.ValidateBasic()
+}
+
+//------------------------------------------------------------------------------------------
+
 // EvidenceList is a list of Evidence. Evidences is not a word.
 type EvidenceList []Evidence
 
@@ -237,6 +719,25 @@ func EvidenceToProto(evidence Evidence) (*tmproto.Evidence, error) {
 			},
 		}, nil
 
+	case *InvalidChainLockEvidence:
+		pbev, err := evi.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_InvalidChainLockEvidence{
+				InvalidChainLockEvidence: pbev,
+			},
+		}, nil
+
+	case *DuplicateProposalEvidence:
+		pbev := evi.ToProto()
+		return &tmproto.Evidence{
+			Sum: &tmproto.Evidence_DuplicateProposalEvidence{
+				DuplicateProposalEvidence: pbev,
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
 	}
@@ -252,6 +753,10 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 	switch evi := evidence.Sum.(type) {
 	case *tmproto.Evidence_DuplicateVoteEvidence:
 		return DuplicateVoteEvidenceFromProto(evi.DuplicateVoteEvidence)
+	case *tmproto.Evidence_InvalidChainLockEvidence:
+		return InvalidChainLockEvidenceFromProto(evi.InvalidChainLockEvidence)
+	case *tmproto.Evidence_DuplicateProposalEvidence:
+		return DuplicateProposalEvidenceFromProto(evi.DuplicateProposalEvidence)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -259,6 +764,8 @@ func EvidenceFromProto(evidence *tmproto.Evidence) (Evidence, error) {
 
 func init() {
 	tmjson.RegisterType(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence")
+	tmjson.RegisterType(&InvalidChainLockEvidence{}, "tendermint/InvalidChainLockEvidence")
+	tmjson.RegisterType(&DuplicateProposalEvidence{}, "tendermint/DuplicateProposalEvidence")
 }
 
 //-------------------------------------------- ERRORS --------------------------------------
@@ -365,6 +872,65 @@ func NewMockDuplicateVoteEvidenceWithPrivValInValidatorSet(height int64, time ti
 	return NewDuplicateVoteEvidence(voteA, voteB, time, valSet)
 }
 
+// NewMockInvalidChainLockEvidence returns evidence of the validator, which must
+// be the proposer of round 0 in the set, proposing a block at the height with
+// the chain lock, or, if the chain lock is nil, regressing the core chain
+// locked height to 0.
+func NewMockInvalidChainLockEvidence(height int64, time time.Time, chainLock *CoreChainLock,
+	pv PrivValidator, valSet *ValidatorSet, chainID string) *InvalidChainLockEvidence {
+	var coreHeight uint32
+	if chainLock != nil {
+		coreHeight = chainLock.CoreBlockHeight
+	}
+	proTxHash, _ := pv.GetProTxHash()
+	block := &Block{
+		Header: Header{
+			Version:               tmversion.Consensus{Block: version.BlockProtocol},
+			ChainID:               chainID,
+			Height:                height,
+			CoreChainLockedHeight: coreHeight,
+			Time:                  time,
+			LastBlockID:           randBlockID(),
+			ValidatorsHash:        valSet.Hash(),
+			ProposerProTxHash:     proTxHash,
+		},
+		Data:          Data{Txs: []Tx{Tx(tmrand.Bytes(100))}},
+		LastCommit:    &Commit{Height: height - 1},
+		CoreChainLock: chainLock,
+	}
+	blockHash := block.Hash()
+	blockParts := block.MakePartSet(BlockPartSizeBytes)
+
+	proposal := NewProposal(height, coreHeight, 0, -1, BlockID{blockHash, blockParts.Header()}, time)
+	p := proposal.ToProto()
+	_, _ = pv.SignProposal(chainID, valSet.QuorumType, valSet.QuorumHash, p)
+	proposal.Signature = p.Signature
+
+	if chainLock == nil {
+		block, blockParts = nil, nil
+	}
+	return NewInvalidChainLockEvidence(proposal, block, blockParts, time, valSet)
+}
+
+// NewMockDuplicateProposalEvidence returns evidence of the validator, which must
+// be the proposer of round 0 in the set, signing two proposals for random
+// blocks at the height.
+func NewMockDuplicateProposalEvidence(height int64, time time.Time,
+	pv PrivValidator, valSet *ValidatorSet, chainID string) *DuplicateProposalEvidence {
+	proposalA := makeMockProposal(height, time, pv, valSet, chainID)
+	proposalB := makeMockProposal(height, time, pv, valSet, chainID)
+	return NewDuplicateProposalEvidence(proposalA, proposalB, time, valSet)
+}
+
+func makeMockProposal(height int64, time time.Time, pv PrivValidator, valSet *ValidatorSet,
+	chainID string) *Proposal {
+	proposal := NewProposal(height, 0, 0, -1, randBlockID(), time)
+	p := proposal.ToProto()
+	_, _ = pv.SignProposal(chainID, valSet.QuorumType, valSet.QuorumHash, p)
+	proposal.Signature = p.Signature
+	return proposal
+}
+
 func makeMockVote(height int64, round, index int32, proTxHash crypto.ProTxHash,
 	blockID BlockID, stateID StateID) *Vote {
 	return &Vote{
@@ -2,6 +2,7 @@ package types
 
 import (
 	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -114,6 +115,160 @@ func TestMockEvidenceValidateBasic(t *testing.T) {
 	assert.Nil(t, goodEvidence.ValidateBasic())
 }
 
+func TestInvalidChainLockEvidence(t *testing.T) {
+	quorumHash := crypto.RandQuorumHash()
+	val := NewMockPVForQuorum(quorumHash)
+	thresholdPublicKey, err := val.GetThresholdPublicKey(quorumHash)
+	require.NoError(t, err)
+	valSet := NewValidatorSet(
+		[]*Validator{val.ExtractIntoValidator(quorumHash)}, thresholdPublicKey, btcjson.LLMQType_5_60, quorumHash, true)
+	const (
+		chainID = "mychain"
+		height  = int64(13)
+	)
+
+	chainLock := NewMockChainLock(1000)
+	ev := NewMockInvalidChainLockEvidence(height, defaultVoteTime, &chainLock, val, valSet, chainID)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Equal(t, height, ev.Height())
+	assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+	assert.Equal(t, DefaultDashVotingPower, ev.ValidatorPower)
+
+	// the chain lock is bound to the proposal by the last parts of the block,
+	// even if it spans several of them
+	block := &Block{
+		Header:        *makeHeaderRandom(),
+		Data:          Data{Txs: []Tx{Tx("foo")}},
+		LastCommit:    &Commit{Height: height - 1},
+		CoreChainLock: &chainLock,
+	}
+	block.Height = height
+	block.CoreChainLockedHeight = chainLock.CoreBlockHeight
+	blockHash := block.Hash()
+	blockParts := block.MakePartSet(32)
+	proposal := NewProposal(height, chainLock.CoreBlockHeight, 0, -1,
+		BlockID{blockHash, blockParts.Header()}, defaultVoteTime)
+	p := proposal.ToProto()
+	_, err = val.SignProposal(chainID, valSet.QuorumType, valSet.QuorumHash, p)
+	require.NoError(t, err)
+	proposal.Signature = p.Signature
+	smallPartsEv := NewInvalidChainLockEvidence(proposal, block, blockParts, defaultVoteTime, valSet)
+	require.Greater(t, len(smallPartsEv.BlockParts), 1)
+	require.NoError(t, smallPartsEv.ValidateBasic())
+
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*InvalidChainLockEvidence)
+		expectErr        bool
+	}{
+		{"Good InvalidChainLockEvidence", func(ev *InvalidChainLockEvidence) {}, false},
+		{"Nil proposal", func(ev *InvalidChainLockEvidence) { ev.Proposal = nil }, true},
+		{"Invalid proposer", func(ev *InvalidChainLockEvidence) { ev.ProposerProTxHash = []byte{1} }, true},
+		{"Nil header", func(ev *InvalidChainLockEvidence) { ev.Header = nil }, true},
+		{"Other header", func(ev *InvalidChainLockEvidence) { ev.Header.AppHash = []byte("apphash") }, true},
+		{"Other chain lock", func(ev *InvalidChainLockEvidence) {
+			cl := NewMockChainLock(1000)
+			cl.Signature = tmrand.Bytes(len(cl.Signature))
+			ev.CoreChainLock = &cl
+		}, true},
+		{"Missing last part", func(ev *InvalidChainLockEvidence) {
+			ev.BlockParts = ev.BlockParts[:len(ev.BlockParts)-1]
+		}, true},
+		{"Missing first part", func(ev *InvalidChainLockEvidence) { ev.BlockParts = ev.BlockParts[1:] }, true},
+		{"Tampered part", func(ev *InvalidChainLockEvidence) {
+			ev.BlockParts[0] = &Part{
+				Index: ev.BlockParts[0].Index,
+				Bytes: tmrand.Bytes(len(ev.BlockParts[0].Bytes)),
+				Proof: ev.BlockParts[0].Proof,
+			}
+		}, true},
+		{"Parts without chain lock", func(ev *InvalidChainLockEvidence) { ev.CoreChainLock = nil }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			ev := NewInvalidChainLockEvidence(proposal, block, blockParts, defaultVoteTime, valSet)
+			header := block.Header
+			ev.Header = &header
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+
+	// a proposal, which regresses the core chain locked height, needs no block
+	ev = NewMockInvalidChainLockEvidence(height, defaultVoteTime, nil, val, valSet, chainID)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Nil(t, ev.Header)
+	assert.Empty(t, ev.BlockParts)
+}
+
+func TestDuplicateProposalEvidence(t *testing.T) {
+	quorumHash := crypto.RandQuorumHash()
+	val := NewMockPVForQuorum(quorumHash)
+	thresholdPublicKey, err := val.GetThresholdPublicKey(quorumHash)
+	require.NoError(t, err)
+	valSet := NewValidatorSet(
+		[]*Validator{val.ExtractIntoValidator(quorumHash)}, thresholdPublicKey, btcjson.LLMQType_5_60, quorumHash, true)
+	const (
+		chainID = "mychain"
+		height  = int64(13)
+	)
+
+	ev := NewMockDuplicateProposalEvidence(height, defaultVoteTime, val, valSet, chainID)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Equal(t, height, ev.Height())
+	assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+	assert.Equal(t, valSet.Validators[0].ProTxHash, ev.ProposerProTxHash)
+	// the proposals are ordered whatever the order they are given in
+	assert.Equal(t, ev, NewDuplicateProposalEvidence(ev.ProposalB, ev.ProposalA, defaultVoteTime, valSet))
+
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*DuplicateProposalEvidence)
+		expectErr        bool
+	}{
+		{"Good DuplicateProposalEvidence", func(ev *DuplicateProposalEvidence) {}, false},
+		{"Nil proposal A", func(ev *DuplicateProposalEvidence) { ev.ProposalA = nil }, true},
+		{"Nil proposal B", func(ev *DuplicateProposalEvidence) { ev.ProposalB = nil }, true},
+		{"Unsigned proposal", func(ev *DuplicateProposalEvidence) { ev.ProposalA.Signature = nil }, true},
+		{"Invalid proposer", func(ev *DuplicateProposalEvidence) { ev.ProposerProTxHash = []byte{1} }, true},
+		{"Invalid proposal order", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalA, ev.ProposalB = ev.ProposalB, ev.ProposalA
+		}, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			ev := NewMockDuplicateProposalEvidence(height, defaultVoteTime, val, valSet, chainID)
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
+func TestCompareProposals(t *testing.T) {
+	blockID := makeBlockIDRandom()
+	proposal := NewProposal(10, 1, 0, -1, blockID, defaultVoteTime)
+	other := func(modify func(*Proposal)) *Proposal {
+		p := *proposal
+		modify(&p)
+		return &p
+	}
+
+	assert.Equal(t, 0, compareProposals(proposal, other(func(*Proposal) {})))
+	// proposals of the same block are ordered by POL round and timestamp
+	assert.Equal(t, -1, compareProposals(proposal, other(func(p *Proposal) { p.POLRound = 0 })))
+	assert.Equal(t, 1, compareProposals(proposal, other(func(p *Proposal) {
+		p.Timestamp = p.Timestamp.Add(-time.Millisecond)
+	})))
+	// the block ID takes precedence
+	otherBlock := other(func(p *Proposal) {
+		p.BlockID = makeBlockIDRandom()
+		p.POLRound = 0
+	})
+	assert.Equal(t, strings.Compare(blockID.Key(), otherBlock.BlockID.Key()), compareProposals(proposal, otherBlock))
+}
+
 func makeVote(
 	t *testing.T, val PrivValidator, chainID string,
 	valIndex int32, height int64, quorumType btcjson.LLMQType,
@@ -189,6 +344,16 @@ func TestEvidenceProto(t *testing.T) {
 	header2.LastBlockID = blockID
 	header2.ChainID = chainID
 
+	// -------- Proposals --------
+	thresholdPublicKey, err := val.GetThresholdPublicKey(quorumHash)
+	require.NoError(t, err)
+	valSet := NewValidatorSet(
+		[]*Validator{val.ExtractIntoValidator(quorumHash)}, thresholdPublicKey, quorumType, quorumHash, true)
+	chainLock := NewMockChainLock(1000)
+	invalidChainLockEv := NewMockInvalidChainLockEvidence(height, defaultVoteTime, &chainLock, val, valSet, chainID)
+	regressionEv := NewMockInvalidChainLockEvidence(height, defaultVoteTime, nil, val, valSet, chainID)
+	duplicateProposalEv := NewMockDuplicateProposalEvidence(height, defaultVoteTime, val, valSet, chainID)
+
 	tests := []struct {
 		testName     string
 		evidence     Evidence
@@ -200,6 +365,11 @@ func TestEvidenceProto(t *testing.T) {
 		{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 		{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 		{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+		{"InvalidChainLockEvidence empty fail", &InvalidChainLockEvidence{}, false, true},
+		{"InvalidChainLockEvidence success", invalidChainLockEv, false, false},
+		{"InvalidChainLockEvidence without chain lock success", regressionEv, false, false},
+		{"DuplicateProposalEvidence empty fail", &DuplicateProposalEvidence{}, false, true},
+		{"DuplicateProposalEvidence success", duplicateProposalEv, false, false},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -100,8 +100,13 @@ func (genDoc *GenesisDoc) ValidateAndComplete() error {
 
 	if genDoc.ConsensusParams == nil {
 		genDoc.ConsensusParams = DefaultConsensusParams()
-	} else if err := ValidateConsensusParams(*genDoc.ConsensusParams); err != nil {
-		return err
+	} else {
+		// Genesis files created before synchrony params were introduced don't
+		// have them set.
+		CompleteSynchronyParams(genDoc.ConsensusParams)
+		if err := ValidateConsensusParams(*genDoc.ConsensusParams); err != nil {
+			return err
+		}
 	}
 
 	for _, v := range genDoc.Validators {
@@ -28,6 +28,7 @@ func DefaultConsensusParams() *tmproto.ConsensusParams {
 		Evidence:  DefaultEvidenceParams(),
 		Validator: DefaultValidatorParams(),
 		Version:   DefaultVersionParams(),
+		Synchrony: DefaultSynchronyParams(),
 	}
 }
 
@@ -63,6 +64,24 @@ func DefaultVersionParams() tmproto.VersionParams {
 	}
 }
 
+// DefaultSynchronyParams returns a default SynchronyParams.
+func DefaultSynchronyParams() tmproto.SynchronyParams {
+	return tmproto.SynchronyParams{
+		// 505ms leaves room for validators which handle leap seconds differently.
+		Precision:    505 * time.Millisecond,
+		MessageDelay: 12 * time.Second,
+	}
+}
+
+// CompleteSynchronyParams sets the default synchrony params, unless they are
+// set. The params created before the synchrony params were introduced don't
+// have them set, which would make every proposal untimely.
+func CompleteSynchronyParams(params *tmproto.ConsensusParams) {
+	if params.Synchrony.Equal(tmproto.SynchronyParams{}) {
+		params.Synchrony = DefaultSynchronyParams()
+	}
+}
+
 func IsValidPubkeyType(params tmproto.ValidatorParams, pubkeyType string) bool {
 	for i := 0; i < len(params.PubKeyTypes); i++ {
 		if params.PubKeyTypes[i] == pubkeyType {
@@ -127,6 +146,16 @@ func ValidateConsensusParams(params tmproto.ConsensusParams) error {
 		}
 	}
 
+	if params.Synchrony.Precision <= 0 {
+		return fmt.Errorf("synchrony.Precision must be greater than 0. Got %v",
+			params.Synchrony.Precision)
+	}
+
+	if params.Synchrony.MessageDelay <= 0 {
+		return fmt.Errorf("synchrony.MessageDelay must be greater than 0. Got %v",
+			params.Synchrony.MessageDelay)
+	}
+
 	return nil
 }
 
@@ -181,5 +210,9 @@ func UpdateConsensusParams(params tmproto.ConsensusParams, params2 *abci.Consens
 	if params2.Version != nil {
 		res.Version.AppVersion = params2.Version.AppVersion
 	}
+	if params2.Synchrony != nil {
+		res.Synchrony.Precision = params2.Synchrony.Precision
+		res.Synchrony.MessageDelay = params2.Synchrony.MessageDelay
+	}
 	return res
 }
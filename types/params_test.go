@@ -40,6 +40,10 @@ func TestConsensusParamsValidation(t *testing.T) {
 		13: {makeParams(1, 0, 10, 2, 0, []string{}), false},
 		// test invalid pubkey type provided
 		14: {makeParams(1, 0, 10, 2, 0, []string{"potatoes make good pubkeys"}), false},
+		// test synchrony params
+		15: {makeSynchronyParams(0, time.Second), false},
+		16: {makeSynchronyParams(time.Millisecond, 0), false},
+		17: {makeSynchronyParams(time.Millisecond, time.Second), true},
 	}
 	for i, tc := range testCases {
 		if tc.valid {
@@ -71,9 +75,19 @@ func makeParams(
 		Validator: tmproto.ValidatorParams{
 			PubKeyTypes: pubkeyTypes,
 		},
+		Synchrony: DefaultSynchronyParams(),
 	}
 }
 
+func makeSynchronyParams(precision, messageDelay time.Duration) tmproto.ConsensusParams {
+	params := makeParams(1, 0, 10, 2, 0, valBLS12381)
+	params.Synchrony = tmproto.SynchronyParams{
+		Precision:    precision,
+		MessageDelay: messageDelay,
+	}
+	return params
+}
+
 func TestConsensusParamsHash(t *testing.T) {
 	params := []tmproto.ConsensusParams{
 		makeParams(4, 2, 10, 3, 1, valBLS12381),
@@ -148,3 +162,28 @@ func TestConsensusParamsUpdate_AppVersion(t *testing.T) {
 
 	assert.EqualValues(t, 1, updated.Version.AppVersion)
 }
+
+func TestCompleteSynchronyParams(t *testing.T) {
+	params := DefaultConsensusParams()
+	params.Synchrony = tmproto.SynchronyParams{}
+	CompleteSynchronyParams(params)
+	assert.Equal(t, DefaultSynchronyParams(), params.Synchrony)
+
+	params.Synchrony = tmproto.SynchronyParams{Precision: time.Second, MessageDelay: time.Second}
+	CompleteSynchronyParams(params)
+	assert.Equal(t, time.Second, params.Synchrony.Precision)
+	assert.Equal(t, time.Second, params.Synchrony.MessageDelay)
+}
+
+func TestConsensusParamsUpdate_Synchrony(t *testing.T) {
+	params := makeParams(1, 2, 10, 3, 0, valBLS12381)
+
+	updated := UpdateConsensusParams(params,
+		&abci.ConsensusParams{Synchrony: &tmproto.SynchronyParams{
+			Precision:    time.Second,
+			MessageDelay: 3 * time.Second,
+		}})
+
+	assert.Equal(t, time.Second, updated.Synchrony.Precision)
+	assert.Equal(t, 3*time.Second, updated.Synchrony.MessageDelay)
+}
@@ -14,7 +14,6 @@ import (
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/protoio"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
-	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 var (
@@ -41,7 +40,10 @@ type Proposal struct {
 
 // NewProposal returns a new Proposal.
 // If there is no POLRound, polRound should be -1.
-func NewProposal(height int64, coreChainLockedHeight uint32, round int32, polRound int32, blockID BlockID) *Proposal {
+// The timestamp must be equal to the time of the proposed block.
+func NewProposal(
+	height int64, coreChainLockedHeight uint32, round int32, polRound int32, blockID BlockID, ts time.Time,
+) *Proposal {
 	return &Proposal{
 		Type:                  tmproto.ProposalType,
 		Height:                height,
@@ -49,10 +51,23 @@ func NewProposal(height int64, coreChainLockedHeight uint32, round int32, polRou
 		Round:                 round,
 		BlockID:               blockID,
 		POLRound:              polRound,
-		Timestamp:             tmtime.Now(),
+		Timestamp:             ts,
 	}
 }
 
+// IsTimely validates that the proposal timestamp is 'timely' according to the
+// proposer-based timestamp algorithm. To evaluate if a proposal is timely, its
+// timestamp is compared to the local time at which the proposal was received.
+//
+// The proposal is timely if
+//   proposal.Timestamp - precision <= recvTime <= proposal.Timestamp + messageDelay + precision
+func (p *Proposal) IsTimely(recvTime time.Time, sp tmproto.SynchronyParams) bool {
+	lhs := p.Timestamp.Add(-sp.Precision)
+	rhs := p.Timestamp.Add(sp.MessageDelay).Add(sp.Precision)
+
+	return !recvTime.Before(lhs) && !recvTime.After(rhs)
+}
+
 // ValidateBasic performs basic validation.
 func (p *Proposal) ValidateBasic() error {
 	if p.Type != tmproto.ProposalType {
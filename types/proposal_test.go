@@ -17,6 +17,7 @@ import (
 	"github.com/tendermint/tendermint/libs/protoio"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 var (
@@ -67,7 +68,8 @@ func TestProposalVerifySignature(t *testing.T) {
 
 	prop := NewProposal(
 		4, 1, 2, 2,
-		BlockID{tmrand.Bytes(tmhash.Size), PartSetHeader{777, tmrand.Bytes(tmhash.Size)}})
+		BlockID{tmrand.Bytes(tmhash.Size), PartSetHeader{777, tmrand.Bytes(tmhash.Size)}},
+		tmtime.Now())
 	p := prop.ToProto()
 	signID := ProposalBlockSignID("test_chain_id", p, btcjson.LLMQType_5_60, quorumHash)
 
@@ -160,7 +162,7 @@ func TestProposalValidateBasic(t *testing.T) {
 		t.Run(tc.testName, func(t *testing.T) {
 			prop := NewProposal(
 				4, 1, 2, 2,
-				blockID)
+				blockID, tmtime.Now())
 			p := prop.ToProto()
 			_, err := privVal.SignProposal("test_chain_id", 0, quorumHash, p)
 			prop.Signature = p.Signature
@@ -172,9 +174,9 @@ func TestProposalValidateBasic(t *testing.T) {
 }
 
 func TestProposalProtoBuf(t *testing.T) {
-	proposal := NewProposal(1, 1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
+	proposal := NewProposal(1, 1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")), tmtime.Now())
 	proposal.Signature = []byte("sig")
-	proposal2 := NewProposal(1, 1, 2, 3, BlockID{})
+	proposal2 := NewProposal(1, 1, 2, 3, BlockID{}, tmtime.Now())
 
 	testCases := []struct {
 		msg     string
@@ -198,3 +200,31 @@ func TestProposalProtoBuf(t *testing.T) {
 		}
 	}
 }
+
+func TestProposalIsTimely(t *testing.T) {
+	genesisTime, err := time.Parse(time.RFC3339, "2019-03-13T23:00:00Z")
+	require.NoError(t, err)
+	sp := tmproto.SynchronyParams{
+		Precision:    time.Millisecond,
+		MessageDelay: 10 * time.Millisecond,
+	}
+
+	testCases := []struct {
+		name     string
+		recvTime time.Time
+		expected bool
+	}{
+		{"received at timestamp", genesisTime, true},
+		{"received within precision before timestamp", genesisTime.Add(-time.Millisecond), true},
+		{"received too early", genesisTime.Add(-2 * time.Millisecond), false},
+		{"received within message delay", genesisTime.Add(11 * time.Millisecond), true},
+		{"received too late", genesisTime.Add(12 * time.Millisecond), false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewProposal(1, 1, 0, -1, BlockID{}, genesisTime)
+			assert.Equal(t, tc.expected, p.IsTimely(tc.recvTime, sp))
+		})
+	}
+}
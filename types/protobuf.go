@@ -129,6 +129,7 @@ func (tm2pb) ConsensusParams(params *tmproto.ConsensusParams) *abci.ConsensusPar
 		},
 		Evidence:  &params.Evidence,
 		Validator: &params.Validator,
+		Synchrony: &params.Synchrony,
 	}
 }
 